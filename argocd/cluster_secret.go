@@ -0,0 +1,188 @@
+package argocd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The functions below manage clusters through their declarative secret (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters),
+// the same way ArgoCD stores the clusters registered through its API.
+
+func clusterSecretName(server string) (string, error) {
+	return db.URIToSecretName("cluster", server)
+}
+
+// clusterToSecret serializes the cluster into the data and metadata of its
+// declarative secret, matching what ArgoCD itself writes. Labels and
+// annotations are merged onto the ones of the secret: the ones of the previous
+// cluster which are no longer present are removed, the ones added out-of-band
+// are retained.
+func clusterToSecret(c *application.Cluster, secret *corev1.Secret, previous *application.Cluster) error {
+	config, err := json.Marshal(c.Config)
+	if err != nil {
+		return fmt.Errorf("failed to serialize config of cluster %s: %w", c.Server, err)
+	}
+
+	data := map[string][]byte{
+		"server": []byte(strings.TrimRight(c.Server, "/")),
+		"name":   []byte(c.Server),
+		"config": config,
+	}
+
+	if c.Name != "" {
+		data["name"] = []byte(c.Name)
+	}
+
+	if len(c.Namespaces) > 0 {
+		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
+	}
+
+	if c.ClusterResources {
+		data["clusterResources"] = []byte("true")
+	}
+
+	if c.Shard != nil {
+		data["shard"] = []byte(strconv.FormatInt(*c.Shard, 10))
+	}
+
+	if c.Project != "" {
+		data["project"] = []byte(c.Project)
+	}
+
+	secret.Data = data
+
+	if previous == nil {
+		previous = &application.Cluster{}
+	}
+
+	secret.Labels = mergeClusterSecretMetadata(secret.Labels, c.Labels, previous.Labels)
+	secret.Labels[common.LabelKeySecretType] = common.LabelValueSecretTypeCluster
+
+	secret.Annotations = mergeClusterSecretMetadata(secret.Annotations, c.Annotations, previous.Annotations)
+	secret.Annotations[common.AnnotationKeyManagedBy] = common.AnnotationValueManagedByArgoCD
+
+	return nil
+}
+
+func mergeClusterSecretMetadata(current, desired, previous map[string]string) map[string]string {
+	if current == nil {
+		current = map[string]string{}
+	}
+
+	for k := range previous {
+		if _, ok := desired[k]; !ok {
+			delete(current, k)
+		}
+	}
+
+	maps.Copy(current, desired)
+
+	return current
+}
+
+// trackedClusterSecretMetadata returns the labels or annotations of the
+// secret with the given keys, i.e. the ones managed by Terraform.
+func trackedClusterSecretMetadata(current map[string]string, managed map[string]interface{}) map[string]string {
+	tracked := make(map[string]string, len(managed))
+
+	for k := range managed {
+		if v, ok := current[k]; ok {
+			tracked[k] = v
+		}
+	}
+
+	return tracked
+}
+
+// secretToCluster deserializes the cluster from its declarative secret, along
+// with the hash of its credentials.
+func secretToCluster(secret *corev1.Secret) (*application.Cluster, string, error) {
+	c, err := db.SecretToCluster(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return c, clusterCredentialsHash(c.Config, secret.UID), nil
+}
+
+func createClusterSecret(ctx context.Context, kc kubernetes.Interface, namespace string, c *application.Cluster) (*application.Cluster, string, error) {
+	name, err := clusterSecretName(c.Server)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid server address %s: %w", c.Server, err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	if err = clusterToSecret(c, secret, nil); err != nil {
+		return nil, "", err
+	}
+
+	secret, err = kc.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return secretToCluster(secret)
+}
+
+func getClusterSecret(ctx context.Context, kc kubernetes.Interface, namespace, server string) (*corev1.Secret, error) {
+	name, err := clusterSecretName(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server address %s: %w", server, err)
+	}
+
+	return kc.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func readClusterSecret(ctx context.Context, kc kubernetes.Interface, namespace, server string) (*application.Cluster, string, error) {
+	secret, err := getClusterSecret(ctx, kc, namespace, server)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return secretToCluster(secret)
+}
+
+func updateClusterSecret(ctx context.Context, kc kubernetes.Interface, namespace string, c, previous *application.Cluster) (*application.Cluster, string, error) {
+	secret, err := getClusterSecret(ctx, kc, namespace, c.Server)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err = clusterToSecret(c, secret, previous); err != nil {
+		return nil, "", err
+	}
+
+	secret, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return secretToCluster(secret)
+}
+
+func deleteClusterSecret(ctx context.Context, kc kubernetes.Interface, namespace, server string) error {
+	name, err := clusterSecretName(server)
+	if err != nil {
+		return fmt.Errorf("invalid server address %s: %w", server, err)
+	}
+
+	return kc.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,107 @@
+package argocd
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClusterSecretLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	shard := int64(1)
+	cluster := &application.Cluster{
+		Server:           "https://kubernetes.example.com/",
+		Name:             "production",
+		Namespaces:       []string{"default", "foo"},
+		ClusterResources: true,
+		Shard:            &shard,
+		Labels:           map[string]string{"environment": "production"},
+		Config: application.ClusterConfig{
+			BearerToken: "token",
+		},
+	}
+
+	created, hash, err := createClusterSecret(ctx, kc, "argocd", cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if created.Server != "https://kubernetes.example.com" || created.Name != "production" || created.Config.BearerToken != "token" {
+		t.Errorf("unexpected cluster %+v", created)
+	}
+
+	if hash != clusterCredentialsHash(cluster.Config, "") {
+		t.Errorf("unexpected credentials hash %q", hash)
+	}
+
+	if _, _, err = createClusterSecret(ctx, kc, "argocd", cluster); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	secret, err := getClusterSecret(ctx, kc, "argocd", cluster.Server)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := secret.Labels[common.LabelKeySecretType]; got != common.LabelValueSecretTypeCluster {
+		t.Errorf("expected secret type label to be %q, got %q", common.LabelValueSecretTypeCluster, got)
+	}
+
+	if got := secret.Labels["environment"]; got != "production" {
+		t.Errorf("expected environment label to be preserved, got %q", got)
+	}
+
+	// Labels added out-of-band are retained, previously managed ones which
+	// are no longer present are removed
+	secret.Labels["team"] = "platform"
+
+	if _, err = kc.CoreV1().Secrets("argocd").Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	previous := &application.Cluster{Labels: cluster.Labels}
+
+	cluster.Namespaces = nil
+	cluster.ClusterResources = false
+	cluster.Shard = nil
+	cluster.Labels = map[string]string{"tier": "backend"}
+
+	if _, _, err = updateClusterSecret(ctx, kc, "argocd", cluster, previous); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, _, err := readClusterSecret(ctx, kc, "argocd", cluster.Server)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(read.Namespaces) != 0 || read.ClusterResources || read.Shard != nil {
+		t.Errorf("expected the scope of the cluster to be cleared, got %+v", read)
+	}
+
+	if _, ok := read.Labels[common.LabelKeySecretType]; ok {
+		t.Errorf("expected secret type label to be hidden from the cluster labels")
+	}
+
+	if expected := map[string]string{"team": "platform", "tier": "backend"}; !maps.Equal(read.Labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, read.Labels)
+	}
+
+	if err = deleteClusterSecret(ctx, kc, "argocd", cluster.Server); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, _, err = readClusterSecret(ctx, kc, "argocd", cluster.Server); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
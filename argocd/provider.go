@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,11 +18,13 @@ import (
 // Used to handle concurrent access to ArgoCD common configuration
 var tokenMutexConfiguration = &sync.RWMutex{}
 
-// Used to handle concurrent access to ArgoCD clusters
-var tokenMutexClusters = &sync.RWMutex{}
+// Used to handle concurrent access to ArgoCD clusters, shared with the
+// cluster data sources
+var tokenMutexClusters = argocdSync.ClusterMutex
 
-// Used to handle concurrent access to ArgoCD secrets
-var tokenMutexSecrets = &sync.RWMutex{}
+// Used to handle concurrent access to ArgoCD secrets, shared with the account
+// resources
+var tokenMutexSecrets = argocdSync.AccountMutex
 
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -84,7 +87,9 @@ func Provider() *schema.Provider {
 				Description: "Configure direct access using Kubernetes API server.\n\n  " +
 					"**Warning**: this feature works by starting a local ArgoCD API server that talks directly to the Kubernetes API using the **current context " +
 					"in the default kubeconfig** (`~/.kube/config`). This behavior cannot be overridden using either environment variables or the `kubernetes` block " +
-					"in the provider configuration at present).\n\n  If the server fails to start (e.g. your kubeconfig is misconfigured) then the provider will " +
+					"in the provider configuration at present).\n\n  Resources which manage Kubernetes objects directly, e.g. the ConfigMaps holding the settings of ArgoCD or the secrets " +
+					"managed through `direct_secret`, manage them in the namespace of this context, which hence has to be the namespace ArgoCD is installed in.\n\n  " +
+					"If the server fails to start (e.g. your kubeconfig is misconfigured) then the provider will " +
 					"fail as a result of the `argocd` module forcing it to exit and no logs will be available to help you debug this. The error message will be " +
 					"similar to\n  > `The plugin encountered an error, and failed to respond to the plugin.(*GRPCProvider).ReadResource call. The plugin logs may " +
 					"contain more details.`\n\n  To debug this, you will need to login via the ArgoCD CLI using `argocd login --core` and then running an operation. " +
@@ -4,23 +4,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/tokensecret"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/cristalhq/jwt/v5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceArgoCDAccountToken() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Manages ArgoCD [account](https://argo-cd.readthedocs.io/en/latest/user-guide/commands/argocd_account/) JWT tokens.\n\n~> **Security Notice** The JWT token generated by this resource is treated as sensitive and, thus, not displayed in console output. However, it will be stored *unencrypted* in your Terraform state file. Read more about sensitive data handling in the [Terraform documentation](https://www.terraform.io/docs/language/state/sensitive-data.html).\n",
+		Description:   "Manages ArgoCD [account](https://argo-cd.readthedocs.io/en/latest/user-guide/commands/argocd_account/) JWT tokens.\n\nExisting tokens can be imported to track their expiry and have them regenerated based on `renew_after` and `renew_before`. As the JWT cannot be recovered, `jwt` is empty for imported tokens until they are regenerated, e.g. with `terraform apply -replace`.\n\n~> **Security Notice** The JWT token generated by this resource is treated as sensitive and, thus, not displayed in console output. However, it will be stored *unencrypted* in your Terraform state file, unless `store_jwt` is disabled and the token is handed over through `kubernetes_secret` instead. Read more about sensitive data handling in the [Terraform documentation](https://www.terraform.io/docs/language/state/sensitive-data.html).\n",
 		CreateContext: resourceArgoCDAccountTokenCreate,
 		ReadContext:   resourceArgoCDAccountTokenRead,
 		UpdateContext: resourceArgoCDAccountTokenUpdate,
 		DeleteContext: resourceArgoCDAccountTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArgoCDAccountTokenImport,
+		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 			ia := d.Get("issued_at").(string)
 			if ia == "" {
@@ -83,6 +91,17 @@ func resourceArgoCDAccountToken() *schema.Resource {
 				return fmt.Errorf("invalid renew_before: %w", err)
 			}
 
+			// Spread the renewal of tokens sharing the same renew_before across
+			// renew_jitter, so that they do not all rotate in the same apply
+			if rj, ok := d.GetOk("renew_jitter"); ok {
+				renewJitterDuration, err := time.ParseDuration(rj.(string))
+				if err != nil {
+					return fmt.Errorf("invalid renew_jitter: %w", err)
+				}
+
+				renewBeforeDuration += tokenRenewalJitter(d.Id(), renewJitterDuration)
+			}
+
 			if expiresAt-time.Now().Unix() < int64(renewBeforeDuration.Seconds()) {
 				// Token will expire within renewBeforeDuration - force recreation
 				if err := d.SetNewComputed("issued_at"); err != nil {
@@ -106,6 +125,18 @@ func resourceArgoCDAccountToken() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateDuration,
+				// Imported tokens carry the lifetime derived from their claims,
+				// e.g. `2160h0m0s`, which must not cause them to be replaced
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					o, err := time.ParseDuration(oldValue)
+					if err != nil {
+						return false
+					}
+
+					n, err := time.ParseDuration(newValue)
+
+					return err == nil && o == n
+				},
 			},
 			"renew_after": {
 				Type:         schema.TypeString,
@@ -120,12 +151,75 @@ func resourceArgoCDAccountToken() *schema.Resource {
 				ValidateFunc: validateDuration,
 				RequiredWith: []string{"expires_in"},
 			},
+			"renew_jitter": {
+				Type:         schema.TypeString,
+				Description:  "Duration by which the regeneration of the token based on `renew_before` is brought forward at most, so that tokens sharing the same `renew_before` are not all regenerated in the same apply. The token is regenerated if `expires_at - currentDate < renew_before + jitter`, where `jitter` is derived from the token ID and lies between zero and `renew_jitter`, so it remains the same across plans. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				RequiredWith: []string{"renew_before"},
+			},
+			"wait_for_propagation": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait until ArgoCD accepts the token before it is returned, for up to 2 minutes. Newly issued tokens may take a moment to be accepted by all replicas of the API server in highly available setups, causing requests made right away with them to fail.",
+				Optional:    true,
+			},
+			"warn_before": {
+				Type:         schema.TypeString,
+				Description:  "Duration to control warnings about the upcoming expiry of the token. If set, Terraform will emit a warning when refreshing the token if `expires_at - currentDate < warn_before`, e.g. to notice expiring tokens which are not regenerated through `renew_before`. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
 			"jwt": {
 				Type:        schema.TypeString,
-				Description: "The raw JWT.",
+				Description: "The raw JWT. Empty if `store_jwt` is disabled.",
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"store_jwt": {
+				Type:        schema.TypeBool,
+				Description: "Whether the JWT is stored in the state. If disabled, the token can only be consumed through `kubernetes_secret`. Changing this issues a new token, as the previous one may already have been stored. Default: `true`.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				// Tokens created before store_jwt was introduced do not carry
+				// it in their state, which must not cause them to be replaced
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return oldValue == "" && newValue == "true"
+				},
+			},
+			"kubernetes_secret": {
+				Type:        schema.TypeList,
+				Description: "Kubernetes secret the JWT is written to, e.g. to hand it over to workloads without storing it in the state in combination with `store_jwt = false`. The secret is created if it does not exist, and deleted again once it no longer holds any token. Only the given key is modified within existing secrets. Requires the provider to be configured with `core = true`. Changing this issues a new token.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "Name of the secret.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Description:  "Namespace of the secret. Defaults to the namespace ArgoCD is managed in, see [`core`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core).",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "Key the JWT is stored under. Default: `token`.",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "token",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 			"issued_at": {
 				Type:        schema.TypeString,
 				Description: "Unix timestamp at which the token was issued.",
@@ -193,6 +287,10 @@ func resourceArgoCDAccountTokenCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if diags := validateAccountTokenRenewJitter(d, expiresIn); diags != nil {
+		return diags
+	}
+
 	tokenMutexSecrets.Lock()
 	resp, err := si.AccountClient.CreateToken(ctx, opts)
 	tokenMutexSecrets.Unlock()
@@ -231,8 +329,32 @@ func resourceArgoCDAccountTokenCreate(ctx context.Context, d *schema.ResourceDat
 		return errorToDiagnostics(fmt.Sprintf("token claims issue date for account %s could not be persisted to state", accountName), err)
 	}
 
-	if err := d.Set("jwt", token.String()); err != nil {
-		return errorToDiagnostics(fmt.Sprintf("token for account %s could not be persisted to state", accountName), err)
+	var diags diag.Diagnostics
+
+	if d.Get("wait_for_propagation").(bool) {
+		diags = waitForAccountTokenPropagation(ctx, si, accountName, token.String())
+	}
+
+	if diags == nil {
+		diags = writeAccountTokenSecret(ctx, si, d, token.String())
+	}
+
+	if diags != nil {
+		// Revoke the token, as it could not be handed over
+		tokenMutexSecrets.Lock()
+		_, _ = si.AccountClient.DeleteToken(ctx, &account.DeleteTokenRequest{
+			Name: accountName,
+			Id:   claims.ID,
+		})
+		tokenMutexSecrets.Unlock()
+
+		return diags
+	}
+
+	if d.Get("store_jwt").(bool) {
+		if err := d.Set("jwt", token.String()); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("token for account %s could not be persisted to state", accountName), err)
+		}
 	}
 
 	d.SetId(claims.ID)
@@ -267,7 +389,25 @@ func resourceArgoCDAccountTokenRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	return nil
+	wb, ok := d.GetOk("warn_before")
+	if !ok {
+		return nil
+	}
+
+	warnBefore, err := time.ParseDuration(wb.(string))
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("token warning duration (%s) for account %s could not be parsed", wb.(string), accountName), err)
+	}
+
+	var expiresAt int64
+
+	if ea := d.Get("expires_at").(string); ea != "" {
+		if expiresAt, err = convertStringToInt64(ea); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("token expiration date (%s) for account %s could not be parsed", ea, accountName), err)
+		}
+	}
+
+	return tokenExpiryWarning(fmt.Sprintf("Token %s of account %s", d.Id(), accountName), expiresAt, warnBefore)
 }
 
 func resourceArgoCDAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -307,6 +447,10 @@ func resourceArgoCDAccountTokenUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if diags := validateAccountTokenRenewJitter(d, expiresIn); diags != nil {
+		return diags
+	}
+
 	return resourceArgoCDAccountTokenRead(ctx, d, meta)
 }
 
@@ -332,11 +476,256 @@ func resourceArgoCDAccountTokenDelete(ctx context.Context, d *schema.ResourceDat
 		return argoCDAPIError("delete", "token for account", accountName, err)
 	}
 
+	if diags := deleteAccountTokenSecret(ctx, si, d); diags != nil {
+		return diags
+	}
+
 	d.SetId("")
 
 	return nil
 }
 
+// resourceArgoCDAccountTokenImport imports a token of the form `{account}:{id}`,
+// or `{id}` for tokens of the current account. The JWT itself cannot be
+// recovered, only the metadata of the token is imported.
+func resourceArgoCDAccountTokenImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountName, id, err := parseAccountTokenID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	si := meta.(*ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return nil, fmt.Errorf("failed to initialize API clients: %v", diags)
+	}
+
+	if err = d.Set("account", accountName); err != nil {
+		return nil, fmt.Errorf("failed to set account: %w", err)
+	}
+
+	accountName, err = getAccount(ctx, si, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tokens of the account are stored in `argocd-secret`
+	tokenMutexSecrets.RLock()
+	a, err := si.AccountClient.GetAccount(ctx, &account.GetAccountRequest{
+		Name: accountName,
+	})
+	tokenMutexSecrets.RUnlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read account %s: %w", accountName, err)
+	}
+
+	var token *account.Token
+
+	for _, t := range a.Tokens {
+		if t.Id == id {
+			token = t
+			break
+		}
+	}
+
+	if token == nil {
+		return nil, fmt.Errorf("token %s does not exist for account %s", id, accountName)
+	}
+
+	if err = d.Set("issued_at", convertInt64ToString(token.IssuedAt)); err != nil {
+		return nil, fmt.Errorf("failed to set issued_at: %w", err)
+	}
+
+	if err = d.Set("store_jwt", true); err != nil {
+		return nil, fmt.Errorf("failed to set store_jwt: %w", err)
+	}
+
+	if token.ExpiresAt > 0 {
+		if err = d.Set("expires_at", convertInt64ToString(token.ExpiresAt)); err != nil {
+			return nil, fmt.Errorf("failed to set expires_at: %w", err)
+		}
+
+		expiresIn := time.Duration(token.ExpiresAt-token.IssuedAt) * time.Second
+
+		if err = d.Set("expires_in", expiresIn.String()); err != nil {
+			return nil, fmt.Errorf("failed to set expires_in: %w", err)
+		}
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseAccountTokenID splits an account token import ID of the form
+// `{account}:{id}` into its components. A plain `{id}` refers to a token of
+// the current account.
+func parseAccountTokenID(id string) (accountName, tokenID string, err error) {
+	ids := strings.Split(id, ":")
+	if len(ids) > 2 || ids[len(ids)-1] == "" {
+		return "", "", fmt.Errorf("invalid account token ID %q, expected format `{account}:{id}` or `{id}`", id)
+	}
+
+	if len(ids) == 2 {
+		accountName = ids[0]
+	}
+
+	return accountName, ids[len(ids)-1], nil
+}
+
+// accountTokenSecret returns the Kubernetes secret configured through
+// `kubernetes_secret`, if any, along with a client to access it.
+func accountTokenSecret(si *ServerInterface, d *schema.ResourceData) (kc kubernetes.Interface, namespace, name, key string, diags diag.Diagnostics) {
+	secrets := d.Get("kubernetes_secret").([]interface{})
+	if len(secrets) == 0 || secrets[0] == nil {
+		return nil, "", "", "", nil
+	}
+
+	s := secrets[0].(map[string]interface{})
+
+	kc, namespace, err := si.KubernetesClient()
+	if err != nil {
+		return nil, "", "", "", errorToDiagnostics("failed to initialize Kubernetes client", err)
+	}
+
+	if ns := s["namespace"].(string); ns != "" {
+		namespace = ns
+	}
+
+	return kc, namespace, s["name"].(string), s["key"].(string), nil
+}
+
+// writeAccountTokenSecret writes the token into the configured Kubernetes
+// secret, if any.
+func writeAccountTokenSecret(ctx context.Context, si *ServerInterface, d *schema.ResourceData, token string) diag.Diagnostics {
+	kc, namespace, name, key, diags := accountTokenSecret(si, d)
+	if diags != nil || kc == nil {
+		return diags
+	}
+
+	if err := tokensecret.Write(ctx, kc, namespace, name, key, token); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to write token to secret %s/%s", namespace, name), err)
+	}
+
+	return nil
+}
+
+// deleteAccountTokenSecret removes the token from the configured Kubernetes
+// secret, if any.
+func deleteAccountTokenSecret(ctx context.Context, si *ServerInterface, d *schema.ResourceData) diag.Diagnostics {
+	kc, namespace, name, key, diags := accountTokenSecret(si, d)
+	if diags != nil || kc == nil {
+		return diags
+	}
+
+	if err := tokensecret.Delete(ctx, kc, namespace, name, key, d.Id()); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to remove token from secret %s/%s", namespace, name), err)
+	}
+
+	return nil
+}
+
+// waitForAccountTokenPropagation waits until ArgoCD accepts the token issued
+// for the given account, as tokens may take a moment to be accepted by all
+// replicas of the API server.
+func waitForAccountTokenPropagation(ctx context.Context, si *ServerInterface, accountName, token string) diag.Diagnostics {
+	timeout := 2 * time.Minute
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := si.VerifyToken(ctx, token); err != nil {
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("token for account %s was not accepted by ArgoCD within %s", accountName, timeout), err)
+	}
+
+	return nil
+}
+
+// validateAccountTokenRenewJitter ensures that the token is not regenerated
+// right after its creation, which would be the case if renew_before and
+// renew_jitter add up to more than expires_in.
+func validateAccountTokenRenewJitter(d *schema.ResourceData, expiresIn int64) diag.Diagnostics {
+	rj, ok := d.GetOk("renew_jitter")
+	if !ok {
+		return nil
+	}
+
+	renewJitterDuration, err := time.ParseDuration(rj.(string))
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("token renewal jitter (%s) could not be parsed", rj.(string)), err)
+	}
+
+	renewBeforeDuration, err := time.ParseDuration(d.Get("renew_before").(string))
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("token renewal duration (%s) could not be parsed", d.Get("renew_before").(string)), err)
+	}
+
+	renewBefore := int64(renewBeforeDuration.Seconds())
+	renewJitter := int64(renewJitterDuration.Seconds())
+
+	if renewBefore+renewJitter > expiresIn {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("renew_before (%d) plus renew_jitter (%d) cannot be greater than expires_in (%d) for account token", renewBefore, renewJitter, expiresIn),
+			},
+		}
+	}
+
+	return nil
+}
+
+// tokenExpiryWarning returns a warning if the token described by name expires
+// within warnBefore, so that expiring tokens are noticed before they are no
+// longer accepted by ArgoCD. Tokens which do not expire are ignored.
+func tokenExpiryWarning(name string, expiresAt int64, warnBefore time.Duration) diag.Diagnostics {
+	if expiresAt <= 0 {
+		return nil
+	}
+
+	expiry := time.Unix(expiresAt, 0)
+	remaining := time.Until(expiry)
+
+	switch {
+	case remaining <= 0:
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  "Token Expired",
+				Detail:   fmt.Sprintf("%s expired at %s.", name, expiry.UTC().Format(time.RFC3339)),
+			},
+		}
+	case remaining < warnBefore:
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  "Token Expiring Soon",
+				Detail:   fmt.Sprintf("%s expires at %s, i.e. in %s.", name, expiry.UTC().Format(time.RFC3339), remaining.Truncate(time.Second)),
+			},
+		}
+	}
+
+	return nil
+}
+
+// tokenRenewalJitter returns a duration between zero and jitter which is
+// derived from the token ID, so that it remains the same across plans while
+// differing between tokens.
+func tokenRenewalJitter(id string, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+
+	return time.Duration(h.Sum64()%uint64(jitter.Seconds()+1)) * time.Second
+}
+
 func getAccount(ctx context.Context, si *ServerInterface, d *schema.ResourceData) (string, error) {
 	accountName := d.Get("account").(string)
 	if len(accountName) > 0 {
@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,6 +71,120 @@ func TestAccArgoCDAccountToken_ExplicitAccount(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDAccountToken_Import(t *testing.T) {
+	resourceName := "argocd_account_token.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccountToken_ImportExpiry(),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "test:" + s.RootModule().Resources[resourceName].Primary.ID, nil
+				},
+				// The JWT cannot be recovered, and the lifetime is derived from
+				// the claims of the token
+				ImportStateVerifyIgnore: []string{"jwt", "expires_in"},
+				ImportStatePersist:      true,
+			},
+			{
+				Config: testAccArgoCDAccountToken_ImportExpiry(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expires_in", "12h0m0s"),
+					resource.TestCheckResourceAttr(resourceName, "jwt", ""),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "test:does-not-exist",
+				ExpectError:   regexp.MustCompile("token does-not-exist does not exist for account test"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDAccountToken_StoreJWT(t *testing.T) {
+	resourceName := "argocd_account_token.store_jwt"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccountToken_StoreJWT(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_jwt", "false"),
+					resource.TestCheckResourceAttr(resourceName, "jwt", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "issued_at"),
+				),
+			},
+			{
+				Config: testAccArgoCDAccountToken_StoreJWT(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_jwt", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "jwt"),
+				),
+			},
+			{
+				Config:      testAccArgoCDAccountToken_KubernetesSecret(),
+				ExpectError: regexp.MustCompile("configured with `core = true`"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDAccountToken_WaitForPropagation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_account_token" "wait" {
+	account              = "test"
+	wait_for_propagation = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("argocd_account_token.wait", "jwt"),
+					testCheckTokenIssuedAt("argocd_account_token.wait"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseAccountTokenID(t *testing.T) {
+	t.Parallel()
+
+	accountName, id, err := parseAccountTokenID("image-updater:6f6e8c71")
+	assert.NoError(t, err)
+	assert.Equal(t, "image-updater", accountName)
+	assert.Equal(t, "6f6e8c71", id)
+
+	accountName, id, err = parseAccountTokenID("6f6e8c71")
+	assert.NoError(t, err)
+	assert.Empty(t, accountName)
+	assert.Equal(t, "6f6e8c71", id)
+
+	for _, invalid := range []string{"", "image-updater:", "a:b:c"} {
+		_, _, err = parseAccountTokenID(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
 func TestAccArgoCDAccountToken_Multiple(t *testing.T) {
 	count := 3 + rand.Intn(7)
 
@@ -133,10 +249,65 @@ func TestAccArgoCDAccountToken_RenewBefore(t *testing.T) {
 				Config:      testAccArgoCDAccountTokenRenewBeforeFailure(expiresInDuration),
 				ExpectError: regexp.MustCompile("renew_before .* cannot be greater than expires_in .*"),
 			},
+			{
+				Config: `
+resource "argocd_account_token" "renew_before" {
+	expires_in   = "30s"
+	renew_before = "20s"
+	renew_jitter = "11s"
+}
+`,
+				ExpectError: regexp.MustCompile("renew_before .* plus renew_jitter .* cannot be greater than expires_in .*"),
+			},
 		},
 	})
 }
 
+func TestTokenRenewalJitter(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), tokenRenewalJitter("6f6e8c71-4d0b-4b2b-9d8c-0e7b2e1d3c55", 0))
+
+	// Jitter is the same across plans, but differs between tokens
+	seen := make(map[time.Duration]bool)
+
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("token-%d", i)
+		jitter := tokenRenewalJitter(id, time.Hour)
+
+		assert.GreaterOrEqual(t, jitter, time.Duration(0))
+		assert.LessOrEqual(t, jitter, time.Hour)
+		assert.Equal(t, jitter, tokenRenewalJitter(id, time.Hour))
+
+		seen[jitter] = true
+	}
+
+	assert.Greater(t, len(seen), 1)
+}
+
+func TestTokenExpiryWarning(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	// Tokens which do not expire, or not within warn_before, are ignored
+	assert.Empty(t, tokenExpiryWarning("Token foo", 0, time.Hour))
+	assert.Empty(t, tokenExpiryWarning("Token foo", now.Add(2*time.Hour).Unix(), time.Hour))
+
+	diags := tokenExpiryWarning("Token foo", now.Add(30*time.Minute).Unix(), time.Hour)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Token Expiring Soon", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "Token foo expires at")
+	}
+
+	diags = tokenExpiryWarning("Token foo", now.Add(-time.Minute).Unix(), time.Hour)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Token Expired", diags[0].Summary)
+	}
+}
+
 func TestAccArgoCDAccountToken_RenewAfter(t *testing.T) {
 	resourceName := "argocd_account_token.renew_after"
 	renewAfterSeconds := 30
@@ -190,6 +361,37 @@ resource "argocd_account_token" "this" {
 `
 }
 
+func testAccArgoCDAccountToken_ImportExpiry() string {
+	return `
+resource "argocd_account_token" "this" {
+	account    = "test"
+	expires_in = "12h"
+}
+`
+}
+
+func testAccArgoCDAccountToken_StoreJWT(storeJWT bool) string {
+	return fmt.Sprintf(`
+resource "argocd_account_token" "store_jwt" {
+	account   = "test"
+	store_jwt = %t
+}
+`, storeJWT)
+}
+
+func testAccArgoCDAccountToken_KubernetesSecret() string {
+	return `
+resource "argocd_account_token" "store_jwt" {
+	account   = "test"
+	store_jwt = false
+
+	kubernetes_secret {
+		name = "account-token"
+	}
+}
+`
+}
+
 func testAccArgoCDAccountToken_Multiple(count int) string {
 	return fmt.Sprintf(`
 resource "argocd_account_token" "multiple1a" {
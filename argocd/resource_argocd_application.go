@@ -53,6 +53,12 @@ func resourceArgoCDApplication() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"allow_takeover": {
+				Type:        schema.TypeBool,
+				Description: "Whether to manage an application that is controlled by an application set. By default, creating or updating an application owned by an application set fails, since the application set controller will revert any changes made by this provider.",
+				Optional:    true,
+				Default:     false,
+			},
 			"status": applicationStatusSchema(),
 		},
 		SchemaVersion: 4,
@@ -112,6 +118,10 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		case l < 1:
 			break
 		case l == 1:
+			if diags := applicationSetOwnershipConflict(&apps.Items[0], d); diags != nil {
+				return diags
+			}
+
 			switch apps.Items[0].DeletionTimestamp {
 			case nil:
 			default:
@@ -260,6 +270,16 @@ func resourceArgoCDApplicationRead(ctx context.Context, d *schema.ResourceData,
 		return errorToDiagnostics(fmt.Sprintf("failed to flatten application %s", appName), err)
 	}
 
+	if owner := applicationSetOwner(&apps.Items[0]); owner != nil && !d.Get("allow_takeover").(bool) {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("application %s is owned by application set %s", appName, owner.Name),
+				Detail:   "Changes to this application will be reverted by the application set controller. Manage the application through the application set instead, or set `allow_takeover = true` to manage it regardless.",
+			},
+		}
+	}
+
 	return nil
 }
 
@@ -325,6 +345,12 @@ func resourceArgoCDApplicationUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if len(apps.Items) == 1 {
+		if diags := applicationSetOwnershipConflict(&apps.Items[0], d); diags != nil {
+			return diags
+		}
+	}
+
 	validate := d.Get("validate").(bool)
 	if _, err = si.ApplicationClient.Update(ctx, &applicationClient.ApplicationUpdateRequest{
 		Application: &application.Application{
@@ -437,3 +463,29 @@ func resourceArgoCDApplicationDelete(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+// applicationSetOwner returns the owner reference of the application set
+// controlling the application, if any.
+func applicationSetOwner(app *application.Application) *metav1.OwnerReference {
+	owner := metav1.GetControllerOf(app)
+	if owner == nil || owner.Kind != application.ApplicationSetSchemaGroupVersionKind.Kind {
+		return nil
+	}
+
+	return owner
+}
+
+func applicationSetOwnershipConflict(app *application.Application, d *schema.ResourceData) diag.Diagnostics {
+	owner := applicationSetOwner(app)
+	if owner == nil || d.Get("allow_takeover").(bool) {
+		return nil
+	}
+
+	return []diag.Diagnostic{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("application %s is owned by application set %s", app.Name, owner.Name),
+			Detail:   "The application set controller manages this application and will revert any changes made by this provider. Manage the application through the application set instead, or set `allow_takeover = true` to manage it regardless.",
+		},
+	}
+}
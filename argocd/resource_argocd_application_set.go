@@ -3,13 +3,19 @@ package argocd
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	applicationClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -20,12 +26,44 @@ func resourceArgoCDApplicationSet() *schema.Resource {
 		ReadContext:   resourceArgoCDApplicationSetRead,
 		UpdateContext: resourceArgoCDApplicationSetUpdate,
 		DeleteContext: resourceArgoCDApplicationSetDelete,
+		CustomizeDiff: resourceArgoCDApplicationSetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDApplicationSetImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("applicationsets.argoproj.io"),
 			"spec":     applicationSetSpecSchemaV1(),
+			"status":   applicationSetStatusSchema(),
+			"generated_application_names": {
+				Type:        schema.TypeList,
+				Description: "Names of the applications that the application set generators are expected to produce, as reported by the ArgoCD dry-run generation API. Previewed at plan time when `metadata` or `spec` change, otherwise only computed if missing from state, e.g. on import or when the preview failed. Requires ArgoCD 2.11.0 or later; unknown until apply if the preview could not be computed.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_applications": {
+				Type:        schema.TypeList,
+				Description: "Upon application set creation or update, wait for the generated applications to exist (and optionally to be healthy). Wait timeouts are controlled by Terraform Create and Update resource timeouts (both default to 5 minutes).",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Description:  "Number of applications that are expected to be generated. When omitted, waits until at least one application has been generated.",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Description: "Whether to also wait for all generated applications to be healthy.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -35,6 +73,10 @@ func resourceArgoCDApplicationSet() *schema.Resource {
 				Version: 0,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -96,7 +138,11 @@ func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", as.Name, objectMeta.Namespace))
+	d.SetId(fmt.Sprintf("%s:%s", as.Name, as.Namespace))
+
+	if diags := waitForApplicationSetApplications(ctx, si, d, as, d.Timeout(schema.TimeoutCreate)); diags != nil {
+		return diags
+	}
 
 	return resourceArgoCDApplicationSetRead(ctx, d, meta)
 }
@@ -107,9 +153,10 @@ func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceDat
 		return pluginSDKDiags(diags)
 	}
 
-	ids := strings.Split(d.Id(), ":")
-	appSetName := ids[0]
-	namespace := ids[1]
+	appSetName, namespace, err := parseApplicationSetID(d.Id())
+	if err != nil {
+		return errorToDiagnostics("failed to parse application set ID", err)
+	}
 
 	appSet, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
 		Name:            appSetName,
@@ -124,11 +171,33 @@ func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceDat
 		return argoCDAPIError("read", "application set", appSetName, err)
 	}
 
+	// Resolve the control plane namespace for IDs that omit it (e.g. on import)
+	d.SetId(fmt.Sprintf("%s:%s", appSet.Name, appSet.Namespace))
+
 	err = flattenApplicationSet(appSet, d)
 	if err != nil {
 		return errorToDiagnostics(fmt.Sprintf("failed to flatten application set %s", appSetName), err)
 	}
 
+	// The names previewed at plan time are retained, the application set is
+	// only generated if they are missing, e.g. on import, as generators may
+	// be costly to evaluate on every refresh. Generating is best effort, the
+	// names stay empty if it fails (e.g. due to insufficient permissions).
+	if si.IsFeatureSupported(features.ApplicationSetGenerate) && applicationSetGeneratedNamesMissing(d) {
+		objectMeta := metav1.ObjectMeta{
+			Name:        appSet.Name,
+			Namespace:   appSet.Namespace,
+			Labels:      appSet.Labels,
+			Annotations: appSet.Annotations,
+		}
+
+		if names, err := generateApplicationSetApplicationNames(ctx, si, objectMeta, appSet.Spec); err == nil {
+			if err = d.Set("generated_application_names", names); err != nil {
+				return errorToDiagnostics(fmt.Sprintf("failed to set generated application names of application set %s", appSetName), err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -169,7 +238,11 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return featureNotSupported(features.ApplicationSetApplicationsSyncPolicy)
 	}
 
-	_, err = si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
+	if !si.IsFeatureSupported(features.ApplicationSetTemplatePatch) && spec.TemplatePatch != nil {
+		return featureNotSupported(features.ApplicationSetTemplatePatch)
+	}
+
+	as, err := si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
 		Applicationset: &application.ApplicationSet{
 			ObjectMeta: objectMeta,
 			Spec:       spec,
@@ -185,6 +258,10 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return argoCDAPIError("update", "application set", objectMeta.Name, err)
 	}
 
+	if diags := waitForApplicationSetApplications(ctx, si, d, as, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+		return diags
+	}
+
 	return resourceArgoCDApplicationSetRead(ctx, d, meta)
 }
 
@@ -194,9 +271,10 @@ func resourceArgoCDApplicationSetDelete(ctx context.Context, d *schema.ResourceD
 		return pluginSDKDiags(diags)
 	}
 
-	ids := strings.Split(d.Id(), ":")
-	appSetName := ids[0]
-	namespace := ids[1]
+	appSetName, namespace, err := parseApplicationSetID(d.Id())
+	if err != nil {
+		return errorToDiagnostics("failed to parse application set ID", err)
+	}
 
 	if _, err := si.ApplicationSetClient.Delete(ctx, &applicationset.ApplicationSetDeleteRequest{
 		Name:            appSetName,
@@ -209,3 +287,197 @@ func resourceArgoCDApplicationSetDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// waitForApplicationSetApplications blocks until the applications generated by
+// the application set exist (and are healthy, if requested) as configured via
+// `wait_for_applications`.
+func waitForApplicationSetApplications(ctx context.Context, si *ServerInterface, d *schema.ResourceData, as *application.ApplicationSet, timeout time.Duration) diag.Diagnostics {
+	w, ok := d.Get("wait_for_applications").([]interface{})
+	if !ok || len(w) == 0 || w[0] == nil {
+		return nil
+	}
+
+	wait := w[0].(map[string]interface{})
+	count := wait["count"].(int)
+	healthy := wait["healthy"].(bool)
+
+	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		list, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
+			AppNamespace: &as.Namespace,
+		})
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error while listing applications: %w", err))
+		}
+
+		generated := 0
+
+		for _, app := range list.Items {
+			if !metav1.IsControlledBy(&app, as) {
+				continue
+			}
+
+			generated++
+
+			if healthy && app.Status.Health.Status != health.HealthStatusHealthy {
+				return retry.RetryableError(fmt.Errorf("expected application %s health status to be healthy but was %s", app.Name, app.Status.Health.Status))
+			}
+		}
+
+		switch {
+		case count == 0 && generated == 0:
+			return retry.RetryableError(fmt.Errorf("expected at least one generated application but found none"))
+		case generated < count:
+			return retry.RetryableError(fmt.Errorf("expected %d generated applications but found %d", count, generated))
+		}
+
+		return nil
+	}); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("error while waiting for applications of application set %s", as.Name), err)
+	}
+
+	return nil
+}
+
+func resourceArgoCDApplicationSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateApplicationSetTemplateSources(d); err != nil {
+		return err
+	}
+
+	if raw := d.GetRawConfig(); !raw.IsNull() && raw.IsWhollyKnown() {
+		if spec, ok := d.Get("spec.0").(map[string]interface{}); ok {
+			if err := validateApplicationSetGeneratedNames(spec); err != nil {
+				return fmt.Errorf("invalid application set generators: %w", err)
+			}
+		}
+	}
+
+	if d.Id() != "" && !d.HasChanges("metadata", "spec") {
+		return nil
+	}
+
+	return previewApplicationSetApplicationNames(ctx, d, meta.(*ServerInterface))
+}
+
+func validateApplicationSetTemplateSources(d *schema.ResourceDiff) error {
+	sources, ok := d.Get("spec.0.template.0.spec.0.source").([]interface{})
+	if !ok || len(sources) < 2 {
+		return nil
+	}
+
+	for i := range sources {
+		if !d.NewValueKnown(fmt.Sprintf("spec.0.template.0.spec.0.source.%d.ref", i)) {
+			// Refs will only be known at apply time
+			return nil
+		}
+	}
+
+	if err := validateApplicationSourceRefs(sources); err != nil {
+		return fmt.Errorf("invalid template sources: %w", err)
+	}
+
+	return nil
+}
+
+// previewApplicationSetApplicationNames populates
+// `generated_application_names` using the ArgoCD dry-run generation API. The
+// preview is best effort: whenever it cannot be computed (e.g. unknown values
+// in the configuration, unsupported ArgoCD version or insufficient
+// permissions) the attribute is marked as known after apply.
+func previewApplicationSetApplicationNames(ctx context.Context, d *schema.ResourceDiff, si *ServerInterface) error {
+	if raw := d.GetRawConfig(); raw.IsNull() || !raw.IsWhollyKnown() {
+		return d.SetNewComputed("generated_application_names")
+	}
+
+	if diags := si.InitClients(ctx); diags != nil || !si.IsFeatureSupported(features.ApplicationSetGenerate) {
+		return d.SetNewComputed("generated_application_names")
+	}
+
+	objectMeta, spec, err := expandApplicationSet(
+		d,
+		si.IsFeatureSupported(features.MultipleApplicationSources),
+		si.IsFeatureSupported(features.ApplicationSetIgnoreApplicationDifferences),
+		si.IsFeatureSupported(features.ApplicationSetTemplatePatch),
+		si.IsFeatureSupported(features.ApplicationSourceName),
+	)
+	if err != nil {
+		return d.SetNewComputed("generated_application_names")
+	}
+
+	names, err := generateApplicationSetApplicationNames(ctx, si, objectMeta, spec)
+	if err != nil {
+		return d.SetNewComputed("generated_application_names")
+	}
+
+	return d.SetNew("generated_application_names", names)
+}
+
+// applicationSetGeneratedNamesMissing returns whether
+// `generated_application_names` is neither in state (e.g. on import or
+// create) nor has been previewed at plan time.
+func applicationSetGeneratedNamesMissing(d *schema.ResourceData) bool {
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() || state.GetAttr("generated_application_names").IsNull() {
+		return true
+	}
+
+	plan := d.GetRawPlan()
+
+	return !plan.IsNull() && plan.IsKnown() && !plan.GetAttr("generated_application_names").IsKnown()
+}
+
+// generateApplicationSetApplicationNames returns the sorted names of the
+// applications which the generators of the given application set produce,
+// using the ArgoCD dry-run generation API.
+func generateApplicationSetApplicationNames(ctx context.Context, si *ServerInterface, objectMeta metav1.ObjectMeta, spec application.ApplicationSetSpec) ([]string, error) {
+	resp, err := si.ApplicationSetClient.Generate(ctx, &applicationset.ApplicationSetGenerateRequest{
+		ApplicationSet: &application.ApplicationSet{
+			ObjectMeta: objectMeta,
+			Spec:       spec,
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ApplicationSet",
+				APIVersion: "argoproj.io/v1alpha1",
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resp.GetApplications()))
+	for _, app := range resp.GetApplications() {
+		if app != nil {
+			names = append(names, app.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func resourceArgoCDApplicationSetImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	name, namespace, err := parseApplicationSetID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, namespace))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseApplicationSetID splits an application set ID of the form
+// `{name}:{namespace}` into its components. A plain `{name}`, or an empty
+// namespace, refers to the Argo CD control plane namespace.
+func parseApplicationSetID(id string) (name, namespace string, err error) {
+	ids := strings.Split(id, ":")
+	if len(ids) > 2 || ids[0] == "" {
+		return "", "", fmt.Errorf("invalid application set ID %q, expected format `{name}:{namespace}` or `{name}`", id)
+	}
+
+	if len(ids) == 2 {
+		namespace = ids[1]
+	}
+
+	return ids[0], namespace, nil
+}
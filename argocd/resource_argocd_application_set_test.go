@@ -18,16 +18,27 @@ func TestAccArgoCDApplicationSet_clusters(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccArgoCDApplicationSet_clusters(),
-				Check: resource.TestCheckResourceAttrSet(
-					"argocd_application_set.clusters",
-					"metadata.0.uid",
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.clusters",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.clusters",
+						"status.#",
+						"1",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.clusters",
+						"status.0.resources_count",
+					),
 				),
 			},
 			{
 				ResourceName:            "argocd_application_set.clusters",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -55,7 +66,7 @@ func TestAccArgoCDApplicationSet_clustersSelector(t *testing.T) {
 				ResourceName:            "argocd_application_set.clusters_selector",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -91,7 +102,7 @@ func TestAccArgoCDApplicationSet_clusterDecisionResource(t *testing.T) {
 				ResourceName:            "argocd_application_set.cluster_decision_resource",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -127,7 +138,7 @@ func TestAccArgoCDApplicationSet_gitDirectories(t *testing.T) {
 				ResourceName:            "argocd_application_set.git_directories",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -160,7 +171,7 @@ func TestAccArgoCDApplicationSet_gitFiles(t *testing.T) {
 				ResourceName:            "argocd_application_set.git_files",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -196,7 +207,7 @@ func TestAccArgoCDApplicationSet_plugin(t *testing.T) {
 				ResourceName:            "argocd_application_set.plugin",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -207,6 +218,10 @@ func TestAccArgoCDApplicationSet_list(t *testing.T) {
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_listDuplicateNames(),
+				ExpectError: regexp.MustCompile(`generators render duplicate application names: "engineering-dev-guestbook"`),
+			},
 			{
 				Config: testAccArgoCDApplicationSet_list(),
 				Check: resource.ComposeTestCheckFunc(
@@ -228,7 +243,91 @@ func TestAccArgoCDApplicationSet_list(t *testing.T) {
 				ResourceName:            "argocd_application_set.list",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+			{
+				ResourceName:            "argocd_application_set.list",
+				ImportState:             true,
+				ImportStateId:           "list",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_waitForApplications(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_waitForApplications(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.wait_for_applications",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait_for_applications",
+						"wait_for_applications.0.count",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait_for_applications",
+						"status.0.resources.#",
+						"2",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.wait_for_applications",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "wait_for_applications"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_generatedApplicationNames(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetGenerate) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_generatedApplicationNames(`"staging", "production"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generated_names",
+						"generated_application_names.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generated_names",
+						"generated_application_names.0",
+						"generated-names-production",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generated_names",
+						"generated_application_names.1",
+						"generated-names-staging",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_generatedApplicationNames(`"staging"`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application_set.generated_names",
+					"generated_application_names.#",
+					"1",
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.generated_names",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -239,6 +338,10 @@ func TestAccArgoCDApplicationSet_listElementsYaml(t *testing.T) {
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_listElementsYamlInvalid(),
+				ExpectError: regexp.MustCompile("must be a YAML list of objects"),
+			},
 			{
 				Config: testAccArgoCDApplicationSet_listElementsYaml(),
 				Check: resource.ComposeTestCheckFunc(
@@ -257,7 +360,7 @@ func TestAccArgoCDApplicationSet_listElementsYaml(t *testing.T) {
 				ResourceName:            "argocd_application_set.list_elements_yaml",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -289,7 +392,41 @@ func TestAccArgoCDApplicationSet_matrix(t *testing.T) {
 				ResourceName:            "argocd_application_set.matrix",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_generatorSelector(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_generatorSelector(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.generator_selector",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generator_selector",
+						"spec.0.generator.0.selector.0.match_labels.env",
+						"prod",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generator_selector",
+						"spec.0.generator.1.matrix.0.generator.0.selector.0.match_expressions.0.key",
+						"env",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.generator_selector",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -329,7 +466,7 @@ func TestAccArgoCDApplicationSet_matrixPluginGenerator(t *testing.T) {
 				ResourceName:            "argocd_application_set.matrix-plugin_generator",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -363,7 +500,7 @@ func TestAccArgoCDApplicationSet_matrixGitPathParamPrefix(t *testing.T) {
 				ResourceName:            "argocd_application_set.matrix_git_path_param_prefix",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -399,7 +536,7 @@ func TestAccArgoCDApplicationSet_matrixNested(t *testing.T) {
 				ResourceName:            "argocd_application_set.matrix_nested",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -465,7 +602,7 @@ func TestAccArgoCDApplicationSet_merge(t *testing.T) {
 				ResourceName:            "argocd_application_set.merge",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -511,7 +648,7 @@ func TestAccArgoCDApplicationSet_mergeNested(t *testing.T) {
 				ResourceName:            "argocd_application_set.merge_nested",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -540,7 +677,7 @@ func TestAccArgoCDApplicationSet_scmProviderAzureDevOps(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_ado",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -569,7 +706,7 @@ func TestAccArgoCDApplicationSet_scmProviderBitbucketCloud(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_bitbucket_cloud",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -598,7 +735,7 @@ func TestAccArgoCDApplicationSet_scmProviderBitbucketServer(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_bitbucket_server",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -627,7 +764,7 @@ func TestAccArgoCDApplicationSet_scmProviderGitea(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_gitea",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -639,7 +776,11 @@ func TestAccArgoCDApplicationSet_scmProviderGithub(t *testing.T) {
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationSet_scmProviderGithub(),
+				Config:      testAccArgoCDApplicationSet_scmProviderGithub("github/token"),
+				ExpectError: regexp.MustCompile("a valid config key must consist of alphanumeric characters"),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_scmProviderGithub("token"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"argocd_application_set.scm_github",
@@ -656,7 +797,7 @@ func TestAccArgoCDApplicationSet_scmProviderGithub(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_github",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -685,7 +826,7 @@ func TestAccArgoCDApplicationSet_scmProviderGitlab(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_gitlab",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -729,7 +870,7 @@ func TestAccArgoCDApplicationSet_scmProviderWithFilters(t *testing.T) {
 				ResourceName:            "argocd_application_set.scm_filters",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -758,7 +899,7 @@ func TestAccArgoCDApplicationSet_pullRequestBitbucketServer(t *testing.T) {
 				ResourceName:            "argocd_application_set.pr_bitbucket_server",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -787,7 +928,7 @@ func TestAccArgoCDApplicationSet_pullRequestGitea(t *testing.T) {
 				ResourceName:            "argocd_application_set.pr_gitea",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -821,7 +962,7 @@ func TestAccArgoCDApplicationSet_pullRequestGithub(t *testing.T) {
 				ResourceName:            "argocd_application_set.pr_github",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -855,7 +996,7 @@ func TestAccArgoCDApplicationSet_pullRequestGitlab(t *testing.T) {
 				ResourceName:            "argocd_application_set.pr_gitlab",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -899,7 +1040,7 @@ func TestAccArgoCDApplicationSet_pullRequestGitlabInsecureAndCARef(t *testing.T)
 				ResourceName:            "argocd_application_set.pr_gitlab_insecure",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -928,7 +1069,7 @@ func TestAccArgoCDApplicationSet_pullRequestAzureDevOps(t *testing.T) {
 				ResourceName:            "argocd_application_set.pr_azure_devops",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 		},
 	})
@@ -983,7 +1124,41 @@ func TestAccArgoCDApplicationSet_generatorTemplate(t *testing.T) {
 				ResourceName:            "argocd_application_set.generator_template",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_generatorTemplateMetadataOnly(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_generatorTemplateMetadataOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.generator_template_metadata_only",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generator_template_metadata_only",
+						"spec.0.generator.0.list.0.template.0.metadata.0.labels.override",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.generator_template_metadata_only",
+						"spec.0.generator.0.list.0.template.0.spec.#",
+						"0",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.generator_template_metadata_only",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1017,7 +1192,7 @@ func TestAccArgoCDApplicationSet_goTemplate(t *testing.T) {
 				ResourceName:            "argocd_application_set.go_template",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1046,7 +1221,7 @@ func TestAccArgoCDApplicationSet_syncPolicy(t *testing.T) {
 				ResourceName:            "argocd_application_set.sync_policy",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1061,7 +1236,11 @@ func TestAccArgoCDApplicationSet_syncPolicyWithApplicationsSyncPolicy(t *testing
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync(),
+				Config:      testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync("create-everything"),
+				ExpectError: regexp.MustCompile("invalid applications sync policy 'create-everything'"),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync("create-update"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"argocd_application_set.applications_sync_policy",
@@ -1083,7 +1262,7 @@ func TestAccArgoCDApplicationSet_syncPolicyWithApplicationsSyncPolicy(t *testing
 				ResourceName:            "argocd_application_set.applications_sync_policy",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1112,7 +1291,7 @@ func TestAccArgoCDApplicationSet_progressiveSync(t *testing.T) {
 				ResourceName:            "argocd_application_set.progressive_sync",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1141,7 +1320,49 @@ func TestAccArgoCDApplicationSet_templatePatch(t *testing.T) {
 				ResourceName:            "argocd_application_set.template_patch",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_multipleSources(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ApplicationSet)
+			testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_multipleSources("$missing/helm-guestbook/values.yaml"),
+				ExpectError: regexp.MustCompile("refers to source ref \"missing\" which is not defined"),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_multipleSources("$values/helm-guestbook/values.yaml"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.multiple_sources",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.multiple_sources",
+						"spec.0.template.0.spec.0.source.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.multiple_sources",
+						"spec.0.template.0.spec.0.source.1.ref",
+						"values",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.multiple_sources",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
 			},
 		},
 	})
@@ -1161,13 +1382,24 @@ func TestAccArgoCDApplicationSet_CustomNamespace(t *testing.T) {
 						"argocd_application_set.custom_namespace",
 						"metadata.0.uid",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.custom_namespace",
+						"id",
+						fmt.Sprintf("%s:mynamespace-1", name),
+					),
 				),
 			},
+			{
+				ResourceName:  "argocd_application_set.custom_namespace",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s:mynamespace-1:extra", name),
+				ExpectError:   regexp.MustCompile("invalid application set ID"),
+			},
 			{
 				ResourceName:            "argocd_application_set.custom_namespace",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.resource_version", "spec.0.template.0.spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.template.0.spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -1526,6 +1758,192 @@ resource "argocd_application_set" "list" {
 }`
 }
 
+func testAccArgoCDApplicationSet_listDuplicateNames() string {
+	return `
+resource "argocd_application_set" "list" {
+	metadata {
+		name = "list"
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster = "engineering-dev"
+						url     = "https://kubernetes.default.svc"
+					},
+					{
+						cluster = "engineering-dev"
+						url     = "https://kubernetes.default.svc"
+					}
+				]
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{cluster}}-guestbook"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "applicationset/examples/list-generator/guestbook/{{cluster}}"
+				}
+
+				destination {
+					server    = "{{url}}"
+					namespace = "guestbook"
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_waitForApplications() string {
+	return `
+resource "argocd_application_set" "wait_for_applications" {
+	metadata {
+		name = "wait-for-applications"
+	}
+
+	wait_for_applications {
+		count   = 2
+		healthy = true
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						name      = "wait-guestbook-1"
+						namespace = "wait-guestbook-1"
+					},
+					{
+						name      = "wait-guestbook-2"
+						namespace = "wait-guestbook-2"
+					}
+				]
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{name}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "test/e2e/testdata/guestbook"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "{{namespace}}"
+				}
+
+				sync_policy {
+					automated {
+						prune = true
+					}
+
+					sync_options = ["CreateNamespace=true"]
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_generatedApplicationNames(environments string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "generated_names" {
+	metadata {
+		name = "generated-names"
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [for env in [%s] : { env = env }]
+			}
+		}
+
+		template {
+			metadata {
+				name = "generated-names-{{env}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "test/e2e/testdata/guestbook"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "{{env}}"
+				}
+			}
+		}
+	}
+}`, environments)
+}
+
+func testAccArgoCDApplicationSet_listElementsYamlInvalid() string {
+	return `
+resource "argocd_application_set" "list_elements_yaml_invalid" {
+	metadata {
+		name = "list-elements-yaml-invalid"
+	}
+
+	spec {
+		generator {
+			list {
+				elements_yaml = <<-EOT
+					cluster: engineering-dev
+					url: https://kubernetes.default.svc
+				EOT
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{cluster}}-guestbook"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "applicationset/examples/list-generator/guestbook/{{cluster}}"
+				}
+
+				destination {
+					server    = "{{url}}"
+					namespace = "guestbook"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_listElementsYaml() string {
 	return `
 resource "argocd_application_set" "list_elements_yaml" {
@@ -1536,7 +1954,6 @@ resource "argocd_application_set" "list_elements_yaml" {
 	spec {
 		generator {
 			list {
-				elements = []
 				elements_yaml = <<-EOT
 					- cluster: engineering-dev
 					  url: https://kubernetes.default.svc
@@ -1631,6 +2048,90 @@ resource "argocd_application_set" "matrix" {
 }`
 }
 
+func testAccArgoCDApplicationSet_generatorSelector() string {
+	return `
+resource "argocd_application_set" "generator_selector" {
+	metadata {
+		name = "generator-selector"
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster = "in-cluster"
+						env     = "prod"
+					},
+					{
+						cluster = "in-cluster"
+						env     = "dev"
+					}
+				]
+			}
+
+			selector {
+				match_labels = {
+					env = "prod"
+				}
+			}
+		}
+
+		generator {
+			matrix {
+				generator {
+					list {
+						elements = [
+							{
+								cluster = "in-cluster"
+								env     = "staging"
+							},
+							{
+								cluster = "in-cluster"
+								env     = "qa"
+							}
+						]
+					}
+
+					selector {
+						match_expressions {
+							key      = "env"
+							operator = "In"
+							values   = ["staging"]
+						}
+					}
+				}
+
+				generator {
+					clusters {}
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{env}}-{{cluster}}-selector"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "test/e2e/testdata/guestbook"
+				}
+
+				destination {
+					name      = "{{cluster}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_matrixPluginGenerator() string {
 	return `
 resource "argocd_application_set" "matrix-plugin_generator" {
@@ -2708,8 +3209,8 @@ resource "argocd_application_set" "scm_gitea" {
 }`
 }
 
-func testAccArgoCDApplicationSet_scmProviderGithub() string {
-	return `
+func testAccArgoCDApplicationSet_scmProviderGithub(tokenKey string) string {
+	return fmt.Sprintf(`
 resource "argocd_application_set" "scm_github" {
 	metadata {
 		name = "scm-github"
@@ -2726,7 +3227,7 @@ resource "argocd_application_set" "scm_github" {
 
 					token_ref {
 						secret_name = "github-token"
-						key         = "token"
+						key         = "%s"
 					}
 				}
 			}
@@ -2753,7 +3254,7 @@ resource "argocd_application_set" "scm_github" {
 			}
 		}
 	}
-}`
+}`, tokenKey)
 }
 
 func testAccArgoCDApplicationSet_scmProviderGitlab() string {
@@ -3277,6 +3778,57 @@ resource "argocd_application_set" "generator_template" {
 }`
 }
 
+func testAccArgoCDApplicationSet_generatorTemplateMetadataOnly() string {
+	return `
+resource "argocd_application_set" "generator_template_metadata_only" {
+	metadata {
+		name = "generator-template-metadata-only"
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster = "engineering-dev"
+						url     = "https://kubernetes.default.svc"
+					}
+				]
+
+				template {
+					metadata {
+						labels = {
+							override = "true"
+						}
+					}
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "appset-generator-template-metadata-{{cluster}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "applicationset/examples/template-override/default"
+				}
+
+				destination {
+					server    = "{{url}}"
+					namespace = "guestbook"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_goTemplate() string {
 	return `
 resource "argocd_application_set" "go_template" {
@@ -3354,8 +3906,8 @@ resource "argocd_application_set" "sync_policy" {
 }`
 }
 
-func testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync() string {
-	return `
+func testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync(applicationsSync string) string {
+	return fmt.Sprintf(`
 resource "argocd_application_set" "applications_sync_policy" {
 	metadata {
 		name = "applications-sync-policy"
@@ -3368,7 +3920,7 @@ resource "argocd_application_set" "applications_sync_policy" {
 
 		sync_policy {
 			preserve_resources_on_deletion = true
-			applications_sync              = "create-update"
+			applications_sync              = "%s"
 		}
 
 		template {
@@ -3390,7 +3942,7 @@ resource "argocd_application_set" "applications_sync_policy" {
 			}
 		}
 	}
-}`
+}`, applicationsSync)
 }
 
 func testAccArgoCDApplicationSet_progressiveSync() string {
@@ -3549,6 +4101,49 @@ resource "argocd_application_set" "template_patch" {
 }`
 }
 
+func testAccArgoCDApplicationSet_multipleSources(valueFile string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "multiple_sources" {
+	metadata {
+		name = "multiple-sources"
+	}
+
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+
+		template {
+			metadata {
+				name = "appset-multiple-sources-{{name}}"
+			}
+
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+					target_revision = "HEAD"
+					path            = "helm-guestbook"
+					helm {
+						value_files = ["%s"]
+					}
+				}
+
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+					target_revision = "HEAD"
+					ref             = "values"
+				}
+
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`, valueFile)
+}
+
 func testAccArgoCDApplicationSetCustomNamespace(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "custom_namespace" {
@@ -3619,3 +4214,35 @@ resource "argocd_application_set" "custom_namespace" {
 }
 `, name)
 }
+
+func TestParseApplicationSetID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id                string
+		expectedName      string
+		expectedNamespace string
+		expectError       bool
+	}{
+		{id: "myappset:mynamespace", expectedName: "myappset", expectedNamespace: "mynamespace"},
+		{id: "myappset:", expectedName: "myappset"},
+		{id: "myappset", expectedName: "myappset"},
+		{id: ":mynamespace", expectError: true},
+		{id: "myappset:mynamespace:extra", expectError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			t.Parallel()
+
+			name, namespace, err := parseApplicationSetID(tc.id)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("parseApplicationSetID() error = %v, expectError = %v", err, tc.expectError)
+			}
+
+			if name != tc.expectedName || namespace != tc.expectedNamespace {
+				t.Errorf("parseApplicationSetID() = (%q, %q), expected (%q, %q)", name, namespace, tc.expectedName, tc.expectedNamespace)
+			}
+		})
+	}
+}
@@ -40,7 +40,7 @@ func TestAccArgoCDApplication(t *testing.T) {
 				ResourceName:            "argocd_application." + name,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate", "spec.0.source.0.helm.0.parameter.0.force_string"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate", "spec.0.source.0.helm.0.parameter.0.force_string"},
 			},
 			{
 				// Update
@@ -87,7 +87,7 @@ func TestAccArgoCDApplication(t *testing.T) {
 				ResourceName:            "argocd_application." + name,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -154,7 +154,7 @@ ingress:
 				ResourceName:            "argocd_application.helm",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -206,7 +206,7 @@ func TestAccArgoCDApplication_Kustomize(t *testing.T) {
 				ResourceName:            "argocd_application.kustomize",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -269,7 +269,7 @@ func TestAccArgoCDApplication_KustomizePatches(t *testing.T) {
 				ResourceName:            "argocd_application.kustomize_patches",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -304,7 +304,7 @@ func TestAccArgoCDApplication_IgnoreDifferences(t *testing.T) {
 				ResourceName:            "argocd_application.ignore_differences",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
 			},
 			{
 				Config: testAccArgoCDApplicationIgnoreDiffJQPathExpressions(
@@ -330,7 +330,7 @@ func TestAccArgoCDApplication_IgnoreDifferences(t *testing.T) {
 				ResourceName:            "argocd_application.ignore_differences_jqpe",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
 			},
 			{
 				Config: testAccArgoCDApplicationIgnoreDiffManagedFieldsManagers(
@@ -356,7 +356,7 @@ func TestAccArgoCDApplication_IgnoreDifferences(t *testing.T) {
 				ResourceName:            "argocd_application.ignore_differences_managed_fields_managers",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
 			},
 		},
 	})
@@ -390,7 +390,7 @@ func TestAccArgoCDApplication_RevisionHistoryLimit(t *testing.T) {
 				ResourceName:            "argocd_application.revision_history_limit",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -419,7 +419,7 @@ func TestAccArgoCDApplication_OptionalDestinationNamespace(t *testing.T) {
 				ResourceName:            "argocd_application.no_namespace",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version"},
 			},
 		},
 	})
@@ -504,7 +504,7 @@ func TestAccArgoCDApplication_DirectoryJsonnet(t *testing.T) {
 				ResourceName:            "argocd_application.directory",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -610,7 +610,7 @@ func TestAccArgoCDApplication_EmptyDirectory(t *testing.T) {
 				ResourceName:            "argocd_application.directory",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -642,7 +642,7 @@ func TestAccArgoCDApplication_DirectoryIncludeExclude(t *testing.T) {
 				ResourceName:            "argocd_application.directory",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -702,7 +702,7 @@ func TestAccArgoCDApplication_SyncPolicy(t *testing.T) {
 				ResourceName:            "argocd_application.sync_policy",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -1107,7 +1107,7 @@ func TestAccArgoCDApplication_CustomNamespace(t *testing.T) {
 				ResourceName:            "argocd_application.custom_namespace",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status", "validate", "metadata.0.generation", "metadata.0.resource_version", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "status", "validate", "metadata.0.generation", "metadata.0.resource_version", "spec.0.source.0.helm.0.parameter.0.force_string", "spec.0.source.0.helm.0.parameter.1.force_string"},
 			},
 		},
 	})
@@ -1156,7 +1156,7 @@ func TestAccArgoCDApplication_MultipleSources(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate",
+					"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate",
 					"spec.0.source.0.helm.0.parameter.0.force_string",
 					"spec.0.source.0.helm.0.parameter.1.force_string",
 					"spec.0.source.0.helm.0.parameter.2.force_string",
@@ -1212,7 +1212,7 @@ func TestAccArgoCDApplication_HelmValuesFromExternalGitRepo(t *testing.T) {
 				ResourceName:            "argocd_application.helm_values_external",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
 			},
 		},
 	})
@@ -1235,7 +1235,7 @@ func TestAccArgoCDApplication_ManagedNamespaceMetadata(t *testing.T) {
 				ResourceName:            "argocd_application.namespace_metadata",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "validate", "status"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "allow_takeover", "metadata.0.generation", "metadata.0.resource_version", "validate", "status"},
 			},
 		},
 	})
@@ -1337,6 +1337,31 @@ func TestAccArgoCDApplication_Sync(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplication_OwnedByApplicationSet(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationOwnedByApplicationSet(name, false),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("application %s is owned by application set %s", name, name)),
+			},
+			{
+				Config: testAccArgoCDApplicationOwnedByApplicationSet(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.owned",
+						"allow_takeover",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccArgoCDApplicationSync(name string, sync bool) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "sync" {
@@ -1370,6 +1395,77 @@ resource "argocd_application" "sync" {
 	`, name, sync)
 }
 
+func testAccArgoCDApplicationOwnedByApplicationSet(name string, allowTakeover bool) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "owner" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  wait_for_applications {
+    count = 1
+  }
+
+  spec {
+    generator {
+      list {
+        elements = [
+          {
+            name = "%[1]s"
+          }
+        ]
+      }
+    }
+
+    template {
+      metadata {
+        name = "{{name}}"
+      }
+
+      spec {
+        project = "default"
+
+        source {
+          repo_url        = "https://github.com/argoproj/argo-cd"
+          path            = "test/e2e/testdata/guestbook"
+          target_revision = "HEAD"
+        }
+
+        destination {
+          server    = "https://kubernetes.default.svc"
+          namespace = "{{name}}"
+        }
+      }
+    }
+  }
+}
+
+resource "argocd_application" "owned" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  allow_takeover = %[2]t
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argo-cd"
+      path            = "test/e2e/testdata/guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  depends_on = [argocd_application_set.owner]
+}
+	`, name, allowTakeover)
+}
+
 func testAccArgoCDApplicationSimple(name, targetRevision string, wait bool) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "%[1]s" {
@@ -4,10 +4,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	applicationClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	clusterClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func resourceArgoCDCluster() *schema.Resource {
@@ -18,23 +25,88 @@ func resourceArgoCDCluster() *schema.Resource {
 		UpdateContext: resourceArgoCDClusterUpdate,
 		DeleteContext: resourceArgoCDClusterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDClusterImport,
 		},
-		Schema: clusterSchema(),
+		Schema:        clusterSchema(),
+		CustomizeDiff: resourceArgoCDClusterCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceArgoCDClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	if err := validateClusterExecProviderCommand(raw); err != nil {
+		return err
+	}
+
+	if directSecret, check := raw.GetAttr("direct_secret"), raw.GetAttr("check_dependent_applications"); directSecret.IsKnown() && check.IsKnown() && directSecret.True() && check.True() {
+		return fmt.Errorf("check_dependent_applications can not be enabled along with direct_secret, as the applications deployed to the cluster are only listed through the ArgoCD API")
+	}
+
+	clusterResources, namespaces := raw.GetAttr("cluster_resources"), raw.GetAttr("namespaces")
+	if clusterResources.IsNull() || !clusterResources.IsKnown() || !namespaces.IsKnown() {
+		return nil
+	}
+
+	if clusterResources.False() && (namespaces.IsNull() || namespaces.LengthInt() == 0) {
+		return fmt.Errorf("cluster_resources can only be disabled for clusters restricted to namespaces, cluster level resources are always managed otherwise")
+	}
+
+	return nil
+}
+
+// validateClusterExecProviderCommand ensures that a command is configured
+// whenever an exec provider is, without requiring it otherwise.
+func validateClusterExecProviderCommand(raw cty.Value) error {
+	config := raw.GetAttr("config")
+	if config.IsNull() || !config.IsKnown() || config.LengthInt() == 0 {
+		return nil
 	}
+
+	epc := config.Index(cty.NumberIntVal(0)).GetAttr("exec_provider_config")
+	if epc.IsNull() || !epc.IsKnown() || epc.LengthInt() == 0 {
+		return nil
+	}
+
+	command := epc.Index(cty.NumberIntVal(0)).GetAttr("command")
+	if !command.IsKnown() {
+		return nil
+	}
+
+	if command.IsNull() || strings.TrimSpace(command.AsString()) == "" {
+		return fmt.Errorf("config.0.exec_provider_config.0.command must be set when exec_provider_config is configured")
+	}
+
+	return nil
 }
 
 func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*ServerInterface)
-	if diags := si.InitClients(ctx); diags != nil {
-		return pluginSDKDiags(diags)
-	}
 
 	cluster, err := expandCluster(d)
 	if err != nil {
 		return errorToDiagnostics("failed to expand cluster", err)
 	}
 
+	if d.Get("direct_secret").(bool) {
+		return resourceArgoCDClusterSecretCreate(ctx, d, si, cluster)
+	}
+
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	if diags := validateClusterProject(ctx, si, cluster); diags != nil {
+		return diags
+	}
+
 	// Need a full lock here to avoid race conditions between List existing clusters and creating a new one
 	tokenMutexClusters.Lock()
 
@@ -55,8 +127,10 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to list existing clusters when creating cluster %s", cluster.Server), err)
 	}
 
+	adopt := d.Get("adopt_existing").(bool)
+
 	// Here we will filter ourselves on the list so that we are backward compatible for argo-cd server with version < v2.8.0 (see coment above)
-	if len(existingClusters.Items) > 0 {
+	if len(existingClusters.Items) > 0 && !adopt {
 		for _, existingCluster := range existingClusters.Items {
 			if rtrimmedServer == strings.TrimRight(existingCluster.Server, "/") {
 				tokenMutexClusters.Unlock()
@@ -65,6 +139,7 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 					{
 						Severity: diag.Error,
 						Summary:  fmt.Sprintf("cluster with server address %s already exists", cluster.Server),
+						Detail:   "Import the cluster, or set adopt_existing to true to bring it under management.",
 					},
 				}
 			}
@@ -72,7 +147,7 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	c, err := si.ClusterClient.Create(ctx, &clusterClient.ClusterCreateRequest{
-		Cluster: cluster, Upsert: false,
+		Cluster: cluster, Upsert: adopt,
 	})
 	tokenMutexClusters.Unlock()
 
@@ -80,18 +155,89 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return argoCDAPIError("create", "cluster", cluster.Server, err)
 	}
 
-	// Check if the name has been defaulted to server (when omitted)
-	if c.Name != "" && c.Name != c.Server {
-		d.SetId(fmt.Sprintf("%s/%s", c.Server, c.Name))
-	} else {
-		d.SetId(c.Server)
+	d.SetId(clusterID(c))
+
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if diags := waitForClusterConnection(ctx, si, d, d.Timeout(schema.TimeoutCreate)); diags != nil {
+			return diags
+		}
 	}
 
 	return resourceArgoCDClusterRead(ctx, d, meta)
 }
 
+// resourceArgoCDClusterImport resolves the import ID, which is either the
+// server URL, the name or the `<server>/<name>` composite of a cluster, to the
+// canonical ID of the cluster.
+func resourceArgoCDClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	si := meta.(*ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return nil, fmt.Errorf("failed to init clients: %v", diags.Errors())
+	}
+
+	tokenMutexClusters.RLock()
+	clusters, err := si.ClusterClient.List(ctx, &clusterClient.ClusterQuery{})
+	tokenMutexClusters.RUnlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	importID := d.Id()
+
+	var matches []*application.Cluster
+
+	for i, c := range clusters.Items {
+		server := strings.TrimRight(c.Server, "/")
+
+		switch {
+		case !strings.Contains(importID, "://") && c.Name == importID,
+			server == strings.TrimRight(importID, "/"),
+			fmt.Sprintf("%s/%s", server, c.Name) == importID:
+			matches = append(matches, &clusters.Items[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no cluster matches import ID %s, expected the server URL, the name or the <server>/<name> of a cluster", importID)
+	case 1:
+		break
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, c := range matches {
+			ids = append(ids, clusterID(c))
+		}
+
+		return nil, fmt.Errorf("import ID %s matches multiple clusters, use one of the following IDs instead: %s", importID, strings.Join(ids, ", "))
+	}
+
+	d.SetId(clusterID(matches[0]))
+
+	if err := d.Set("server", matches[0].Server); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// clusterID returns the canonical ID of a cluster, which is `<server>/<name>`
+// or just the server if the name of the cluster defaulted to it.
+func clusterID(c *application.Cluster) string {
+	if c.Name != "" && c.Name != c.Server {
+		return fmt.Sprintf("%s/%s", c.Server, c.Name)
+	}
+
+	return c.Server
+}
+
 func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*ServerInterface)
+
+	if d.Get("direct_secret").(bool) {
+		return resourceArgoCDClusterSecretRead(ctx, d, si)
+	}
+
 	if diags := si.InitClients(ctx); diags != nil {
 		return pluginSDKDiags(diags)
 	}
@@ -144,7 +290,11 @@ func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta
 			if len(existingClusters.Items) > 0 {
 				for _, existingCluster := range existingClusters.Items {
 					if rtrimmedServer == strings.TrimRight(existingCluster.Server, "/") {
-						// Cluster was found, return
+						// Cluster was found, e.g. a project scoped cluster that can be listed but not read directly
+						if err = flattenCluster(&existingCluster, d); err != nil {
+							return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+						}
+
 						return nil
 					}
 				}
@@ -168,15 +318,26 @@ func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceArgoCDClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*ServerInterface)
-	if diags := si.InitClients(ctx); diags != nil {
-		return pluginSDKDiags(diags)
-	}
 
 	cluster, err := expandCluster(d)
 	if err != nil {
 		return errorToDiagnostics(fmt.Sprintf("failed to expand cluster %s", d.Id()), err)
 	}
 
+	if d.Get("direct_secret").(bool) {
+		return resourceArgoCDClusterSecretUpdate(ctx, d, si, cluster)
+	}
+
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	if d.HasChange("project") {
+		if diags := validateClusterProject(ctx, si, cluster); diags != nil {
+			return diags
+		}
+	}
+
 	tokenMutexClusters.Lock()
 	_, err = si.ClusterClient.Update(ctx, &clusterClient.ClusterUpdateRequest{Cluster: cluster})
 	tokenMutexClusters.Unlock()
@@ -185,15 +346,53 @@ func resourceArgoCDClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		return argoCDAPIError("update", "cluster", cluster.Server, err)
 	}
 
+	if refresh, ok := d.GetOk("refresh_cache_on_update"); ok && refresh.(bool) && d.HasChanges("config", "namespaces", "cluster_resources", "project") {
+		tokenMutexClusters.Lock()
+		_, err = si.ClusterClient.InvalidateCache(ctx, &clusterClient.ClusterQuery{Server: cluster.Server, Name: cluster.Name})
+		tokenMutexClusters.Unlock()
+
+		if err != nil {
+			return argoCDAPIError("invalidate cache of", "cluster", cluster.Server, err)
+		}
+	}
+
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if diags := waitForClusterConnection(ctx, si, d, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
 	return resourceArgoCDClusterRead(ctx, d, meta)
 }
 
 func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*ServerInterface)
+
+	if d.Get("direct_secret").(bool) {
+		return resourceArgoCDClusterSecretDelete(ctx, d, si)
+	}
+
 	if diags := si.InitClients(ctx); diags != nil {
 		return pluginSDKDiags(diags)
 	}
 
+	if check, ok := d.GetOk("check_dependent_applications"); ok && check.(bool) {
+		apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{})
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to list applications deployed to cluster %s", d.Id()), err)
+		}
+
+		if dependents := clusterDependentApplications(apps.Items, d.Get("server").(string), d.Get("name").(string)); len(dependents) > 0 {
+			return []diag.Diagnostic{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("cluster %s still has %d applications deployed to it", d.Id(), len(dependents)),
+					Detail:   fmt.Sprintf("Delete or move the following applications before deleting the cluster, or set check_dependent_applications to false to delete it regardless: %s", strings.Join(dependents, ", ")),
+				},
+			}
+		}
+	}
+
 	tokenMutexClusters.Lock()
 	_, err := si.ClusterClient.Delete(ctx, getClusterQueryFromID(d))
 	tokenMutexClusters.Unlock()
@@ -212,9 +411,200 @@ func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// The functions below manage the cluster through its declarative secret when
+// direct_secret is enabled, so that neither the ArgoCD API nor its connection
+// check to the cluster are involved.
+
+func resourceArgoCDClusterSecretCreate(ctx context.Context, d *schema.ResourceData, si *ServerInterface, cluster *application.Cluster) diag.Diagnostics {
+	kc, namespace, err := si.KubernetesClient()
+	if err != nil {
+		return errorToDiagnostics("failed to initialize Kubernetes client", err)
+	}
+
+	tokenMutexClusters.Lock()
+	c, hash, err := createClusterSecret(ctx, kc, namespace, cluster)
+	tokenMutexClusters.Unlock()
+
+	if apierrors.IsAlreadyExists(err) {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("cluster with server address %s already exists", cluster.Server),
+				Detail:   fmt.Sprintf("a cluster secret for %s already exists in namespace %s, import the cluster to bring it under management.", cluster.Server, namespace),
+			},
+		}
+	} else if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to create secret of cluster %s", cluster.Server), err)
+	}
+
+	d.SetId(clusterID(c))
+
+	if err = d.Set("credentials_hash", hash); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+	}
+
+	return resourceArgoCDClusterSecretRead(ctx, d, si)
+}
+
+func resourceArgoCDClusterSecretRead(ctx context.Context, d *schema.ResourceData, si *ServerInterface) diag.Diagnostics {
+	kc, namespace, err := si.KubernetesClient()
+	if err != nil {
+		return errorToDiagnostics("failed to initialize Kubernetes client", err)
+	}
+
+	tokenMutexClusters.RLock()
+	c, hash, err := readClusterSecret(ctx, kc, namespace, d.Get("server").(string))
+	tokenMutexClusters.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to read secret of cluster %s", d.Id()), err)
+	}
+
+	// Labels and annotations added outside of Terraform are retained on the
+	// secret, hence only the tracked ones are read back
+	c.Labels = trackedClusterSecretMetadata(c.Labels, d.Get("metadata.0.labels").(map[string]interface{}))
+	c.Annotations = trackedClusterSecretMetadata(c.Annotations, d.Get("metadata.0.annotations").(map[string]interface{}))
+
+	if err = flattenCluster(c, d); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+	}
+
+	// The hash of the credentials is recorded whenever they are written, so
+	// that credentials changed outside of Terraform show up as drift
+	previous := d.Get("credentials_hash").(string)
+
+	if err = d.Set("credentials_hash", hash); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+	}
+
+	if previous == "" || previous == hash {
+		return nil
+	}
+
+	stale, err := markClusterCredentialsStale(d)
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+	}
+
+	detail := "The credentials within the secret of the cluster differ from the ones last applied by Terraform."
+	if stale {
+		detail += " The configured credentials will be applied again on the next apply."
+	}
+
+	return []diag.Diagnostic{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("credentials of cluster %s were changed outside of Terraform", d.Id()),
+			Detail:   detail,
+		},
+	}
+}
+
+func resourceArgoCDClusterSecretUpdate(ctx context.Context, d *schema.ResourceData, si *ServerInterface, cluster *application.Cluster) diag.Diagnostics {
+	kc, namespace, err := si.KubernetesClient()
+	if err != nil {
+		return errorToDiagnostics("failed to initialize Kubernetes client", err)
+	}
+
+	tokenMutexClusters.Lock()
+	_, hash, err := updateClusterSecret(ctx, kc, namespace, cluster, previousClusterMetadata(d))
+	tokenMutexClusters.Unlock()
+
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to update secret of cluster %s", cluster.Server), err)
+	}
+
+	if err = d.Set("credentials_hash", hash); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten cluster %s", d.Id()), err)
+	}
+
+	return resourceArgoCDClusterSecretRead(ctx, d, si)
+}
+
+func resourceArgoCDClusterSecretDelete(ctx context.Context, d *schema.ResourceData, si *ServerInterface) diag.Diagnostics {
+	kc, namespace, err := si.KubernetesClient()
+	if err != nil {
+		return errorToDiagnostics("failed to initialize Kubernetes client", err)
+	}
+
+	tokenMutexClusters.Lock()
+	err = deleteClusterSecret(ctx, kc, namespace, d.Get("server").(string))
+	tokenMutexClusters.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errorToDiagnostics(fmt.Sprintf("failed to delete secret of cluster %s", d.Id()), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// waitForClusterConnection polls the cluster until ArgoCD reports a
+// successful connection to it, so that invalid credentials or unreachable API
+// servers are reported by the apply.
+func waitForClusterConnection(ctx context.Context, si *ServerInterface, d *schema.ResourceData, timeout time.Duration) diag.Diagnostics {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		tokenMutexClusters.RLock()
+		c, err := si.ClusterClient.Get(ctx, getClusterQueryFromID(d))
+		tokenMutexClusters.RUnlock()
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if cs := c.Info.ConnectionState; cs.Status != application.ConnectionStatusSuccessful {
+			return retry.RetryableError(fmt.Errorf("expected connection status to be %s but was %s: %s", application.ConnectionStatusSuccessful, cs.Status, cs.Message))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("error while waiting for cluster %s to be connected", d.Id()), err)
+	}
+
+	return nil
+}
+
+// validateClusterProject ensures that the project referenced by a project
+// scoped cluster exists. The check is skipped if the caller is not allowed to
+// read the project.
+func validateClusterProject(ctx context.Context, si *ServerInterface, cluster *application.Cluster) diag.Diagnostics {
+	if cluster.Project == "" {
+		return nil
+	}
+
+	_, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{Name: cluster.Project})
+
+	switch {
+	case err == nil, strings.Contains(err.Error(), "PermissionDenied"):
+		return nil
+	case strings.Contains(err.Error(), "NotFound"):
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("project %s referenced by cluster %s does not exist", cluster.Project, cluster.Server),
+			},
+		}
+	default:
+		return argoCDAPIError("get", "project", cluster.Project, err)
+	}
+}
+
 func getClusterQueryFromID(d *schema.ResourceData) *clusterClient.ClusterQuery {
 	cq := &clusterClient.ClusterQuery{}
 
+	// The server tracked in the state disambiguates server URLs with a path
+	if server, ok := d.Get("server").(string); ok && server != "" && strings.HasPrefix(d.Id(), server) {
+		cq.Server = server
+		cq.Name = strings.TrimPrefix(strings.TrimPrefix(d.Id(), server), "/")
+
+		return cq
+	}
+
 	id := strings.Split(strings.TrimPrefix(d.Id(), "https://"), "/")
 	if len(id) > 1 {
 		cq.Name = id[len(id)-1]
@@ -225,3 +615,25 @@ func getClusterQueryFromID(d *schema.ResourceData) *clusterClient.ClusterQuery {
 
 	return cq
 }
+
+// previousClusterMetadata returns the labels and annotations of the cluster
+// recorded in the prior state, i.e. the ones previously managed by Terraform.
+func previousClusterMetadata(d *schema.ResourceData) *application.Cluster {
+	previous := &application.Cluster{}
+
+	old, _ := d.GetChange("metadata")
+
+	if m, ok := old.([]interface{}); ok && len(m) > 0 && m[0] != nil {
+		metadata := m[0].(map[string]interface{})
+
+		if v, ok := metadata["labels"].(map[string]interface{}); ok {
+			previous.Labels = expandStringMap(v)
+		}
+
+		if v, ok := metadata["annotations"].(map[string]interface{}); ok {
+			previous.Annotations = expandStringMap(v)
+		}
+	}
+
+	return previous
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -90,6 +91,10 @@ func TestAccArgoCDCluster_projectScope(t *testing.T) {
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterProjectScope(acctest.RandString(10), acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("referenced by cluster .* does not exist"),
+			},
 			{
 				Config: testAccArgoCDClusterProjectScope(acctest.RandString(10), "myproject1"),
 				Check: resource.ComposeTestCheckFunc(
@@ -254,6 +259,19 @@ func TestAccArgoCDCluster_metadata(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"config.0.bearer_token", "info", "config.0.tls_client_config.0.key_data"},
 			},
+			{
+				PreConfig:          testAccArgoCDClusterRemoveLabels(t, clusterName),
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccArgoCDClusterMetadata_addAnnotations(clusterName),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.cluster_metadata",
+					"metadata.0.labels.test",
+					"label",
+				),
+			},
 			{
 				Config: testAccArgoCDClusterMetadata_removeLabels(clusterName),
 				Check: resource.ComposeTestCheckFunc(
@@ -438,6 +456,304 @@ func TestAccArgoCDCluster_namespacesErrorWhenEmpty(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_execProviderConfig(t *testing.T) {
+	if testhelpers.GlobalTestEnv != nil {
+		t.Skip("exec provider test relies on Kind's bootstrap token")
+	}
+
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterExecProviderConfig(name, "client.authentication.k8s.io/v1alpha1"),
+				ExpectError: regexp.MustCompile(`expected config.0.exec_provider_config.0.api_version to be one of`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "argocd_cluster" "exec" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  config {
+    exec_provider_config {
+      api_version = "client.authentication.k8s.io/v1beta1"
+    }
+  }
+}
+`, name),
+				ExpectError: regexp.MustCompile(`config.0.exec_provider_config.0.command must be set`),
+			},
+			{
+				Config: testAccArgoCDClusterExecProviderConfig(name, "client.authentication.k8s.io/v1beta1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.exec",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.exec",
+						"config.0.exec_provider_config.0.command",
+						"sh",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.exec",
+						"config.0.exec_provider_config.0.env.CLUSTER_NAME",
+						name,
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_cluster.exec",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config.0.exec_provider_config", "info"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_directSecretCheckDependentApplications(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_cluster" "direct" {
+  server                       = "https://direct.example.com"
+  direct_secret                = true
+  check_dependent_applications = true
+}
+`,
+				ExpectError: regexp.MustCompile("check_dependent_applications can not be enabled along with direct_secret"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_namespacesScope(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterNamespacesScope(name, "[]", "false"),
+				ExpectError: regexp.MustCompile("cluster_resources can only be disabled for clusters restricted to namespaces"),
+			},
+			{
+				Config: testAccArgoCDClusterNamespacesScope(name, `["foo", "bar"]`, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.namespaces", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("argocd_cluster.namespaces", "cluster_resources", "true"),
+				),
+			},
+			{
+				Config: testAccArgoCDClusterNamespacesScope(name, `["bar", "foo"]`, "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:            "argocd_cluster.namespaces",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config.0.bearer_token", "info", "config.0.tls_client_config.0.key_data"},
+			},
+			{
+				Config: testAccArgoCDClusterNamespacesScope(name, "null", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.namespaces", "namespaces.#", "0"),
+					resource.TestCheckResourceAttr("argocd_cluster.namespaces", "cluster_resources", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_wait(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterWait(name, `["default"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.wait", "wait", "true"),
+					resource.TestCheckResourceAttr("argocd_cluster.wait", "info.0.connection_state.0.status", "Successful"),
+				),
+			},
+			{
+				Config: testAccArgoCDClusterWait(name, `["default", "foo"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.wait", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("argocd_cluster.wait", "info.0.connection_state.0.status", "Successful"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_refreshCacheOnUpdate(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterRefreshCacheOnUpdate(name, `["default"]`),
+				Check:  resource.TestCheckResourceAttr("argocd_cluster.refresh", "refresh_cache_on_update", "true"),
+			},
+			{
+				Config: testAccArgoCDClusterRefreshCacheOnUpdate(name, `["default", "foo"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.refresh", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("argocd_cluster.refresh", "info.0.connection_state.0.status", "Successful"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_checkDependentApplications(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterCheckDependentApplications(name, true),
+				Check:  resource.TestCheckResourceAttr("argocd_cluster.dependent", "check_dependent_applications", "true"),
+			},
+			{
+				// Deleting the cluster while the application is still deployed to it
+				Config:      testAccArgoCDClusterCheckDependentApplications(name, false),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("argocd/%s", name)),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_adoptInCluster(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterAdoptInCluster(false),
+				ExpectError: regexp.MustCompile("cluster with server address https://kubernetes.default.svc already exists"),
+			},
+			{
+				Config: testAccArgoCDClusterAdoptInCluster(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "id", "https://kubernetes.default.svc/in-cluster"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "metadata.0.labels.adopted", "true"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "info.0.connection_state.0.status", "Successful"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_writeOnlyBearerToken(t *testing.T) {
+	if testhelpers.GlobalTestEnv != nil {
+		t.Skip("write-only bearer token test relies on Kind's bootstrap token")
+	}
+
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterWriteOnlyBearerToken(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.wo", "info.0.connection_state.0.status", "Successful"),
+					resource.TestCheckResourceAttr("argocd_cluster.wo", "config.0.credentials_version", "1"),
+					resource.TestCheckNoResourceAttr("argocd_cluster.wo", "config.0.bearer_token_wo"),
+				),
+			},
+			{
+				Config: testAccArgoCDClusterWriteOnlyBearerToken(name, "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccArgoCDClusterWriteOnlyBearerToken(name, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("argocd_cluster.wo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.wo", "info.0.connection_state.0.status", "Successful"),
+					resource.TestCheckResourceAttr("argocd_cluster.wo", "config.0.credentials_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_importFormats(t *testing.T) {
+	name := acctest.RandString(10)
+	server := "https://kubernetes.default.svc.cluster.local"
+	ignore := []string{"config.0.bearer_token", "info", "config.0.tls_client_config.0.key_data"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterBearerToken(name),
+			},
+			{
+				ResourceName:            "argocd_cluster.simple",
+				ImportState:             true,
+				ImportStateId:           server,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: ignore,
+			},
+			{
+				ResourceName:            "argocd_cluster.simple",
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: ignore,
+			},
+			{
+				ResourceName:            "argocd_cluster.simple",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s", server, name),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: ignore,
+			},
+			{
+				ResourceName:  "argocd_cluster.simple",
+				ImportState:   true,
+				ImportStateId: acctest.RandString(10),
+				ExpectError:   regexp.MustCompile("no cluster matches import ID"),
+			},
+		},
+	})
+}
+
 func testAccArgoCDClusterBearerToken(clusterName string) string {
 	return fmt.Sprintf(`
 resource "argocd_cluster" "simple" {
@@ -641,6 +957,145 @@ resource "argocd_cluster" "cluster_metadata" {
 `, clusterName, getConfig())
 }
 
+func testAccArgoCDClusterNamespacesScope(clusterName, namespaces, clusterResources string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "namespaces" {
+  server            = "https://kubernetes.default.svc.cluster.local"
+  name              = "%s"
+  namespaces        = %s
+  cluster_resources = %s
+  config {
+%s
+  }
+}
+`, clusterName, namespaces, clusterResources, getConfig())
+}
+
+func testAccArgoCDClusterWait(clusterName, namespaces string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "wait" {
+  server     = "https://kubernetes.default.svc.cluster.local"
+  name       = "%s"
+  namespaces = %s
+  wait       = true
+  config {
+%s
+  }
+
+  timeouts {
+    create = "2m"
+    update = "2m"
+  }
+}
+`, clusterName, namespaces, getConfig())
+}
+
+func testAccArgoCDClusterRefreshCacheOnUpdate(clusterName, namespaces string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "refresh" {
+  server                  = "https://kubernetes.default.svc.cluster.local"
+  name                    = "%s"
+  namespaces              = %s
+  refresh_cache_on_update = true
+  wait                    = true
+  config {
+%s
+  }
+}
+`, clusterName, namespaces, getConfig())
+}
+
+func testAccArgoCDClusterCheckDependentApplications(name string, withCluster bool) string {
+	cluster := fmt.Sprintf(`
+resource "argocd_cluster" "dependent" {
+  server                       = "https://kubernetes.default.svc.cluster.local"
+  name                         = "%s"
+  check_dependent_applications = true
+  config {
+%s
+  }
+}
+`, name, getConfig())
+
+	// The dependency on the cluster is kept in the state once it is removed
+	// from the configuration, so that the application is destroyed first
+	dependsOn := "depends_on = [argocd_cluster.dependent]"
+
+	if !withCluster {
+		cluster, dependsOn = "", ""
+	}
+
+	return cluster + fmt.Sprintf(`
+resource "argocd_application" "dependent" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://kubernetes-sigs.github.io/descheduler"
+      chart           = "descheduler"
+      target_revision = "0.33.0"
+    }
+
+    sync_policy {
+      automated {
+        prune = true
+      }
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      name      = "%[1]s"
+      namespace = "%[1]s"
+    }
+  }
+
+  wait = true
+
+  %[2]s
+}
+`, name, dependsOn)
+}
+
+func testAccArgoCDClusterAdoptInCluster(adopt bool) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "in_cluster" {
+  server         = "https://kubernetes.default.svc"
+  name           = "in-cluster"
+  adopt_existing = %t
+  wait           = true
+
+  # Relies on the service account of ArgoCD
+  config {}
+
+  metadata {
+    labels = {
+      adopted = "true"
+    }
+  }
+}
+`, adopt)
+}
+
+func testAccArgoCDClusterWriteOnlyBearerToken(clusterName, credentialsVersion string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "wo" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token_wo     = "abcdef.0123456789abcdef"
+    credentials_version = "%s"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName, credentialsVersion)
+}
+
 func testAccArgoCDClusterNamespacesContainsEmptyString(clusterName string) string {
 	return fmt.Sprintf(`
 resource "argocd_cluster" "simple" {
@@ -669,6 +1124,59 @@ resource "argocd_cluster" "simple" {
 `, clusterName, getConfig())
 }
 
+func testAccArgoCDClusterExecProviderConfig(clusterName, apiVersion string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "exec" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%[1]s"
+  config {
+    exec_provider_config {
+      api_version  = "%[2]s"
+      command      = "sh"
+      # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+      args         = ["-c", "echo '{\"apiVersion\":\"%[2]s\",\"kind\":\"ExecCredential\",\"status\":{\"token\":\"abcdef.0123456789abcdef\"}}'"]
+      env          = {
+        CLUSTER_NAME = "%[1]s"
+      }
+      install_hint = "sh is expected to be available"
+    }
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName, apiVersion)
+}
+
+// testAccArgoCDClusterRemoveLabels removes the labels of the cluster secret
+// outside of Terraform.
+func testAccArgoCDClusterRemoveLabels(t *testing.T, clusterName string) func() {
+	return func() {
+		si, err := getServerInterface()
+		if err != nil {
+			t.Fatalf("failed to get server interface: %s", err)
+		}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 120*time.Second)
+		defer cancel()
+
+		c, err := si.ClusterClient.Get(ctx, &cluster.ClusterQuery{Name: clusterName})
+		if err != nil {
+			t.Fatalf("failed to get cluster '%s': %s", clusterName, err)
+		}
+
+		c.Labels = nil
+
+		_, err = si.ClusterClient.Update(ctx, &cluster.ClusterUpdateRequest{
+			Cluster:       c,
+			UpdatedFields: []string{"labels"},
+		})
+		if err != nil {
+			t.Fatalf("failed to remove labels of cluster '%s': %s", clusterName, err)
+		}
+	}
+}
+
 // getInternalRestConfig returns the internal Kubernetes cluster REST config.
 func getInternalRestConfig() (*rest.Config, error) {
 	if testhelpers.GlobalTestEnv != nil {
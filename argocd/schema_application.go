@@ -1754,9 +1754,10 @@ func applicationSpecSchemaV4(allOptional, isAppSet bool) *schema.Schema {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													"duration": {
-														Type:        schema.TypeString,
-														Description: "Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
-														Optional:    true,
+														Type:         schema.TypeString,
+														Description:  "Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
+														Optional:     true,
+														ValidateFunc: validateRetryBackoffDuration,
 													},
 													"factor": {
 														Type:        schema.TypeString,
@@ -1764,9 +1765,10 @@ func applicationSpecSchemaV4(allOptional, isAppSet bool) *schema.Schema {
 														Optional:    true,
 													},
 													"max_duration": {
-														Type:        schema.TypeString,
-														Description: "Maximum amount of time allowed for the backoff strategy. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
-														Optional:    true,
+														Type:         schema.TypeString,
+														Description:  "Maximum amount of time allowed for the backoff strategy. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
+														Optional:     true,
+														ValidateFunc: validateRetryBackoffDuration,
 													},
 												},
 											},
@@ -2003,61 +2005,7 @@ func applicationStatusSchema() *schema.Schema {
 					Type:        schema.TypeList,
 					Description: "List of Kubernetes resources managed by this application.",
 					Computed:    true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"group": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Group.",
-								Computed:    true,
-							},
-							"health": {
-								Type:        schema.TypeList,
-								Description: "Resource health status.",
-								Computed:    true,
-								Elem:        resourceApplicationHealthStatus(),
-							},
-							"kind": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Kind.",
-								Computed:    true,
-							},
-							"hook": {
-								Type:        schema.TypeBool,
-								Description: "Indicates whether or not this resource has a hook annotation.",
-								Computed:    true,
-							},
-							"name": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Name.",
-								Computed:    true,
-							},
-							"namespace": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Namespace.",
-								Computed:    true,
-							},
-							"requires_pruning": {
-								Type:        schema.TypeBool,
-								Description: "Indicates if the resources requires pruning or not.",
-								Computed:    true,
-							},
-							"status": {
-								Type:        schema.TypeString,
-								Description: "Resource sync status.",
-								Computed:    true,
-							},
-							"sync_wave": {
-								Type:        schema.TypeString,
-								Description: "Sync wave.",
-								Computed:    true,
-							},
-							"version": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Version.",
-								Computed:    true,
-							},
-						},
-					},
+					Elem:        resourceApplicationResourceStatus(),
 				},
 				"summary": {
 					Type:        schema.TypeList,
@@ -2110,6 +2058,64 @@ func applicationStatusSchema() *schema.Schema {
 	}
 }
 
+func resourceApplicationResourceStatus() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Description: "The Kubernetes resource Group.",
+				Computed:    true,
+			},
+			"health": {
+				Type:        schema.TypeList,
+				Description: "Resource health status.",
+				Computed:    true,
+				Elem:        resourceApplicationHealthStatus(),
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "The Kubernetes resource Kind.",
+				Computed:    true,
+			},
+			"hook": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether or not this resource has a hook annotation.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The Kubernetes resource Name.",
+				Computed:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The Kubernetes resource Namespace.",
+				Computed:    true,
+			},
+			"requires_pruning": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the resources requires pruning or not.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Resource sync status.",
+				Computed:    true,
+			},
+			"sync_wave": {
+				Type:        schema.TypeString,
+				Description: "Sync wave.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The Kubernetes resource Version.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
 func resourceApplicationHealthStatus() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
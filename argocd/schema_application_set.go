@@ -110,13 +110,14 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 						Schema: map[string]*schema.Schema{
 							"preserve_resources_on_deletion": {
 								Type:        schema.TypeBool,
-								Description: "Label selector used to narrow the scope of targeted clusters.",
+								Description: "Whether the resources of generated applications should be preserved when the application set is deleted. When `true`, deleting the application set (or a generated application) will not cascade to the resources deployed by the generated applications.",
 								Optional:    true,
 							},
 							"applications_sync": {
-								Type:        schema.TypeString,
-								Description: "Represents the policy applied on the generated applications. Possible values are create-only, create-update, create-delete, and sync.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "Represents the policy applied on the generated applications. Possible values are `create-only`, `create-update`, `create-delete`, and `sync`.",
+								Optional:     true,
+								ValidateFunc: validateApplicationsSyncPolicy,
 							},
 						},
 					},
@@ -148,6 +149,103 @@ func applicationSetSpecSchemaV1() *schema.Schema {
 	return applicationSetSpecSchemaV0()
 }
 
+func applicationSetStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Status information for the application set. **Note**: this is not guaranteed to be up to date immediately after creating/updating an application set since the generated applications are reconciled asynchronously by the ApplicationSet controller.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"application_status": {
+					Type:        schema.TypeList,
+					Description: "Progressive sync status of the generated applications.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"application": {
+								Type:        schema.TypeString,
+								Description: "Name of the generated application.",
+								Computed:    true,
+							},
+							"last_transition_time": {
+								Type:        schema.TypeString,
+								Description: "The time the status was last updated.",
+								Computed:    true,
+							},
+							"message": {
+								Type:        schema.TypeString,
+								Description: "Human-readable message indicating details about the status.",
+								Computed:    true,
+							},
+							"status": {
+								Type:        schema.TypeString,
+								Description: "The application set's perceived status of the generated application.",
+								Computed:    true,
+							},
+							"step": {
+								Type:        schema.TypeString,
+								Description: "The progressive sync step in which this application should be updated.",
+								Computed:    true,
+							},
+							"target_revisions": {
+								Type:        schema.TypeList,
+								Description: "The desired revisions the application should be synced to.",
+								Computed:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"conditions": {
+					Type:        schema.TypeList,
+					Description: "List of currently observed application set conditions.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"last_transition_time": {
+								Type:        schema.TypeString,
+								Description: "The time the condition was last observed.",
+								Computed:    true,
+							},
+							"message": {
+								Type:        schema.TypeString,
+								Description: "Human-readable message indicating details about condition.",
+								Computed:    true,
+							},
+							"reason": {
+								Type:        schema.TypeString,
+								Description: "Single word camelcase representing the reason for the status, e.g. `ErrorOccurred`.",
+								Computed:    true,
+							},
+							"status": {
+								Type:        schema.TypeString,
+								Description: "Status of the condition. One of `True`, `False` or `Unknown`.",
+								Computed:    true,
+							},
+							"type": {
+								Type:        schema.TypeString,
+								Description: "Application set condition type.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"resources": {
+					Type:        schema.TypeList,
+					Description: "List of applications generated by this application set.",
+					Computed:    true,
+					Elem:        resourceApplicationResourceStatus(),
+				},
+				"resources_count": {
+					Type:        schema.TypeInt,
+					Description: "Total number of applications generated by this application set. This may be higher than the number of items in `resources` when the number of applications exceeds the limit imposed by the controller.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
 func applicationSetGeneratorSchemaV0() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -454,9 +552,10 @@ func applicationSetListGeneratorSchemaV0() *schema.Schema {
 					},
 				},
 				"elements_yaml": {
-					Type:        schema.TypeString,
-					Description: "YAML string containing list of key/value pairs to pass as parameters into the template",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "YAML string containing list of key/value pairs to pass as parameters into the template. Unlike `elements`, values are not restricted to strings, which makes it possible to pass e.g. the output of `yamlencode()` or `templatefile()`. Within a matrix generator, this may also be a template referencing parameters of a previous generator.",
+					Optional:     true,
+					ValidateFunc: validateElementsYaml,
 				},
 				"template": {
 					Type:        schema.TypeList,
@@ -748,9 +847,10 @@ func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 								Optional:    true,
 							},
 							"app_secret_name": {
-								Type:        schema.TypeString,
-								Description: "Reference to a GitHub App repo-creds secret. Uses a GitHub App to access the API instead of a PAT.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "Reference to a GitHub App repo-creds secret, e.g. the `secret_name` of an `argocd_repository_credentials` configured with a GitHub App. Uses a GitHub App to access the API instead of a PAT.",
+								Optional:     true,
+								ValidateFunc: validateMetadataName,
 							},
 							"organization": {
 								Type:        schema.TypeString,
@@ -982,9 +1082,10 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 								Optional:    true,
 							},
 							"app_secret_name": {
-								Type:        schema.TypeString,
-								Description: "Reference to a GitHub App repo-creds secret with permission to access pull requests.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "Reference to a GitHub App repo-creds secret with permission to access pull requests, e.g. the `secret_name` of an `argocd_repository_credentials` configured with a GitHub App.",
+								Optional:     true,
+								ValidateFunc: validateMetadataName,
 							},
 							"labels": {
 								Type:        schema.TypeList,
@@ -1103,10 +1204,11 @@ func applicationSetTemplateResource(allOptional bool) *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"name": {
-							Type:        schema.TypeString,
-							Description: "Name of the resulting Application",
-							Optional:    allOptional,
-							Required:    !allOptional,
+							Type:         schema.TypeString,
+							Description:  "Name of the resulting Application. Parts of the name outside of template expressions are validated against Kubernetes resource name constraints.",
+							Optional:     allOptional,
+							Required:     !allOptional,
+							ValidateFunc: validateApplicationSetTemplateName,
 						},
 						"namespace": {
 							Type:        schema.TypeString,
@@ -1131,14 +1233,16 @@ func secretRefResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
-				Type:        schema.TypeString,
-				Description: "Key containing information in Kubernetes `Secret`.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Key containing information in Kubernetes `Secret`, e.g. `password` or `bearerToken` for the secret of an `argocd_repository` or `argocd_repository_credentials`.",
+				Required:     true,
+				ValidateFunc: validateConfigKey,
 			},
 			"secret_name": {
-				Type:        schema.TypeString,
-				Description: "Name of Kubernetes `Secret`.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Name of Kubernetes `Secret`, e.g. the `secret_name` of an `argocd_repository` or `argocd_repository_credentials`. The `Secret` must reside in the namespace of the ApplicationSet controller.",
+				Required:     true,
+				ValidateFunc: validateMetadataName,
 			},
 		},
 	}
@@ -1148,14 +1252,16 @@ func configMapRefResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
-				Type:        schema.TypeString,
-				Description: "Key containing information in trusted CA certs.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Key containing information in trusted CA certs.",
+				Required:     true,
+				ValidateFunc: validateConfigKey,
 			},
 			"config_map_name": {
-				Type:        schema.TypeString,
-				Description: "Name of the ConfigMap.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Name of the ConfigMap.",
+				Required:     true,
+				ValidateFunc: validateMetadataName,
 			},
 		},
 	}
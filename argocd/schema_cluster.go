@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func clusterSchema() map[string]*schema.Schema {
@@ -34,17 +35,27 @@ func clusterSchema() map[string]*schema.Schema {
 			},
 		},
 		"shard": {
-			Type:        schema.TypeString,
-			Description: "Optional shard number. Calculated on the fly by the application controller if not specified.",
-			Optional:    true,
+			Type:         schema.TypeString,
+			Description:  "Optional shard number of the application controller replica managing the cluster, starting at `0`. Calculated on the fly by the application controller if not specified.",
+			Optional:     true,
+			ValidateFunc: validateClusterShard,
 		},
 		"namespaces": {
 			Type:        schema.TypeList,
-			Description: "List of namespaces which are accessible in that cluster. Cluster level resources would be ignored if namespace list is not empty.",
+			Description: "List of namespaces which are accessible in that cluster. Cluster level resources would be ignored if namespace list is not empty, unless `cluster_resources` is enabled. The order of the namespaces is not significant.",
 			Optional:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
+			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+				o, n := d.GetChange("namespaces")
+				return sameStringElements(clusterNamespaces(o), clusterNamespaces(n))
+			},
+		},
+		"cluster_resources": {
+			Type:        schema.TypeBool,
+			Description: "Whether cluster level resources are managed when the cluster is restricted to `namespaces`. Cluster level resources are always managed if `namespaces` is empty, hence it can only be disabled together with `namespaces`.",
+			Optional:    true,
 		},
 		"config": {
 			Type:        schema.TypeList,
@@ -55,8 +66,10 @@ func clusterSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"aws_auth_config": {
-						Type:     schema.TypeList,
-						Optional: true,
+						Type:        schema.TypeList,
+						Description: "Configuration for ArgoCD's native IAM authentication against EKS clusters, using the AWS credentials available to the ArgoCD application controller and server.",
+						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"cluster_name": {
@@ -69,6 +82,11 @@ func clusterSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "IAM role ARN. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.",
 								},
+								"profile": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "AWS profile. If set then AWS IAM Authenticator uses the profile to perform cluster operations instead of the default AWS credential provider chain.",
+								},
 							},
 						},
 					},
@@ -78,17 +96,31 @@ func clusterSchema() map[string]*schema.Schema {
 						Optional:    true,
 						Sensitive:   true,
 					},
+					"bearer_token_wo": {
+						Type:          schema.TypeString,
+						Description:   "Write-only variant of `bearer_token` which is never stored in the plan or state. Bump `credentials_version` to update the token. Requires Terraform 1.11 or later.",
+						Optional:      true,
+						Sensitive:     true,
+						WriteOnly:     true,
+						ConflictsWith: []string{"config.0.bearer_token"},
+					},
+					"credentials_version": {
+						Type:        schema.TypeString,
+						Description: "Arbitrary value which triggers an update of the write-only credentials `bearer_token_wo` and `tls_client_config.key_data_wo` whenever it changes, e.g. when rotating them. If `direct_secret` is enabled, credentials tracked in the state, including this value, are cleared whenever `credentials_hash` changes outside of Terraform, so that the configured credentials are applied again by the next apply. Changes outside of Terraform are not detected when managing the cluster through the API.",
+						Optional:    true,
+					},
 					"exec_provider_config": {
 						Type:        schema.TypeList,
 						Optional:    true,
 						MaxItems:    1,
-						Description: "Configuration for an exec provider used to call an external command to perform cluster authentication See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig.",
+						Description: "Configuration for an exec provider used to call an external command to perform cluster authentication, e.g. `aws eks get-token` for EKS or `gke-gcloud-auth-plugin` for GKE. See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig.",
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"api_version": {
-									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "Preferred input version of the ExecInfo",
+									Type:         schema.TypeString,
+									Optional:     true,
+									Description:  "Preferred input version of the ExecInfo. One of `client.authentication.k8s.io/v1` or `client.authentication.k8s.io/v1beta1`.",
+									ValidateFunc: validation.StringInSlice([]string{"client.authentication.k8s.io/v1", "client.authentication.k8s.io/v1beta1"}, false),
 								},
 								"args": {
 									Type:        schema.TypeList,
@@ -100,9 +132,10 @@ func clusterSchema() map[string]*schema.Schema {
 									},
 								},
 								"command": {
-									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "Command to execute",
+									Type:         schema.TypeString,
+									Optional:     true,
+									Description:  "Command to execute, e.g. `aws` or `gke-gcloud-auth-plugin`. Must be set whenever `exec_provider_config` is configured. The command must be available on the ArgoCD application controller and server.",
+									ValidateFunc: validation.StringIsNotWhiteSpace,
 								},
 								"env": {
 									Type:        schema.TypeMap,
@@ -129,9 +162,11 @@ func clusterSchema() map[string]*schema.Schema {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"ca_data": {
-									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "PEM-encoded bytes (typically read from a root certificates bundle).",
+									Type:         schema.TypeString,
+									Optional:     true,
+									Description:  "PEM-encoded bytes (typically read from a root certificates bundle). May also be provided as base64 encoded PEM or as the path to a PEM file. The PEM-encoded bytes are stored in the state, so that changes to the content of the file are planned as well.",
+									ValidateFunc: validateClusterCAData,
+									StateFunc:    clusterCADataStateFunc,
 								},
 								"cert_data": {
 									Type:        schema.TypeString,
@@ -149,6 +184,14 @@ func clusterSchema() map[string]*schema.Schema {
 									Sensitive:   true,
 									Description: "PEM-encoded bytes (typically read from a client certificate key file).",
 								},
+								"key_data_wo": {
+									Type:          schema.TypeString,
+									Optional:      true,
+									Sensitive:     true,
+									WriteOnly:     true,
+									ConflictsWith: []string{"config.0.tls_client_config.0.key_data"},
+									Description:   "Write-only variant of `key_data` which is never stored in the plan or state. Bump `credentials_version` to update the key. Requires Terraform 1.11 or later.",
+								},
 								"server_name": {
 									Type:        schema.TypeString,
 									Optional:    true,
@@ -171,6 +214,11 @@ func clusterSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"credentials_hash": {
+			Type:        schema.TypeString,
+			Description: "HMAC-SHA256 of the credentials stored within the secret of the cluster, keyed with the UID of the secret, recorded whenever Terraform writes them. Only tracked if `direct_secret` is enabled: the ArgoCD API never returns the credentials of clusters, hence credentials changed outside of Terraform are not detected when managing the cluster through the API.",
+			Computed:    true,
+		},
 		"info": {
 			Type:        schema.TypeList,
 			Description: "Information about cluster cache and state.",
@@ -211,9 +259,9 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"metadata": {
 			Type:        schema.TypeList,
-			Description: "Standard cluster secret's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata",
+			Description: "Standard cluster secret's metadata. Labels can be used to select the cluster from the cluster generator of an ApplicationSet. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata",
 			Optional:    true,
-			MaxItems:    2,
+			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"annotations": {
@@ -235,7 +283,32 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"project": {
 			Type:        schema.TypeString,
-			Description: "Reference between project and cluster that allow you automatically to be added as item inside Destinations project entity. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters.",
+			Description: "Reference between project and cluster that allow you automatically to be added as item inside Destinations project entity. The project must exist. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters.",
+			Optional:    true,
+		},
+		"wait": {
+			Type:        schema.TypeBool,
+			Description: "Upon cluster creation or update, wait for the connection state of the cluster to be `Successful`, when set to true. A failed connection is retried until the timeout is reached, after which the last connection message is reported. Wait timeouts are controlled by Terraform Create and Update resource timeouts (all default to 5 minutes).",
+			Optional:    true,
+		},
+		"refresh_cache_on_update": {
+			Type:        schema.TypeBool,
+			Description: "Whether the cluster cache of ArgoCD is invalidated after the credentials (`config`) or the scope (`namespaces`, `cluster_resources`, `project`) of the cluster are updated, when set to true, so that the application controller reconnects to the cluster with the new settings right away instead of relying on a stale cache.",
+			Optional:    true,
+		},
+		"check_dependent_applications": {
+			Type:        schema.TypeBool,
+			Description: "Whether the deletion of the cluster fails when applications are still deployed to it, when set to true. The error lists the applications whose destination is the cluster, which must be deleted or moved to another cluster first. Set to false to delete the cluster regardless, which orphans any remaining applications. Can not be enabled along with `direct_secret`, as the applications are listed through the ArgoCD API.",
+			Optional:    true,
+		},
+		"direct_secret": {
+			Type:        schema.TypeBool,
+			Description: "Whether the cluster is registered by managing its [declarative cluster secret](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters) directly through the Kubernetes API instead of the ArgoCD API, when set to true. Requires the provider to be configured with [`core = true`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core). This allows registering clusters before the ArgoCD components are running, at the cost of ArgoCD not checking the connection to the cluster, hence `wait`, `refresh_cache_on_update` and `adopt_existing` are ignored, `check_dependent_applications` can not be enabled and `info` is not populated. Labels and annotations added to the secret outside of Terraform are retained.",
+			Optional:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "Whether a cluster which is already registered in ArgoCD with the same `server`, such as the implicit in-cluster `https://kubernetes.default.svc` entry, is brought under management on create instead of failing. The configuration of the existing cluster is overwritten with the configured one. Destroying an adopted in-cluster entry reverts it to the implicit in-cluster defaults of ArgoCD.",
 			Optional:    true,
 		},
 	}
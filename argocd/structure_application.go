@@ -47,8 +47,8 @@ func expandApplicationSpec(s map[string]interface{}, featureApplicationSourceNam
 		}
 	}
 
-	if v, ok := s["destination"]; ok {
-		spec.Destination = expandApplicationDestination(v.(*schema.Set).List()[0])
+	if v, ok := s["destination"].(*schema.Set); ok && v.Len() > 0 {
+		spec.Destination = expandApplicationDestination(v.List()[0])
 	}
 
 	if v, ok := s["source"].([]interface{}); ok && len(v) > 0 {
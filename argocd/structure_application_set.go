@@ -13,14 +13,14 @@ import (
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-func expandApplicationSet(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool, featureApplicationSetTemplatePatch bool, featureApplicationSourceNameSupported bool) (metadata meta.ObjectMeta, spec application.ApplicationSetSpec, err error) {
+func expandApplicationSet(d resourceGetter, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool, featureApplicationSetTemplatePatch bool, featureApplicationSourceNameSupported bool) (metadata meta.ObjectMeta, spec application.ApplicationSetSpec, err error) {
 	metadata = expandMetadata(d)
 	spec, err = expandApplicationSetSpec(d, featureMultipleApplicationSourcesSupported, featureApplicationSetIgnoreApplicationDifferences, featureApplicationSetTemplatePatch, featureApplicationSourceNameSupported)
 
 	return
 }
 
-func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool, featureApplicationSetTemplatePatch bool, featureApplicationSourceNameSupported bool) (spec application.ApplicationSetSpec, err error) {
+func expandApplicationSetSpec(d resourceGetter, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool, featureApplicationSetTemplatePatch bool, featureApplicationSourceNameSupported bool) (spec application.ApplicationSetSpec, err error) {
 	s := d.Get("spec.0").(map[string]interface{})
 
 	if v, ok := s["generator"].([]interface{}); ok && len(v) > 0 {
@@ -269,6 +269,12 @@ func expandApplicationSetListGenerator(lg interface{}, featureMultipleApplicatio
 	// Handle elements_yaml field
 	if yamlStr, ok := l["elements_yaml"].(string); ok && yamlStr != "" {
 		asg.List.ElementsYaml = yamlStr
+
+		// `elements` is a required field of the list generator, even when
+		// all elements are provided via `elements_yaml`.
+		if asg.List.Elements == nil {
+			asg.List.Elements = []apiextensionsv1.JSON{}
+		}
 	}
 
 	if v, ok := l["template"].([]interface{}); ok && len(v) > 0 {
@@ -304,6 +310,7 @@ func expandApplicationSetMatrixGenerator(mg interface{}, featureMultipleApplicat
 			Plugin:                  g.Plugin,
 			PullRequest:             g.PullRequest,
 			SCMProvider:             g.SCMProvider,
+			Selector:                g.Selector,
 		}
 
 		if g.Matrix != nil {
@@ -374,6 +381,7 @@ func expandApplicationSetMergeGenerator(mg interface{}, featureMultipleApplicati
 			Plugin:                  g.Plugin,
 			PullRequest:             g.PullRequest,
 			SCMProvider:             g.SCMProvider,
+			Selector:                g.Selector,
 		}
 
 		if g.Matrix != nil {
@@ -940,15 +948,17 @@ func expandApplicationSetTemplate(temp interface{}, featureMultipleApplicationSo
 		return template, fmt.Errorf("could not expand application set template")
 	}
 
-	if v, ok := t["metadata"]; ok {
-		template.ApplicationSetTemplateMeta, err = expandApplicationSetTemplateMeta(v.([]interface{})[0])
+	// Generator level templates may override only a subset of the spec level
+	// template, so both `metadata` and `spec` may be omitted.
+	if v, ok := t["metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		template.ApplicationSetTemplateMeta, err = expandApplicationSetTemplateMeta(v[0])
 		if err != nil {
 			return template, err
 		}
 	}
 
-	if v, ok := t["spec"]; ok {
-		s := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := t["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s := v[0].(map[string]interface{})
 
 		template.Spec, err = expandApplicationSpec(s, featureApplicationSourceNameSupported)
 		if err != nil {
@@ -1053,9 +1063,65 @@ func flattenApplicationSet(as *application.ApplicationSet, d *schema.ResourceDat
 		return fmt.Errorf("error persisting spec: %s\n%s", err, e)
 	}
 
+	fStatus := flattenApplicationSetStatus(as.Status)
+	if err := d.Set("status", fStatus); err != nil {
+		e, _ := json.MarshalIndent(fStatus, "", "\t")
+		return fmt.Errorf("error persisting status: %s\n%s", err, e)
+	}
+
 	return nil
 }
 
+func flattenApplicationSetStatus(s application.ApplicationSetStatus) []map[string]interface{} {
+	status := map[string]interface{}{
+		"application_status": flattenApplicationSetApplicationStatuses(s.ApplicationStatus),
+		"conditions":         flattenApplicationSetConditions(s.Conditions),
+		"resources":          flattenApplicationResourceStatuses(s.Resources),
+		"resources_count":    s.ResourcesCount,
+	}
+
+	return []map[string]interface{}{status}
+}
+
+func flattenApplicationSetApplicationStatuses(asass []application.ApplicationSetApplicationStatus) []map[string]interface{} {
+	ass := make([]map[string]interface{}, len(asass))
+
+	for i, v := range asass {
+		ass[i] = map[string]interface{}{
+			"application":      v.Application,
+			"message":          v.Message,
+			"status":           v.Status,
+			"step":             v.Step,
+			"target_revisions": v.TargetRevisions,
+		}
+
+		if v.LastTransitionTime != nil {
+			ass[i]["last_transition_time"] = v.LastTransitionTime.String()
+		}
+	}
+
+	return ass
+}
+
+func flattenApplicationSetConditions(ascs []application.ApplicationSetCondition) []map[string]interface{} {
+	cs := make([]map[string]interface{}, len(ascs))
+
+	for i, v := range ascs {
+		cs[i] = map[string]interface{}{
+			"message": v.Message,
+			"reason":  v.Reason,
+			"status":  v.Status,
+			"type":    v.Type,
+		}
+
+		if v.LastTransitionTime != nil {
+			cs[i]["last_transition_time"] = v.LastTransitionTime.String()
+		}
+	}
+
+	return cs
+}
+
 func flattenApplicationSetSpec(s application.ApplicationSetSpec) ([]map[string]interface{}, error) {
 	generators := make([]interface{}, len(s.Generators))
 
@@ -1735,7 +1801,10 @@ func flattenApplicationMatchExpression(in []application.ApplicationMatchExpressi
 func flattenApplicationSetSyncPolicy(assp application.ApplicationSetSyncPolicy) []map[string]interface{} {
 	p := map[string]interface{}{
 		"preserve_resources_on_deletion": assp.PreserveResourcesOnDeletion,
-		"applications_sync":              assp.ApplicationsSync,
+	}
+
+	if assp.ApplicationsSync != nil {
+		p["applications_sync"] = string(*assp.ApplicationsSync)
 	}
 
 	return []map[string]interface{}{p}
@@ -1757,7 +1826,11 @@ func flattenApplicationSetTemplate(ast application.ApplicationSetTemplate) []map
 
 	t := map[string]interface{}{
 		"metadata": flattenApplicationSetTemplateMetadata(ast.ApplicationSetTemplateMeta),
-		"spec":     flattenApplicationSpec(ast.Spec),
+	}
+
+	// Generator level templates may only override the template metadata
+	if !reflect.ValueOf(ast.Spec).IsZero() {
+		t["spec"] = flattenApplicationSpec(ast.Spec)
 	}
 
 	return []map[string]interface{}{t}
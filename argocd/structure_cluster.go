@@ -1,10 +1,21 @@
 package argocd
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
@@ -37,10 +48,19 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 		}
 	}
 
+	cluster.ClusterResources = d.Get("cluster_resources").(bool)
+
 	if v, ok := d.GetOk("config"); ok {
-		cluster.Config = expandClusterConfig(v.([]interface{})[0])
+		config, err := expandClusterConfig(v.([]interface{})[0])
+		if err != nil {
+			return nil, err
+		}
+
+		cluster.Config = config
 	}
 
+	expandClusterConfigWriteOnly(d, &cluster.Config)
+
 	m := expandMetadata(d)
 	cluster.Annotations = m.Annotations
 	cluster.Labels = m.Labels
@@ -52,10 +72,12 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 	return cluster, nil
 }
 
-func expandClusterConfig(config interface{}) application.ClusterConfig {
+func expandClusterConfig(config interface{}) (application.ClusterConfig, error) {
 	clusterConfig := application.ClusterConfig{}
 
-	c := config.(map[string]interface{})
+	// An empty config block, e.g. for the in-cluster entry which relies on the
+	// service account of ArgoCD, is not decoded to a map
+	c, _ := config.(map[string]interface{})
 	if aws, ok := c["aws_auth_config"].([]interface{}); ok && len(aws) > 0 {
 		clusterConfig.AWSAuthConfig = &application.AWSAuthConfig{}
 
@@ -65,6 +87,8 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 				clusterConfig.AWSAuthConfig.ClusterName = v.(string)
 			case "role_arn":
 				clusterConfig.AWSAuthConfig.RoleARN = v.(string)
+			case "profile":
+				clusterConfig.AWSAuthConfig.Profile = v.(string)
 			}
 		}
 	}
@@ -87,7 +111,16 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 		for k, v := range tls[0].(map[string]interface{}) {
 			switch k {
 			case "ca_data":
-				clusterConfig.CAData = []byte(v.(string))
+				data, err := normalizeClusterCAData(v.(string))
+				if err != nil {
+					return clusterConfig, fmt.Errorf("config.0.tls_client_config.0.ca_data: %w", err)
+				}
+
+				if errs := validateClusterCACertificates(data); len(errs) > 0 {
+					return clusterConfig, fmt.Errorf("config.0.tls_client_config.0.ca_data: %w", errors.Join(errs...))
+				}
+
+				clusterConfig.CAData = data
 			case "cert_data":
 				clusterConfig.CertData = []byte(v.(string))
 			case "key_data":
@@ -127,25 +160,97 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 		}
 	}
 
-	return clusterConfig
+	return clusterConfig, nil
 }
 
-func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error {
-	r := map[string]interface{}{
-		"name":       cluster.Name,
-		"server":     cluster.Server,
-		"namespaces": cluster.Namespaces,
-		"info":       flattenClusterInfo(cluster.Info),
-		"config":     flattenClusterConfig(cluster.Config, d),
-		"project":    cluster.Project,
+// decodeClusterCAData returns the PEM encoded CA bundle from either raw PEM or
+// base64 encoded PEM, and false if the CA data is neither of them, i.e. the
+// path to a PEM file.
+func decodeClusterCAData(caData string) ([]byte, bool) {
+	if caData == "" || strings.HasPrefix(strings.TrimSpace(caData), "-----BEGIN") {
+		return []byte(caData), true
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(caData)); err == nil && bytes.HasPrefix(bytes.TrimSpace(decoded), []byte("-----BEGIN")) {
+		return decoded, true
+	}
+
+	return nil, false
+}
+
+// normalizeClusterCAData returns the PEM encoded CA bundle from either raw
+// PEM, base64 encoded PEM or the path to a PEM file.
+func normalizeClusterCAData(caData string) ([]byte, error) {
+	if data, ok := decodeClusterCAData(caData); ok {
+		return data, nil
 	}
 
-	if len(cluster.Annotations) != 0 || len(cluster.Labels) != 0 {
+	data, err := os.ReadFile(caData)
+	if err != nil {
+		return nil, fmt.Errorf("must be PEM encoded, base64 encoded PEM or the path to a PEM file: %w", err)
+	}
+
+	return data, nil
+}
+
+// clusterCADataStateFunc stores the PEM encoded CA bundle in the state rather
+// than the configured value, so that changes to the content of a referenced
+// PEM file are planned as well.
+func clusterCADataStateFunc(v interface{}) string {
+	data, err := normalizeClusterCAData(v.(string))
+	if err != nil {
+		// The error surfaces when the cluster is created or updated
+		return v.(string)
+	}
+
+	return string(data)
+}
+
+// expandClusterConfigWriteOnly sets the write-only credentials of the
+// cluster, which are only available from the raw configuration.
+func expandClusterConfigWriteOnly(d *schema.ResourceData, clusterConfig *application.ClusterConfig) {
+	configPath := cty.GetAttrPath("config").IndexInt(0)
+
+	if v := rawConfigString(d, configPath.GetAttr("bearer_token_wo")); v != "" {
+		clusterConfig.BearerToken = v
+	}
+
+	if v := rawConfigString(d, configPath.GetAttr("tls_client_config").IndexInt(0).GetAttr("key_data_wo")); v != "" {
+		clusterConfig.KeyData = []byte(v)
+	}
+}
+
+func rawConfigString(d *schema.ResourceData, p cty.Path) string {
+	v, diags := d.GetRawConfigAt(p)
+	if diags.HasError() || v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error {
+	r := map[string]interface{}{
+		"name":              cluster.Name,
+		"server":            cluster.Server,
+		"namespaces":        flattenClusterNamespaces(cluster.Namespaces, d),
+		"cluster_resources": cluster.ClusterResources,
+		"info":              flattenClusterInfo(cluster.Info),
+		"config":            flattenClusterConfig(cluster.Config, d),
+		"project":           cluster.Project,
+	}
+
+	// Metadata which is tracked in the state is always read back, so that
+	// labels and annotations removed outside of Terraform show up as drift
+	if _, ok := d.GetOk("metadata"); ok || len(cluster.Annotations) != 0 || len(cluster.Labels) != 0 {
 		// The generic flattenMetadata function can not be used since the Cluster
 		// object does not actually have ObjectMeta, just label and annotation maps
-		r["metadata"] = flattenClusterMetadata(cluster.Annotations, cluster.Labels)
+		r["metadata"] = flattenClusterMetadata(cluster.Annotations, cluster.Labels, d)
 	}
 
+	// A nil shard means the application controller assigns it, whereas 0 pins
+	// the cluster to the first shard
+	r["shard"] = ""
 	if cluster.Shard != nil {
 		r["shard"] = convertInt64PointerToString(cluster.Shard)
 	}
@@ -159,6 +264,107 @@ func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error
 	return nil
 }
 
+// clusterCredentialsHash returns the HMAC-SHA256 of the credentials within the
+// configuration of the cluster, or an empty string if it has none. The HMAC is
+// keyed with the UID of the secret of the cluster, which is not stored in the
+// state, so that the credentials cannot be guessed from the hash.
+func clusterCredentialsHash(config application.ClusterConfig, uid k8stypes.UID) string {
+	if config.BearerToken == "" && config.Password == "" && len(config.KeyData) == 0 {
+		return ""
+	}
+
+	h := hmac.New(sha256.New, []byte(uid))
+
+	for _, v := range [][]byte{[]byte(config.BearerToken), []byte(config.Password), config.KeyData} {
+		h.Write(v)
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// markClusterCredentialsStale clears the credentials tracked in the state,
+// which the API never returns, so that the next plan re-applies the configured
+// credentials. Returns whether any credentials were cleared.
+func markClusterCredentialsStale(d *schema.ResourceData) (bool, error) {
+	config, ok := d.Get("config").([]interface{})
+	if !ok || len(config) == 0 {
+		return false, nil
+	}
+
+	c, ok := config[0].(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+
+	stale := false
+
+	for _, k := range []string{"bearer_token", "password", "credentials_version"} {
+		if v, _ := c[k].(string); v != "" {
+			c[k], stale = "", true
+		}
+	}
+
+	if tls, ok := c["tls_client_config"].([]interface{}); ok && len(tls) > 0 {
+		if t, ok := tls[0].(map[string]interface{}); ok {
+			if v, _ := t["key_data"].(string); v != "" {
+				t["key_data"], stale = "", true
+			}
+		}
+	}
+
+	if !stale {
+		return false, nil
+	}
+
+	return true, d.Set("config", config)
+}
+
+// clusterDependentApplications returns the `<namespace>/<name>` of the
+// applications whose destination is the cluster, either by server or by name.
+func clusterDependentApplications(apps []application.Application, server, name string) []string {
+	server = strings.TrimRight(server, "/")
+	if name == "" {
+		name = server
+	}
+
+	var dependents []string
+
+	for _, app := range apps {
+		dest := app.Spec.Destination
+		if (dest.Server != "" && strings.TrimRight(dest.Server, "/") == server) || (dest.Name != "" && dest.Name == name) {
+			dependents = append(dependents, app.Namespace+"/"+app.Name)
+		}
+	}
+
+	slices.Sort(dependents)
+
+	return dependents
+}
+
+// flattenClusterNamespaces keeps the order of the namespaces tracked in the
+// state whenever ArgoCD returns the same namespaces.
+func flattenClusterNamespaces(namespaces []string, d *schema.ResourceData) []string {
+	if current := clusterNamespaces(d.Get("namespaces")); sameStringElements(current, namespaces) {
+		return current
+	}
+
+	return namespaces
+}
+
+// clusterNamespaces converts the namespaces of the configuration or state,
+// where empty strings are represented as nil elements.
+func clusterNamespaces(v interface{}) []string {
+	l, _ := v.([]interface{})
+	namespaces := make([]string, len(l))
+
+	for i, n := range l {
+		namespaces[i], _ = n.(string)
+	}
+
+	return namespaces
+}
+
 func flattenClusterInfo(info application.ClusterInfo) []map[string]interface{} {
 	return []map[string]interface{}{
 		{
@@ -186,6 +392,7 @@ func flattenClusterConfig(config application.ClusterConfig, d *schema.ResourceDa
 			{
 				"cluster_name": config.AWSAuthConfig.ClusterName,
 				"role_arn":     config.AWSAuthConfig.RoleARN,
+				"profile":      config.AWSAuthConfig.Profile,
 			},
 		}
 	}
@@ -202,6 +409,10 @@ func flattenClusterConfig(config application.ClusterConfig, d *schema.ResourceDa
 		r["password"] = p
 	}
 
+	if cv, ok := d.GetOk("config.0.credentials_version"); ok {
+		r["credentials_version"] = cv
+	}
+
 	return []map[string]interface{}{r}
 }
 
@@ -263,11 +474,11 @@ func flattenClusterConfigExecProviderConfig(d *schema.ResourceData) []map[string
 	return []map[string]interface{}{c}
 }
 
-func flattenClusterMetadata(annotations, labels map[string]string) []map[string]interface{} {
+func flattenClusterMetadata(annotations, labels map[string]string, d *schema.ResourceData) []map[string]interface{} {
 	return []map[string]interface{}{
 		{
-			"annotations": annotations,
-			"labels":      labels,
+			"annotations": metadataRemoveInternalKeys(annotations, d.Get("metadata.0.annotations").(map[string]interface{})),
+			"labels":      metadataRemoveInternalKeys(labels, d.Get("metadata.0.labels").(map[string]interface{})),
 		},
 	}
 }
@@ -0,0 +1,351 @@
+package argocd
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+func TestClusterAWSAuthConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{
+		"server": "https://eks.example.com",
+		"config": []interface{}{
+			map[string]interface{}{
+				"aws_auth_config": []interface{}{
+					map[string]interface{}{
+						"cluster_name": "myekscluster",
+						"role_arn":     "arn:aws:iam::123456789012:role/argocd",
+						"profile":      "argocd",
+					},
+				},
+			},
+		},
+	})
+
+	cluster, err := expandCluster(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := application.AWSAuthConfig{
+		ClusterName: "myekscluster",
+		RoleARN:     "arn:aws:iam::123456789012:role/argocd",
+		Profile:     "argocd",
+	}
+
+	if cluster.Config.AWSAuthConfig == nil || *cluster.Config.AWSAuthConfig != expected {
+		t.Fatalf("expected aws auth config %+v, got %+v", expected, cluster.Config.AWSAuthConfig)
+	}
+
+	// The API returns the AWS auth config as is, so it must be read back
+	// without relying on the state
+	read := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{})
+	if err := flattenCluster(cluster, read); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, v := range map[string]string{
+		"config.0.aws_auth_config.0.cluster_name": expected.ClusterName,
+		"config.0.aws_auth_config.0.role_arn":     expected.RoleARN,
+		"config.0.aws_auth_config.0.profile":      expected.Profile,
+	} {
+		if got := read.Get(k); got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestClusterShardRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		shard    string
+		expected *int64
+	}{
+		{
+			name: "Unassigned shard",
+		},
+		{
+			name:     "First shard",
+			shard:    "0",
+			expected: new(int64),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{
+				"server": "https://kubernetes.default.svc",
+				"config": []interface{}{map[string]interface{}{"username": "admin"}},
+			}
+
+			if tc.shard != "" {
+				raw["shard"] = tc.shard
+			}
+
+			cluster, err := expandCluster(schema.TestResourceDataRaw(t, clusterSchema(), raw))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if (cluster.Shard == nil) != (tc.expected == nil) || (cluster.Shard != nil && *cluster.Shard != *tc.expected) {
+				t.Fatalf("expected shard %v, got %v", tc.expected, cluster.Shard)
+			}
+
+			// A shard which was unset outside of Terraform must show up as drift
+			read := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{"shard": "1"})
+			if err := flattenCluster(cluster, read); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := read.Get("shard"); got != tc.shard {
+				t.Errorf("expected shard to be read as %q, got %q", tc.shard, got)
+			}
+		})
+	}
+}
+
+func TestClusterMetadataDrift(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{
+				"labels": map[string]interface{}{"environment": "production"},
+			},
+		},
+	})
+
+	// Labels removed from the cluster secret outside of Terraform
+	err := flattenCluster(&application.Cluster{
+		Server:      "https://kubernetes.default.svc",
+		Annotations: map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"},
+	}, d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("metadata.0.labels").(map[string]interface{}); len(got) != 0 {
+		t.Errorf("expected labels to be empty, got %v", got)
+	}
+
+	if got := d.Get("metadata.0.annotations").(map[string]interface{}); len(got) != 0 {
+		t.Errorf("expected internal annotations to be ignored, got %v", got)
+	}
+}
+
+func TestGetClusterQueryFromID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		id             string
+		server         string
+		expectedServer string
+		expectedName   string
+	}{
+		{
+			name:           "Server",
+			id:             "https://kubernetes.default.svc",
+			expectedServer: "https://kubernetes.default.svc",
+		},
+		{
+			name:           "Server and name",
+			id:             "https://kubernetes.default.svc/in-cluster",
+			expectedServer: "https://kubernetes.default.svc",
+			expectedName:   "in-cluster",
+		},
+		{
+			name:           "Server with path from state",
+			id:             "https://rancher.example.com/k8s/clusters/c-m-abcd",
+			server:         "https://rancher.example.com/k8s/clusters/c-m-abcd",
+			expectedServer: "https://rancher.example.com/k8s/clusters/c-m-abcd",
+		},
+		{
+			name:           "Server with path and name from state",
+			id:             "https://rancher.example.com/k8s/clusters/c-m-abcd/production",
+			server:         "https://rancher.example.com/k8s/clusters/c-m-abcd",
+			expectedServer: "https://rancher.example.com/k8s/clusters/c-m-abcd",
+			expectedName:   "production",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{}
+			if tc.server != "" {
+				raw["server"] = tc.server
+			}
+
+			d := schema.TestResourceDataRaw(t, clusterSchema(), raw)
+			d.SetId(tc.id)
+
+			cq := getClusterQueryFromID(d)
+			if cq.Server != tc.expectedServer || cq.Name != tc.expectedName {
+				t.Errorf("expected server %q and name %q, got server %q and name %q", tc.expectedServer, tc.expectedName, cq.Server, cq.Name)
+			}
+		})
+	}
+}
+
+func TestClusterCredentialsHash(t *testing.T) {
+	t.Parallel()
+
+	const uid = k8stypes.UID("6a2a1a6e-2f5c-4b5e-9a0e-8b7b1f3e6d51")
+
+	if got := clusterCredentialsHash(application.ClusterConfig{Username: "admin"}, uid); got != "" {
+		t.Errorf("expected no hash without credentials, got %q", got)
+	}
+
+	token := application.ClusterConfig{BearerToken: "token"}
+	hash := clusterCredentialsHash(token, uid)
+
+	if hash == "" {
+		t.Fatalf("expected a hash")
+	}
+
+	if got := clusterCredentialsHash(token, uid); got != hash {
+		t.Errorf("expected the hash to be stable, got %q and %q", hash, got)
+	}
+
+	if got := clusterCredentialsHash(token, "ad0b5b52-6f0e-4f4e-8d6c-2c9b3c1b7e20"); got == hash {
+		t.Errorf("expected the hash to depend on the UID of the secret")
+	}
+
+	rotated := []application.ClusterConfig{
+		{BearerToken: "rotated"},
+		{BearerToken: "token", Password: "password"},
+		{BearerToken: "token", TLSClientConfig: application.TLSClientConfig{KeyData: []byte("key")}},
+		{Password: "token"},
+	}
+
+	for _, c := range rotated {
+		if got := clusterCredentialsHash(c, uid); got == hash {
+			t.Errorf("expected the hash of %+v to differ", c)
+		}
+	}
+}
+
+func TestExpandClusterConfigCAData(t *testing.T) {
+	t.Parallel()
+
+	caPEM := testClusterCAPEM(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(caPEM), 0o600))
+
+	tlsConfig := func(caData string) map[string]interface{} {
+		return map[string]interface{}{
+			"tls_client_config": []interface{}{
+				map[string]interface{}{"ca_data": caData},
+			},
+		}
+	}
+
+	for _, caData := range []string{caPEM, base64.StdEncoding.EncodeToString([]byte(caPEM)), caFile} {
+		config, err := expandClusterConfig(tlsConfig(caData))
+		require.NoError(t, err)
+		assert.Equal(t, caPEM, string(config.TLSClientConfig.CAData))
+
+		// The content of files is stored in the state, so that changes to it are planned
+		assert.Equal(t, caPEM, clusterCADataStateFunc(caData))
+	}
+
+	_, err := expandClusterConfig(tlsConfig(filepath.Join(t.TempDir(), "missing.pem")))
+	assert.ErrorContains(t, err, "ca_data")
+
+	invalidFile := filepath.Join(t.TempDir(), "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydGlmaWNhdGU=\n-----END CERTIFICATE-----\n"), 0o600))
+
+	_, err = expandClusterConfig(tlsConfig(invalidFile))
+	assert.ErrorContains(t, err, "invalid certificate")
+}
+
+func TestMarkClusterCredentialsStale(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{
+		"server": "https://kubernetes.default.svc",
+		"config": []interface{}{
+			map[string]interface{}{
+				"bearer_token":        "token",
+				"credentials_version": "1",
+				"tls_client_config": []interface{}{
+					map[string]interface{}{
+						"key_data": "key",
+						"insecure": true,
+					},
+				},
+			},
+		},
+	})
+
+	stale, err := markClusterCredentialsStale(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !stale {
+		t.Fatalf("expected credentials to be marked as stale")
+	}
+
+	for _, k := range []string{"config.0.bearer_token", "config.0.credentials_version", "config.0.tls_client_config.0.key_data"} {
+		if got := d.Get(k); got != "" {
+			t.Errorf("expected %s to be cleared, got %q", k, got)
+		}
+	}
+
+	if !d.Get("config.0.tls_client_config.0.insecure").(bool) {
+		t.Errorf("expected non-sensitive settings to be preserved")
+	}
+
+	if stale, _ = markClusterCredentialsStale(d); stale {
+		t.Errorf("expected no credentials to be left to clear")
+	}
+}
+
+func TestClusterDependentApplications(t *testing.T) {
+	t.Parallel()
+
+	app := func(name, server, destName string) application.Application {
+		return application.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: application.ApplicationSpec{
+				Destination: application.ApplicationDestination{Server: server, Name: destName},
+			},
+		}
+	}
+
+	apps := []application.Application{
+		app("by-server", "https://kubernetes.example.com/", ""),
+		app("by-name", "", "production"),
+		app("other", "https://kubernetes.default.svc", ""),
+		app("other-name", "", "staging"),
+	}
+
+	got := clusterDependentApplications(apps, "https://kubernetes.example.com", "production")
+	expected := []string{"argocd/by-name", "argocd/by-server"}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := clusterDependentApplications(apps, "https://staging.example.com", ""); len(got) != 0 {
+		t.Errorf("expected no dependent applications, got %v", got)
+	}
+}
@@ -9,7 +9,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func expandMetadata(d *schema.ResourceData) (meta meta.ObjectMeta) {
+func expandMetadata(d resourceGetter) (meta meta.ObjectMeta) {
 	m := d.Get("metadata.0").(map[string]interface{})
 
 	if v, ok := m["annotations"].(map[string]interface{}); ok && len(v) > 0 {
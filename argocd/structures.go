@@ -10,6 +10,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// resourceGetter is satisfied by both *schema.ResourceData and
+// *schema.ResourceDiff, allowing expanders to be reused at plan time.
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
 func expandIntOrString(s string) (*intstr.IntOrString, error) {
 	if len(s) == 0 {
 		return nil, nil
@@ -3,6 +3,7 @@ package argocd
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -89,6 +90,16 @@ func sliceOfString(slice []interface{}) []string {
 	return result
 }
 
+// sameStringElements reports whether both lists contain the same strings,
+// regardless of their order.
+func sameStringElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
 func isValidPolicyAction(action string) bool {
 	validActions := map[string]bool{
 		rbac.ActionGet:      true,
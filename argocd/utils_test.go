@@ -89,3 +89,44 @@ func TestValidatePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestSameStringElements(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     []string
+		expected bool
+	}{
+		{
+			name:     "Both empty",
+			expected: true,
+		},
+		{
+			name:     "Different order",
+			a:        []string{"foo", "bar"},
+			b:        []string{"bar", "foo"},
+			expected: true,
+		},
+		{
+			name: "Different lengths",
+			a:    []string{"foo", "bar"},
+			b:    []string{"foo"},
+		},
+		{
+			name: "Different duplicates",
+			a:    []string{"foo", "foo", "bar"},
+			b:    []string{"foo", "bar", "bar"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sameStringElements(tc.a, tc.b); got != tc.expected {
+				t.Errorf("sameStringElements(%v, %v) = %v, expected %v", tc.a, tc.b, got, tc.expected)
+			}
+		})
+	}
+}
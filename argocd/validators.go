@@ -1,14 +1,19 @@
 package argocd
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
-	"time"
 	_ "time/tzdata"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
 )
 
 func validateMetadataLabels(isAppSet bool) func(value interface{}, key string) (ws []string, es []error) {
@@ -65,16 +70,75 @@ func validateMetadataName(value interface{}, key string) (ws []string, es []erro
 	return
 }
 
+var applicationSetTemplateExpression = regexp.MustCompile(`{{.*?}}`)
+
+// validateApplicationSetTemplateName validates the literal parts of a
+// templated application name, since the parameters substituted by the
+// generators are only known once the application set is reconciled.
+func validateApplicationSetTemplateName(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if !strings.Contains(v, "{{") {
+		return validateMetadataName(value, key)
+	}
+
+	literal := applicationSetTemplateExpression.ReplaceAllString(v, "")
+	if strings.Contains(literal, "{{") || strings.Contains(literal, "}}") {
+		es = append(es, fmt.Errorf("%s (%q) contains an unterminated template expression", key, v))
+		return
+	}
+
+	if len(literal) > utilValidation.DNS1123SubdomainMaxLength {
+		es = append(es, fmt.Errorf("%s (%q) must be no more than %d characters, but is already %d characters before parameter expansion", key, v, utilValidation.DNS1123SubdomainMaxLength, len(literal)))
+	}
+
+	if strings.ContainsFunc(literal, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '.'
+	}) {
+		es = append(es, fmt.Errorf("%s (%q) may only contain lowercase alphanumeric characters, '-' or '.' outside of template expressions", key, v))
+	}
+
+	parts := applicationSetTemplateExpression.Split(v, -1)
+	if strings.HasPrefix(parts[0], "-") || strings.HasPrefix(parts[0], ".") || strings.HasSuffix(parts[len(parts)-1], "-") || strings.HasSuffix(parts[len(parts)-1], ".") {
+		es = append(es, fmt.Errorf("%s (%q) must start and end with an alphanumeric character or a template expression", key, v))
+	}
+
+	return
+}
+
+func validateConfigKey(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	for _, msg := range utilValidation.IsConfigMapKey(v) {
+		es = append(es, fmt.Errorf("%s (%q) %s", key, v, msg))
+	}
+
+	return
+}
+
 func validateDuration(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
-	if _, err := time.ParseDuration(v); err != nil {
+	if err := validators.ValidateDuration(v); err != nil {
 		es = append(es, fmt.Errorf("%s: invalid duration '%s': %s", key, v, err))
 	}
 
 	return
 }
 
+// validateRetryBackoffDuration mirrors ArgoCD's handling of retry backoff
+// durations, where a plain number is interpreted as seconds.
+func validateRetryBackoffDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	// Template expressions of application set templates are only resolved by the controller
+	if _, err := strconv.Atoi(v); err == nil || strings.Contains(v, "{{") {
+		return
+	}
+
+	return validateDuration(value, key)
+}
+
 func validateIntOrStringPercentage(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
@@ -86,3 +150,339 @@ func validateIntOrStringPercentage(value interface{}, key string) (ws []string,
 
 	return
 }
+
+// validateClusterCAData ensures the CA data holds at least one certificate
+// and that all of them can be parsed, since ArgoCD would otherwise only fail
+// when connecting to the cluster. PEM files are not read at validation time,
+// their content is validated when the cluster is created or updated.
+func validateClusterCAData(value interface{}, key string) (ws []string, es []error) {
+	data, ok := decodeClusterCAData(value.(string))
+	if !ok || len(data) == 0 {
+		return
+	}
+
+	for _, err := range validateClusterCACertificates(data) {
+		es = append(es, fmt.Errorf("%s: %w", key, err))
+	}
+
+	return
+}
+
+// validateClusterCACertificates ensures the PEM encoded CA bundle holds at
+// least one certificate and that all of them can be parsed.
+func validateClusterCACertificates(data []byte) (es []error) {
+	certificates := 0
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			es = append(es, fmt.Errorf("unexpected PEM block of type %s, expected CERTIFICATE", block.Type))
+			continue
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			es = append(es, fmt.Errorf("invalid certificate: %w", err))
+			continue
+		}
+
+		certificates++
+	}
+
+	if certificates == 0 && len(es) == 0 {
+		es = append(es, fmt.Errorf("no PEM encoded certificate found"))
+	}
+
+	return
+}
+
+// validateClusterShard ensures the shard is a non-negative integer, since
+// the application controller ignores clusters pinned to any other shard.
+func validateClusterShard(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if i, err := strconv.ParseInt(v, 10, 64); err != nil || i < 0 {
+		es = append(es, fmt.Errorf("%s: invalid shard '%s': must be a non-negative integer", key, v))
+	}
+
+	return
+}
+
+func validateElementsYaml(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if strings.Contains(v, "{{") {
+		// Templated values can only be resolved by the ApplicationSet controller
+		return
+	}
+
+	var elements []map[string]interface{}
+	if err := yaml.Unmarshal([]byte(v), &elements); err != nil {
+		es = append(es, fmt.Errorf("%s: must be a YAML list of objects: %s", key, err))
+	}
+
+	return
+}
+
+func validateApplicationsSyncPolicy(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	switch application.ApplicationsSyncPolicy(v) {
+	case application.ApplicationsSyncPolicyCreateOnly,
+		application.ApplicationsSyncPolicyCreateUpdate,
+		application.ApplicationsSyncPolicyCreateDelete,
+		application.ApplicationsSyncPolicySync:
+	default:
+		es = append(es, fmt.Errorf("%s: invalid applications sync policy '%s'. Must be one of create-only, create-update, create-delete or sync", key, v))
+	}
+
+	return
+}
+
+// validateApplicationSourceRefs ensures that `ref` names are unique across an
+// application's sources and that every `$ref` prefixed Helm value file refers
+// to one of them.
+func validateApplicationSourceRefs(sources []interface{}) error {
+	refs := make(map[string]bool)
+
+	for _, s := range sources {
+		src, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ref, _ := src["ref"].(string)
+		if ref == "" {
+			continue
+		}
+
+		if strings.Contains(ref, "{{") {
+			// Templated refs (e.g. within application sets) can only be
+			// resolved at generation time.
+			return nil
+		}
+
+		if refs[ref] {
+			return fmt.Errorf("source ref %q is defined more than once", ref)
+		}
+
+		refs[ref] = true
+	}
+
+	for _, s := range sources {
+		src, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		helm, ok := src["helm"].([]interface{})
+		if !ok || len(helm) == 0 || helm[0] == nil {
+			continue
+		}
+
+		valueFiles, _ := helm[0].(map[string]interface{})["value_files"].([]interface{})
+		for _, vf := range valueFiles {
+			v, _ := vf.(string)
+			if !strings.HasPrefix(v, "$") {
+				continue
+			}
+
+			ref := strings.SplitN(strings.TrimPrefix(v, "$"), "/", 2)[0]
+			if ref == "" || strings.Contains(ref, "{{") {
+				continue
+			}
+
+			if !refs[ref] {
+				return fmt.Errorf("helm value file %q refers to source ref %q which is not defined by any source", v, ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+var applicationSetTemplateParameter = regexp.MustCompile(`{{\s*(\.?)([\w.\-]+)\s*}}`)
+
+// validateApplicationSetGeneratedNames renders the template name of list
+// generators (including list generators nested within matrix generators) and
+// reports application names that would be generated more than once. Generators
+// whose output cannot be determined statically are skipped.
+func validateApplicationSetGeneratedNames(spec map[string]interface{}) error {
+	goTemplate, _ := spec["go_template"].(bool)
+	specName := applicationSetTemplateName(spec["template"])
+
+	var names []string
+
+	counts := make(map[string]int)
+
+	generators, _ := spec["generator"].([]interface{})
+	for _, g := range generators {
+		generator, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		params, ok := applicationSetGeneratorParams(generator)
+		if !ok {
+			continue
+		}
+
+		name := specName
+		for _, k := range []string{"list", "matrix"} {
+			if v, ok := generator[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if n := applicationSetTemplateName(v[0].(map[string]interface{})["template"]); n != "" {
+					name = n
+				}
+			}
+		}
+
+		if name == "" {
+			continue
+		}
+
+		for _, p := range params {
+			rendered, ok := renderApplicationSetTemplateParameters(name, p, goTemplate)
+			if !ok {
+				break
+			}
+
+			if counts[rendered] == 1 {
+				names = append(names, fmt.Sprintf("%q", rendered))
+			}
+
+			counts[rendered]++
+		}
+	}
+
+	if len(names) > 0 {
+		return fmt.Errorf("generators render duplicate application names: %s", strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+func applicationSetTemplateName(t interface{}) string {
+	template, ok := t.([]interface{})
+	if !ok || len(template) == 0 || template[0] == nil {
+		return ""
+	}
+
+	metadata, ok := template[0].(map[string]interface{})["metadata"].([]interface{})
+	if !ok || len(metadata) == 0 || metadata[0] == nil {
+		return ""
+	}
+
+	name, _ := metadata[0].(map[string]interface{})["name"].(string)
+
+	return name
+}
+
+// applicationSetGeneratorParams returns the parameter sets produced by a list
+// generator, or by a matrix generator combining list generators. The second
+// return value is false when the parameters cannot be determined statically.
+func applicationSetGeneratorParams(generator map[string]interface{}) ([]map[string]string, bool) {
+	if v, ok := generator["selector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return nil, false
+	}
+
+	if v, ok := generator["list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		list := v[0].(map[string]interface{})
+		if ey, _ := list["elements_yaml"].(string); ey != "" {
+			return nil, false
+		}
+
+		elements, _ := list["elements"].([]interface{})
+		params := make([]map[string]string, 0, len(elements))
+
+		for _, e := range elements {
+			element, _ := e.(map[string]interface{})
+			p := make(map[string]string, len(element))
+
+			for k, val := range element {
+				p[k], _ = val.(string)
+			}
+
+			params = append(params, p)
+		}
+
+		return params, true
+	}
+
+	if v, ok := generator["matrix"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		children, _ := v[0].(map[string]interface{})["generator"].([]interface{})
+		if len(children) != 2 {
+			return nil, false
+		}
+
+		var combined []map[string]string
+
+		for i, c := range children {
+			child, ok := c.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+
+			params, ok := applicationSetGeneratorParams(child)
+			if !ok {
+				return nil, false
+			}
+
+			if i == 0 {
+				combined = params
+				continue
+			}
+
+			product := make([]map[string]string, 0, len(combined)*len(params))
+
+			for _, a := range combined {
+				for _, b := range params {
+					p := make(map[string]string, len(a)+len(b))
+					for k, val := range a {
+						p[k] = val
+					}
+
+					for k, val := range b {
+						p[k] = val
+					}
+
+					product = append(product, p)
+				}
+			}
+
+			combined = product
+		}
+
+		return combined, true
+	}
+
+	return nil, false
+}
+
+// renderApplicationSetTemplateParameters substitutes simple `{{key}}` (or
+// `{{.key}}` when using Go templates) parameters. The second return value is
+// false when the template uses any other construct.
+func renderApplicationSetTemplateParameters(s string, params map[string]string, goTemplate bool) (string, bool) {
+	ok := true
+
+	rendered := applicationSetTemplateParameter.ReplaceAllStringFunc(s, func(m string) string {
+		sm := applicationSetTemplateParameter.FindStringSubmatch(m)
+		key := sm[2]
+
+		if goTemplate != (sm[1] == ".") || (goTemplate && strings.Contains(key, ".")) {
+			ok = false
+			return m
+		}
+
+		v, found := params[key]
+		if !found {
+			ok = false
+			return m
+		}
+
+		return v
+	})
+
+	if !ok || strings.Contains(rendered, "{{") {
+		return "", false
+	}
+
+	return rendered, true
+}
@@ -1,8 +1,19 @@
 package argocd
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -122,3 +133,465 @@ func Test_validateMetadataLabels(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateApplicationSourceRefs(t *testing.T) {
+	t.Parallel()
+
+	helmSource := func(valueFiles ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"repo_url": "https://charts.bitnami.com/bitnami",
+			"chart":    "wordpress",
+			"helm": []interface{}{
+				map[string]interface{}{
+					"value_files": valueFiles,
+				},
+			},
+		}
+	}
+
+	refSource := func(ref string) map[string]interface{} {
+		return map[string]interface{}{
+			"repo_url": "https://github.com/argoproj/argocd-example-apps.git",
+			"ref":      ref,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		sources     []interface{}
+		expectError bool
+	}{
+		{
+			name:    "No refs",
+			sources: []interface{}{helmSource("values.yaml"), refSource("")},
+		},
+		{
+			name:    "Value file refers to defined ref",
+			sources: []interface{}{helmSource("$values/helm/values.yaml"), refSource("values")},
+		},
+		{
+			name:        "Value file refers to undefined ref",
+			sources:     []interface{}{helmSource("$other/helm/values.yaml"), refSource("values")},
+			expectError: true,
+		},
+		{
+			name:        "Duplicate refs",
+			sources:     []interface{}{helmSource(), refSource("values"), refSource("values")},
+			expectError: true,
+		},
+		{
+			name:    "Templated ref",
+			sources: []interface{}{helmSource("$values/helm/values.yaml"), refSource("{{ .ref }}")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateApplicationSourceRefs(tc.sources)
+			if (err != nil) != tc.expectError {
+				t.Errorf("validateApplicationSourceRefs() error = %v, expectError = %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func Test_validateElementsYaml(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "List of objects",
+			value: "- cluster: engineering-dev\n  url: https://kubernetes.default.svc\n  values:\n    replicas: 2\n",
+		},
+		{
+			name:  "Templated value",
+			value: "{{ .key.components | toJson }}",
+		},
+		{
+			name:        "Single object",
+			value:       "cluster: engineering-dev\n",
+			expectError: true,
+		},
+		{
+			name:        "List of strings",
+			value:       "- engineering-dev\n",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateElementsYaml(tc.value, "elements_yaml")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateElementsYaml() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
+
+func Test_validateConfigKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "Simple key",
+			value: "token",
+		},
+		{
+			name:  "Dotted key",
+			value: "ca.crt",
+		},
+		{
+			name:        "Key with slash",
+			value:       "github/token",
+			expectError: true,
+		},
+		{
+			name:        "Empty key",
+			value:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateConfigKey(tc.value, "key")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateConfigKey() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
+
+// testClusterCAPEM returns a PEM encoded self-signed CA certificate.
+func testClusterCAPEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kubernetes"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func Test_validateClusterCAData(t *testing.T) {
+	t.Parallel()
+
+	caPEM := testClusterCAPEM(t)
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "PEM",
+			value: caPEM,
+		},
+		{
+			name:  "Base64 encoded PEM",
+			value: base64.StdEncoding.EncodeToString([]byte(caPEM)),
+		},
+		{
+			// Files are only read when the cluster is created or updated
+			name:  "Missing file",
+			value: filepath.Join(t.TempDir(), "missing.pem"),
+		},
+		{
+			name:        "Malformed certificate",
+			value:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a certificate")})),
+			expectError: true,
+		},
+		{
+			name:        "Private key instead of certificate",
+			value:       string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")})),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateClusterCAData(tc.value, "ca_data")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateClusterCAData() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
+
+func Test_validateClusterShard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "First shard",
+			value: "0",
+		},
+		{
+			name:  "Positive shard",
+			value: "3",
+		},
+		{
+			name:        "Negative shard",
+			value:       "-1",
+			expectError: true,
+		},
+		{
+			name:        "Not a number",
+			value:       "one",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateClusterShard(tc.value, "shard")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateClusterShard() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
+
+func Test_validateRetryBackoffDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "Seconds without unit",
+			value: "30",
+		},
+		{
+			name:  "Duration with units",
+			value: "1h30m",
+		},
+		{
+			name:  "Template expression",
+			value: "{{.backoff}}",
+		},
+		{
+			name:        "Ambiguous fractional duration",
+			value:       "1.5h30m",
+			expectError: true,
+		},
+		{
+			name:        "Unsupported unit",
+			value:       "1d",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateRetryBackoffDuration(tc.value, "duration")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateRetryBackoffDuration() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
+
+func Test_validateApplicationSetGeneratedNames(t *testing.T) {
+	t.Parallel()
+
+	template := func(name string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"metadata": []interface{}{
+					map[string]interface{}{"name": name},
+				},
+			},
+		}
+	}
+
+	list := func(elements ...map[string]interface{}) map[string]interface{} {
+		e := make([]interface{}, len(elements))
+		for i := range elements {
+			e[i] = elements[i]
+		}
+
+		return map[string]interface{}{
+			"list": []interface{}{
+				map[string]interface{}{"elements": e},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		spec        map[string]interface{}
+		expectError string
+	}{
+		{
+			name: "Unique names",
+			spec: map[string]interface{}{
+				"generator": []interface{}{
+					list(map[string]interface{}{"cluster": "dev"}, map[string]interface{}{"cluster": "prod"}),
+				},
+				"template": template("{{cluster}}-guestbook"),
+			},
+		},
+		{
+			name: "Duplicate names",
+			spec: map[string]interface{}{
+				"generator": []interface{}{
+					list(map[string]interface{}{"cluster": "dev", "url": "a"}, map[string]interface{}{"cluster": "dev", "url": "b"}),
+				},
+				"template": template("{{ cluster }}-guestbook"),
+			},
+			expectError: `"dev-guestbook"`,
+		},
+		{
+			name: "Duplicate names across generators with Go templates",
+			spec: map[string]interface{}{
+				"go_template": true,
+				"generator": []interface{}{
+					list(map[string]interface{}{"cluster": "dev"}),
+					list(map[string]interface{}{"cluster": "dev"}),
+				},
+				"template": template("{{.cluster}}"),
+			},
+			expectError: `"dev"`,
+		},
+		{
+			name: "Duplicate names within matrix",
+			spec: map[string]interface{}{
+				"generator": []interface{}{
+					map[string]interface{}{
+						"matrix": []interface{}{
+							map[string]interface{}{
+								"generator": []interface{}{
+									list(map[string]interface{}{"cluster": "dev"}, map[string]interface{}{"cluster": "prod"}),
+									list(map[string]interface{}{"app": "a"}, map[string]interface{}{"app": "b"}),
+								},
+							},
+						},
+					},
+				},
+				"template": template("{{cluster}}"),
+			},
+			expectError: `"dev", "prod"`,
+		},
+		{
+			name: "Non-trivial template",
+			spec: map[string]interface{}{
+				"go_template": true,
+				"generator": []interface{}{
+					list(map[string]interface{}{"cluster": "dev"}, map[string]interface{}{"cluster": "dev"}),
+				},
+				"template": template("{{ .cluster | lower }}"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateApplicationSetGeneratedNames(tc.spec)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expectError)
+		})
+	}
+}
+
+func Test_validateApplicationSetTemplateName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:  "Plain name",
+			value: "guestbook",
+		},
+		{
+			name:  "Templated name",
+			value: "{{cluster}}-{{path.basename}}",
+		},
+		{
+			name:  "Go templated name",
+			value: "appset-{{ .cluster | lower }}",
+		},
+		{
+			name:        "Invalid plain name",
+			value:       "Guestbook",
+			expectError: true,
+		},
+		{
+			name:        "Invalid characters outside template expressions",
+			value:       "{{.cluster}}_guestbook",
+			expectError: true,
+		},
+		{
+			name:        "Leading dash",
+			value:       "-{{cluster}}",
+			expectError: true,
+		},
+		{
+			name:        "Unterminated template expression",
+			value:       "guestbook-{{cluster",
+			expectError: true,
+		},
+		{
+			name:        "Too long before parameter expansion",
+			value:       strings.Repeat("a", 254) + "-{{cluster}}",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, es := validateApplicationSetTemplateName(tc.value, "name")
+			if (len(es) > 0) != tc.expectError {
+				t.Errorf("validateApplicationSetTemplateName() errors = %v, expectError = %v", es, tc.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+data "argocd_account" "ci" {
+  name = "ci"
+}
+
+resource "argocd_rbac" "this" {
+  policy_csv = provider::argocd::rbac_policy(data.argocd_account.ci.name, "sync", "applications", "*/*", "allow")
+
+  lifecycle {
+    precondition {
+      condition     = data.argocd_account.ci.exists && data.argocd_account.ci.enabled
+      error_message = "The ci account must exist and be enabled in ArgoCD."
+    }
+  }
+}
@@ -0,0 +1,16 @@
+data "argocd_accounts" "all" {}
+
+locals {
+  granted_accounts = ["ci", "image-updater"]
+}
+
+check "granted_accounts_exist" {
+  assert {
+    condition     = length(setsubtract(local.granted_accounts, data.argocd_accounts.all.accounts[*].name)) == 0
+    error_message = "Some accounts granted permissions do not exist in ArgoCD."
+  }
+}
+
+output "token_count" {
+  value = { for a in data.argocd_accounts.all.accounts : a.name => length(a.tokens) }
+}
@@ -0,0 +1,24 @@
+data "argocd_certificates" "bitbucket" {
+  host_name_pattern = "bitbucket.internal"
+  cert_type         = "ssh"
+}
+
+resource "argocd_repository" "private" {
+  repo            = "git@bitbucket.internal:my-org/private-repo.git"
+  ssh_private_key = file("~/.ssh/id_ed25519")
+
+  lifecycle {
+    precondition {
+      condition     = length(data.argocd_certificates.bitbucket.certificates) > 0
+      error_message = "bitbucket.internal must have a known hosts entry before repositories hosted on it are added."
+    }
+  }
+}
+
+data "argocd_certificates" "all" {}
+
+output "ssh_fingerprints" {
+  value = {
+    for c in data.argocd_certificates.all.certificates : "${c.cert_subtype}/${c.server_name}" => c.cert_info if c.cert_type == "ssh"
+  }
+}
@@ -0,0 +1,7 @@
+data "argocd_cluster" "production" {
+  name = "production"
+}
+
+output "production_connected" {
+  value = data.argocd_cluster.production.info.connection_state.status == "Successful"
+}
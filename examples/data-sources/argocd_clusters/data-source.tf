@@ -0,0 +1,25 @@
+data "argocd_clusters" "production" {
+  selector = "environment=production"
+}
+
+resource "argocd_application" "guestbook" {
+  for_each = { for c in data.argocd_clusters.production.clusters : c.name => c }
+
+  metadata {
+    name      = "guestbook-${each.key}"
+    namespace = "argocd"
+  }
+
+  spec {
+    destination {
+      server    = each.value.server
+      namespace = "guestbook"
+    }
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+  }
+}
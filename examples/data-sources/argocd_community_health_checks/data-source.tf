@@ -0,0 +1,26 @@
+data "argocd_community_health_checks" "this" {
+  revision = "v3.3.6"
+
+  resources = [
+    {
+      group = "cert-manager.io"
+      kind  = "Certificate"
+    },
+    {
+      group = "external-secrets.io"
+      kind  = "ExternalSecret"
+    },
+    {
+      group = "*.crossplane.io"
+      kind  = "*"
+    },
+  ]
+}
+
+resource "argocd_resource_health_customization" "community" {
+  for_each = data.argocd_community_health_checks.this.health_checks
+
+  group = each.value.group
+  kind  = each.value.kind
+  lua   = each.value.lua
+}
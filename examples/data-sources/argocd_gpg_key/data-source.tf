@@ -0,0 +1,20 @@
+data "argocd_gpg_key" "release" {
+  key_id = "4AEE18F83AFDEB23"
+}
+
+resource "argocd_project" "signed" {
+  metadata {
+    name      = "signed"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos   = ["*"]
+    signature_keys = [data.argocd_gpg_key.release.key_id]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
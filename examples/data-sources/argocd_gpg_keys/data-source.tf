@@ -0,0 +1,29 @@
+data "argocd_gpg_keys" "all" {}
+
+resource "argocd_project" "signed" {
+  metadata {
+    name      = "signed"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos   = ["*"]
+    signature_keys = ["4AEE18F83AFDEB23"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for k in ["4AEE18F83AFDEB23"] : contains(data.argocd_gpg_keys.all.key_ids, k)])
+      error_message = "All signature keys must exist in the GnuPG keyring of ArgoCD."
+    }
+  }
+}
+
+output "gpg_key_owners" {
+  value = { for k in data.argocd_gpg_keys.all.keys : k.key_id => k.owner }
+}
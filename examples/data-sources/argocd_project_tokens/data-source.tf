@@ -0,0 +1,17 @@
+data "argocd_project_tokens" "ci" {
+  project = "myproject"
+  role    = "ci"
+}
+
+# Tokens which have not been issued by argocd_project_token, e.g. created with
+# `argocd proj role create-token`
+output "unmanaged_ci_tokens" {
+  value = [for t in data.argocd_project_tokens.ci.tokens : t.id if !t.managed]
+}
+
+check "ci_tokens_expire" {
+  assert {
+    condition     = alltrue([for t in data.argocd_project_tokens.ci.tokens : t.expires_at != null])
+    error_message = "All tokens of the ci role of myproject must expire."
+  }
+}
@@ -0,0 +1,42 @@
+locals {
+  policy_csv = join("\n", [
+    provider::argocd::rbac_policy("role:deployer", "sync", "applications", "team-alpha/*", "allow"),
+    "g, ci, role:deployer",
+  ])
+}
+
+# Evaluates the policy before it is written to argocd-rbac-cm
+data "argocd_rbac_can_i" "ci_sync" {
+  subject    = "ci"
+  action     = "sync"
+  resource   = "applications"
+  object     = "team-alpha/my-app"
+  policy_csv = local.policy_csv
+}
+
+data "argocd_rbac_can_i" "ci_delete_clusters" {
+  subject    = "ci"
+  action     = "delete"
+  resource   = "clusters"
+  policy_csv = local.policy_csv
+}
+
+resource "argocd_rbac" "this" {
+  policy_csv = local.policy_csv
+
+  lifecycle {
+    precondition {
+      condition     = data.argocd_rbac_can_i.ci_sync.allowed && !data.argocd_rbac_can_i.ci_delete_clusters.allowed
+      error_message = "The ci account must be able to sync the applications of team-alpha, but must not be able to delete clusters."
+    }
+  }
+}
+
+# Evaluates the policy currently stored in argocd-rbac-cm, requires `core = true`
+data "argocd_rbac_can_i" "readonly" {
+  subject  = "role:readonly"
+  action   = "get"
+  resource = "applications"
+
+  depends_on = [argocd_rbac.this]
+}
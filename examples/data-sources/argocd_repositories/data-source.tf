@@ -0,0 +1,15 @@
+data "argocd_repositories" "my_org" {
+  type       = "git"
+  url_prefix = "https://github.com/my-org/"
+}
+
+output "my_org_repositories" {
+  value = { for r in data.argocd_repositories.my_org.repositories : r.id => r.connection_state_status }
+}
+
+check "my_org_repositories_connected" {
+  assert {
+    condition     = alltrue([for r in data.argocd_repositories.my_org.repositories : r.connection_state_status == "Successful"])
+    error_message = "ArgoCD failed to connect to some repositories of my-org."
+  }
+}
@@ -0,0 +1,29 @@
+data "argocd_repository" "charts" {
+  repo = "https://charts.example.com"
+}
+
+resource "argocd_application" "app" {
+  metadata {
+    name = "app"
+  }
+
+  spec {
+    source {
+      repo_url        = data.argocd_repository.charts.repo
+      chart           = "app"
+      target_revision = "1.0.0"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.argocd_repository.charts.exists && data.argocd_repository.charts.connection_state_status == "Successful"
+      error_message = "The charts repository must be registered in ArgoCD and reachable."
+    }
+  }
+}
@@ -0,0 +1,19 @@
+ephemeral "argocd_account_token" "image_updater" {
+  account         = "image-updater"
+  expires_in      = "2160h"
+  revoke_on_close = false
+}
+
+# The token is handed over to argocd-image-updater without being written to
+# the state (requires Terraform 1.11 or later)
+resource "kubernetes_secret_v1" "image_updater" {
+  metadata {
+    name      = "argocd-image-updater-secret"
+    namespace = "argocd"
+  }
+
+  data_wo = {
+    "argocd.token" = ephemeral.argocd_account_token.image_updater.jwt
+  }
+  data_wo_revision = 1
+}
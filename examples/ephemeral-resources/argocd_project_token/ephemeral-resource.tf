@@ -0,0 +1,12 @@
+ephemeral "argocd_project_token" "ci" {
+  project     = "someproject"
+  role        = "ci"
+  description = "token for the current CI run"
+  expires_in  = "15m"
+}
+
+provider "argocd" {
+  alias       = "ci"
+  server_addr = "argocd.local:443"
+  auth_token  = ephemeral.argocd_project_token.ci.jwt
+}
@@ -0,0 +1,13 @@
+# Authenticates with the credentials configured on the provider block
+ephemeral "argocd_session_token" "admin" {}
+
+# The token can be used to configure providers calling ArgoCD API endpoints
+# which are not covered by this provider, without being written to the state
+provider "restapi" {
+  uri                  = "https://argocd.example.com/api/v1"
+  write_returns_object = true
+
+  headers = {
+    Authorization = "Bearer ${ephemeral.argocd_session_token.admin.token}"
+  }
+}
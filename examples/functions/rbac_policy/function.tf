@@ -0,0 +1,16 @@
+locals {
+  teams = {
+    "my-org:team-alpha" = "alpha"
+    "my-org:team-beta"  = "beta"
+  }
+}
+
+resource "argocd_rbac" "this" {
+  policy_csv = join("\n", flatten([
+    for group, project in local.teams : [
+      provider::argocd::rbac_policy("role:${project}", "*", "applications", "${project}/*", "allow"),
+      provider::argocd::rbac_policy("role:${project}", "delete", "applications", "${project}/production-*", "deny"),
+      "g, ${group}, role:${project}",
+    ]
+  ]))
+}
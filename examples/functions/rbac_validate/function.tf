@@ -0,0 +1,6 @@
+resource "argocd_rbac" "this" {
+  # Policies maintained by each team are validated together when planning
+  policy_csv = provider::argocd::rbac_validate(join("\n", [
+    for f in fileset("${path.module}/policies", "*.csv") : file("${path.module}/policies/${f}")
+  ]))
+}
@@ -0,0 +1,3 @@
+# Accounts can be imported using their name.
+
+terraform import argocd_account.image_updater image-updater
\ No newline at end of file
@@ -0,0 +1,12 @@
+# Service account for argocd-image-updater, which authenticates with an API token
+resource "argocd_account" "image_updater" {
+  name         = "image-updater"
+  capabilities = ["apiKey"]
+}
+
+# Service account for CI pipelines, temporarily disabled
+resource "argocd_account" "ci" {
+  name         = "ci"
+  capabilities = ["apiKey", "login"]
+  enabled      = false
+}
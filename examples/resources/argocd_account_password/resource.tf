@@ -0,0 +1,13 @@
+resource "argocd_account" "alice" {
+  name         = "alice"
+  capabilities = ["login"]
+}
+
+resource "argocd_account_password" "alice" {
+  account = argocd_account.alice.name
+
+  # Never stored in the plan or state, bump the version to rotate the password
+  # (requires Terraform 1.11 or later)
+  password_wo         = var.alice_password
+  password_wo_version = "1"
+}
@@ -0,0 +1,6 @@
+# Account tokens can be imported using an id consisting of `{account}:{id}`, or
+# `{id}` for tokens of the account configured on the provider. The JWT cannot be
+# recovered, hence `jwt` is empty until the token is regenerated, e.g. with
+# `terraform apply -replace=argocd_account_token.image_updater`.
+
+terraform import argocd_account_token.image_updater image-updater:6f6e8c71-4d0b-4b2b-9d8c-0e7b2e1d3c55
\ No newline at end of file
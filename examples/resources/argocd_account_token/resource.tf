@@ -8,4 +8,18 @@ resource "argocd_account_token" "foo" {
   account      = "foo"
   expires_in   = "168h" # expire in 7 days
   renew_before = "84h"  # renew when less than 3.5 days remain until expiry
+  renew_jitter = "12h"  # bring renewal forward by up to 12 hours per token
+}
+
+# Token for account `image-updater`, which is only handed over through a
+# Kubernetes secret and never stored in the state
+resource "argocd_account_token" "image_updater" {
+  account   = "image-updater"
+  store_jwt = false
+
+  kubernetes_secret {
+    name      = "argocd-image-updater-secret"
+    namespace = "argocd"
+    key       = "argocd.token"
+  }
 }
@@ -0,0 +1,7 @@
+# ArgoCD application sets can be imported using an id consisting of `{name}:{namespace}`.
+
+terraform import argocd_application_set.myappset myappset:argocd
+
+# Application sets in the ArgoCD control plane namespace can also be imported by name.
+
+terraform import argocd_application_set.myappset myappset
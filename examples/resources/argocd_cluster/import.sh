@@ -1,3 +1,7 @@
-# Cluster credentials can be imported using the server URL.
-
-terraform import argocd_cluster.mycluster https://mycluster.io:443
\ No newline at end of file
+# Cluster credentials can be imported using the server URL, the name of the
+# cluster, or the <server>/<name> composite when several clusters share the same
+# server URL. The ID stored in the state is always the <server>/<name>
+# composite, or the server URL when the name of the cluster is the server URL.
+terraform import argocd_cluster.mycluster https://mycluster.io:443
+terraform import argocd_cluster.mycluster mycluster
+terraform import argocd_cluster.mycluster https://mycluster.io:443/mycluster
@@ -95,9 +95,74 @@ resource "argocd_cluster" "eks" {
     aws_auth_config {
       cluster_name = "myekscluster"
       role_arn     = "arn:aws:iam::<123456789012>:role/<role-name>"
+      profile      = "<profile-name>"
     }
     tls_client_config {
       ca_data = base64decode(data.aws_eks_cluster.cluster.certificate_authority[0].data)
     }
   }
 }
+
+## GKE cluster using exec-based authentication, requires gke-gcloud-auth-plugin
+## to be available on the ArgoCD application controller and server
+resource "argocd_cluster" "gke_exec" {
+  server = format("https://%s", data.google_container_cluster.cluster.endpoint)
+  name   = "gke-exec"
+
+  config {
+    exec_provider_config {
+      api_version  = "client.authentication.k8s.io/v1beta1"
+      command      = "gke-gcloud-auth-plugin"
+      install_hint = "Install gke-gcloud-auth-plugin for use with ArgoCD"
+    }
+    tls_client_config {
+      ca_data = base64decode(data.google_container_cluster.cluster.master_auth.0.cluster_ca_certificate)
+    }
+  }
+}
+
+## Bearer token which is never stored in the state, requires Terraform 1.11 or later
+resource "argocd_cluster" "write_only" {
+  server = "https://1.2.3.4:12345"
+
+  config {
+    bearer_token_wo     = var.cluster_token
+    credentials_version = "1" # bump to rotate the token
+
+    tls_client_config {
+      ca_data = file("path/to/ca.pem")
+    }
+  }
+}
+
+## Bring the in-cluster entry implicitly registered by ArgoCD under management
+resource "argocd_cluster" "in_cluster" {
+  server         = "https://kubernetes.default.svc"
+  name           = "in-cluster"
+  adopt_existing = true
+
+  # Relies on the service account of ArgoCD
+  config {}
+
+  metadata {
+    labels = {
+      environment = "management"
+    }
+  }
+}
+
+## Register a cluster through its declarative secret, e.g. while bootstrapping
+## ArgoCD, requires the provider to be configured with `core = true`
+resource "argocd_cluster" "bootstrap" {
+  server        = "https://1.2.3.4:12345"
+  name          = "bootstrap"
+  direct_secret = true
+
+  config {
+    bearer_token = "eyJhbGciOiJSUzI..."
+
+    tls_client_config {
+      ca_data = file("path/to/ca.pem")
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# Compare options can be imported using the name of the ArgoCD ConfigMap.
+
+terraform import argocd_compare_options.this argocd-cm
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "argocd_compare_options" "this" {
+  ignore_aggregated_roles      = true
+  ignore_resource_status_field = "crd"
+}
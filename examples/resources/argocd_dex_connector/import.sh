@@ -0,0 +1,3 @@
+# Dex connectors can be imported using their connector ID.
+
+terraform import argocd_dex_connector.github github
\ No newline at end of file
@@ -0,0 +1,49 @@
+resource "argocd_dex_connector" "github" {
+  connector_id = "github"
+  name         = "GitHub"
+
+  github = {
+    client_id     = "aabbccddeeff00112233"
+    client_secret = "$dex.github.clientSecret"
+
+    orgs = [
+      {
+        name  = "my-org"
+        teams = ["platform", "developers"]
+      },
+    ]
+  }
+}
+
+resource "argocd_dex_connector" "ldap" {
+  connector_id = "ldap"
+  name         = "Active Directory"
+
+  ldap = {
+    host    = "ldap.example.com:636"
+    bind_dn = "cn=argocd,cn=users,dc=example,dc=com"
+    bind_pw = "$dex.ldap.bindPW"
+
+    user_search = {
+      base_dn    = "cn=users,dc=example,dc=com"
+      filter     = "(objectClass=person)"
+      username   = "sAMAccountName"
+      id_attr    = "sAMAccountName"
+      email_attr = "mail"
+      name_attr  = "displayName"
+    }
+
+    group_search = {
+      base_dn   = "cn=groups,dc=example,dc=com"
+      filter    = "(objectClass=group)"
+      name_attr = "cn"
+
+      user_matchers = [
+        {
+          user_attr  = "DN"
+          group_attr = "member"
+        },
+      ]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# Notification triggers can be imported using their name.
+
+terraform import argocd_notifications_trigger.on_deployed on-deployed
\ No newline at end of file
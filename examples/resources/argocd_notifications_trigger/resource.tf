@@ -0,0 +1,12 @@
+resource "argocd_notifications_trigger" "on_deployed" {
+  name = "on-deployed"
+
+  conditions = [
+    {
+      description = "Application is synced and healthy. Triggered once per commit."
+      when        = "app.status.operationState != nil and app.status.operationState.phase in ['Succeeded'] and app.status.health.status == 'Healthy'"
+      send        = ["app-deployed"]
+      once_per    = "app.status.operationState?.syncResult?.revision"
+    },
+  ]
+}
@@ -0,0 +1,3 @@
+# The OIDC configuration can be imported using the name of the ConfigMap.
+
+terraform import argocd_oidc_config.okta argocd-cm
\ No newline at end of file
@@ -0,0 +1,17 @@
+resource "argocd_oidc_config" "okta" {
+  name      = "Okta"
+  issuer    = "https://example.okta.com"
+  client_id = "argocd"
+
+  client_secret = {
+    key = "oidc.okta.clientSecret"
+  }
+
+  requested_scopes = ["openid", "profile", "email", "groups"]
+
+  requested_id_token_claims = {
+    groups = {
+      essential = true
+    }
+  }
+}
@@ -1,3 +1,5 @@
-# Projects can be imported using the project name.
+# Projects can be imported using the project name, optionally followed by the
+# namespace of the ArgoCD control plane serving the project.
 
 terraform import argocd_project.myproject myproject
+terraform import argocd_project.myproject myproject:argocd
@@ -5,3 +5,34 @@ resource "argocd_project_token" "secret" {
   expires_in   = "1h"
   renew_before = "30m"
 }
+
+# Token which is rotated out-of-band, with a warning being emitted once less
+# than 7 days remain until expiry
+resource "argocd_project_token" "long_lived" {
+  project     = "someproject"
+  role        = "foobar"
+  expires_in  = "2160h"
+  warn_before = "168h"
+}
+
+# Token with an explicit ID, so that tokens for the same role managed by
+# other workspaces are told apart
+resource "argocd_project_token" "workspace" {
+  id      = "ci-${terraform.workspace}"
+  project = "someproject"
+  role    = "foobar"
+}
+# Token which is only handed over through a Kubernetes secret, e.g. to CI
+# runners, and never stored in the state
+resource "argocd_project_token" "ci" {
+  project      = "someproject"
+  role         = "foobar"
+  expires_in   = "168h"
+  renew_before = "24h"
+  store_jwt    = false
+
+  kubernetes_secret = {
+    name      = "argocd-token"
+    namespace = "ci"
+  }
+}
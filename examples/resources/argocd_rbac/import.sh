@@ -0,0 +1,3 @@
+# The RBAC configuration can be imported using the name of the ConfigMap.
+
+terraform import argocd_rbac.this argocd-rbac-cm
\ No newline at end of file
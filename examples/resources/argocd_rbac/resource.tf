@@ -0,0 +1,12 @@
+resource "argocd_rbac" "this" {
+  policy_default = "role:readonly"
+  scopes         = ["groups", "email"]
+
+  policy_csv = <<-EOT
+    p, role:org-admin, applications, *, */*, allow
+    p, role:org-admin, clusters, get, *, allow
+    p, role:org-admin, repositories, *, *, allow
+    g, my-org:team-alpha, role:org-admin
+    g, jane@example.com, role:admin
+  EOT
+}
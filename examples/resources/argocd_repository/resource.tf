@@ -35,3 +35,71 @@ resource "argocd_repository" "oci_registry" {
   username = "my-username"
   password = "my-token"
 }
+
+# OCI Helm repository, e.g. hosted in ECR, GAR or ACR
+resource "argocd_repository" "helm_oci" {
+  repo       = "oci://123456789012.dkr.ecr.eu-west-1.amazonaws.com/charts"
+  name       = "ecr-charts"
+  type       = "helm"
+  enable_oci = true
+  username   = "AWS"
+  password   = data.aws_ecr_authorization_token.token.password
+}
+
+# Private repository whose key is never stored in the state (requires Terraform 1.11 or later)
+resource "argocd_repository" "private_wo" {
+  repo                       = "git@private-git-repository.argocd.svc.cluster.local:project.git"
+  ssh_private_key_wo         = file("path/to/id_ed25519")
+  ssh_private_key_wo_version = "1" # bump to rotate the key
+}
+
+# Credentials rotated together with their version in Vault (requires Terraform 1.11 or later)
+resource "argocd_repository" "private_vault" {
+  repo                = "https://git.example.com/my-org/project.git"
+  username            = "argocd"
+  password_wo         = ephemeral.vault_kv_secret_v2.git.data["token"]
+  credentials_version = data.vault_kv_secret_v2.git_metadata.version
+}
+
+# Azure DevOps repository using a PAT, whose username is ignored by Azure DevOps
+resource "argocd_repository" "azure_devops" {
+  repo     = "https://dev.azure.com/my-org/my-project/_git/my-repo"
+  password = var.azure_devops_pat
+}
+
+# Azure DevOps repository using the workload identity of the repo server
+resource "argocd_repository" "azure_devops_workload_identity" {
+  repo                        = "https://dev.azure.com/my-org/my-project/_git/my-repo"
+  use_azure_workload_identity = true
+}
+
+# Bitbucket Data Center repository using an HTTP access token, the project key
+# being part of the URL
+resource "argocd_repository" "bitbucket_data_center" {
+  repo         = "https://bitbucket.example.com/scm/proj/my-repo.git"
+  bearer_token = var.bitbucket_http_access_token
+}
+
+# Repository registered through its declarative secret, e.g. while bootstrapping
+# ArgoCD, requires the provider to be configured with `core = true`
+resource "argocd_repository" "bootstrap" {
+  repo          = "https://git.example.com/my-org/platform.git"
+  username      = "argocd"
+  password      = var.git_token
+  direct_secret = true
+}
+
+# Repository whose secret is labelled for other controllers, requires the
+# provider to be configured with `core = true`
+resource "argocd_repository" "labelled" {
+  repo = "https://github.com/argoproj/argocd-example-apps.git"
+
+  metadata {
+    labels = {
+      "example.com/team" = "platform"
+    }
+    annotations = {
+      "example.com/owner" = "terraform"
+    }
+  }
+}
@@ -1,8 +1,12 @@
-# Repository credentials can be imported using the repository URL.
+# Repository credentials can be imported using their URL prefix.
 
-# Note: as the ArgoCD API does not return any sensitive information, a
-# subsequent `terraform apply` should be executed to make the `password`,
-# `ssh_private_key` and `tls_client_cert_key` attributes converge to their
-# expected values defined within the plan.
+# Note: as the ArgoCD API only returns the URL and username of repository
+# credentials, the other attributes are only read back when the provider is
+# configured with `core = true`, in which case they are read from the secret of
+# the credentials. Sensitive attributes are never read back, hence a subsequent
+# `terraform apply` should be executed to make the `password`,
+# `ssh_private_key`, `tls_client_cert_key`, `githubapp_private_key` and
+# `gcp_service_account_key` attributes converge to their expected values
+# defined within the plan.
 
-terraform import argocd_repository_credentials.myrepocreds git@private-git-repository.local:somerepo.git
\ No newline at end of file
+terraform import argocd_repository_credentials.myrepocreds https://github.com/my-org/
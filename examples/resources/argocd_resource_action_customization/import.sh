@@ -0,0 +1,3 @@
+# Resource action customizations can be imported using `<group>/<kind>`, or `<kind>` for resources of the core group.
+
+terraform import argocd_resource_action_customization.rollout argoproj.io/Rollout
\ No newline at end of file
@@ -0,0 +1,33 @@
+resource "argocd_resource_action_customization" "rollout" {
+  group = "argoproj.io"
+  kind  = "Rollout"
+
+  discovery_lua = <<-EOT
+    actions = {}
+    actions["restart"] = {}
+    actions["promote-full"] = {["disabled"] = obj.status == nil or not obj.status.pauseConditions}
+    return actions
+  EOT
+
+  actions = [
+    {
+      name = "restart"
+      lua  = <<-EOT
+        local os = require("os")
+        obj.spec.restartAt = os.date("!%Y-%m-%dT%XZ")
+        return obj
+      EOT
+    },
+    {
+      name = "promote-full"
+      lua  = <<-EOT
+        if obj.status ~= nil then
+          obj.status.promoteFull = true
+        end
+        return obj
+      EOT
+    },
+  ]
+
+  merge_builtin_actions = true
+}
@@ -0,0 +1,3 @@
+# Resource health customizations can be imported using `<group>/<kind>`, or `<kind>` for resources of the core group.
+
+terraform import argocd_resource_health_customization.certificate cert-manager.io/Certificate
\ No newline at end of file
@@ -0,0 +1,3 @@
+# Resource ignore updates customizations can be imported using `<group>/<kind>`, or `<kind>` for resources of the core group.
+
+terraform import argocd_resource_ignore_updates_customization.hpa autoscaling/HorizontalPodAutoscaler
\ No newline at end of file
@@ -0,0 +1,17 @@
+# Ignore updates of the status of all resources
+resource "argocd_resource_ignore_updates_customization" "all" {
+  kind          = "all"
+  json_pointers = ["/status"]
+}
+
+resource "argocd_resource_ignore_updates_customization" "hpa" {
+  group = "autoscaling"
+  kind  = "HorizontalPodAutoscaler"
+
+  jq_path_expressions = [
+    ".metadata.annotations.\"autoscaling.alpha.kubernetes.io/behavior\"",
+    ".metadata.annotations.\"autoscaling.alpha.kubernetes.io/conditions\"",
+    ".metadata.annotations.\"autoscaling.alpha.kubernetes.io/metrics\"",
+    ".metadata.annotations.\"autoscaling.alpha.kubernetes.io/current-metrics\"",
+  ]
+}
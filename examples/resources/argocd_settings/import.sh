@@ -0,0 +1,3 @@
+# The settings can be imported using the name of the ConfigMap, in which case all settings present within it are taken over.
+
+terraform import argocd_settings.this argocd-cm
\ No newline at end of file
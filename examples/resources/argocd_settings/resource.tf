@@ -0,0 +1,8 @@
+resource "argocd_settings" "this" {
+  url                     = "https://argocd.example.com"
+  anonymous_users_enabled = false
+  status_badge_enabled    = true
+  reconciliation_timeout  = "5m"
+  exec_enabled            = true
+  admin_enabled           = false
+}
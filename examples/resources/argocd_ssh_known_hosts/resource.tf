@@ -0,0 +1,14 @@
+resource "argocd_ssh_known_hosts" "git_servers" {
+  entries = [
+    {
+      server_name  = "github.com"
+      cert_subtype = "ecdsa-sha2-nistp256"
+      cert_data    = "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBEmKSENjQEezOmxkZMy7opKgwFB9nkt5YRrYMjNuG5N87uRgg6CLrbo5wAdT/y6v0mKV0U2w0WZ2YB/++Tpockg="
+    },
+    {
+      server_name  = "gitlab.com"
+      cert_subtype = "ecdsa-sha2-nistp256"
+      cert_data    = "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY="
+    },
+  ]
+}
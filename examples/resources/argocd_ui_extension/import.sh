@@ -0,0 +1,3 @@
+# UI extensions can be imported using their name.
+
+terraform import argocd_ui_extension.metrics metrics
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "argocd_ui_extension" "metrics" {
+  name = "metrics"
+
+  services = [
+    {
+      url = "http://argocd-metrics-server.argocd:9003"
+
+      headers = [
+        {
+          name  = "Authorization"
+          value = "Bearer $extension.metrics.token"
+        },
+      ]
+    },
+  ]
+
+  connection_timeout = "5s"
+}
@@ -21,6 +21,9 @@ const (
 	ProjectFineGrainedPolicy
 	ApplicationSourceName
 	RepositoryDepth
+	ProjectPermitOnlyScopedClusters
+	ApplicationSetGenerate
+	ProjectSyncWindowDescription
 )
 
 type FeatureConstraint struct {
@@ -45,4 +48,7 @@ var ConstraintsMap = map[Feature]FeatureConstraint{
 	ApplicationSourceName:                      {"named application sources", semver.MustParse("2.14.0")},
 	ProjectDestinationServiceAccounts:          {"project destination service accounts", semver.MustParse("2.13.0")},
 	RepositoryDepth:                            {"repository shallow clone depth", semver.MustParse("3.3.0")},
+	ProjectPermitOnlyScopedClusters:            {"permitting only project scoped clusters", semver.MustParse("2.10.0")},
+	ApplicationSetGenerate:                     {"application set dry-run generation", semver.MustParse("2.11.0")},
+	ProjectSyncWindowDescription:               {"sync window description", semver.MustParse("2.14.0")},
 }
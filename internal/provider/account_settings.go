@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The functions below manage local accounts through the `argocd-cm` ConfigMap
+// (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#create-new-user),
+// as the ArgoCD API only allows reading them. The keys of an account are
+// written through merge patches, so that concurrent changes to any other
+// settings are retained.
+
+type localAccount struct {
+	Name         string
+	Enabled      bool
+	Capabilities []string
+}
+
+func accountCapabilitiesKey(name string) string {
+	return "accounts." + name
+}
+
+func accountEnabledKey(name string) string {
+	return "accounts." + name + ".enabled"
+}
+
+func readLocalAccount(ctx context.Context, kc kubernetes.Interface, namespace, name string) (*localAccount, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, hasCapabilities := cm.Data[accountCapabilitiesKey(name)]
+	enabled, hasEnabled := cm.Data[accountEnabledKey(name)]
+
+	if !hasCapabilities && !hasEnabled {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), accountCapabilitiesKey(name))
+	}
+
+	a := &localAccount{
+		Name:    name,
+		Enabled: true,
+	}
+
+	if hasEnabled {
+		if a.Enabled, err = strconv.ParseBool(enabled); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range strings.Split(capabilities, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			a.Capabilities = append(a.Capabilities, c)
+		}
+	}
+
+	return a, nil
+}
+
+func createLocalAccount(ctx context.Context, kc kubernetes.Interface, namespace string, a *localAccount) error {
+	_, err := readLocalAccount(ctx, kc, namespace, a.Name)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), accountCapabilitiesKey(a.Name))
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateLocalAccount(ctx, kc, namespace, a)
+}
+
+func updateLocalAccount(ctx context.Context, kc kubernetes.Interface, namespace string, a *localAccount) error {
+	// ArgoCD omits the enabled key of enabled accounts
+	var enabled any
+	if !a.Enabled {
+		enabled = "false"
+	}
+
+	patch, err := dataMergePatch(map[string]any{
+		accountCapabilitiesKey(a.Name): strings.Join(a.Capabilities, ","),
+		accountEnabledKey(a.Name):      enabled,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// deleteLocalAccount removes the account along with its password and tokens,
+// so that they are not inherited by an account created later on with the same
+// name.
+func deleteLocalAccount(ctx context.Context, kc kubernetes.Interface, namespace, name string) error {
+	patch, err := dataMergePatch(map[string]any{
+		accountCapabilitiesKey(name): nil,
+		accountEnabledKey(name):      nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	patch, err = dataMergePatch(map[string]any{
+		"accounts." + name + ".password":      nil,
+		"accounts." + name + ".passwordMtime": nil,
+		"accounts." + name + ".tokens":        nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().Secrets(namespace).Patch(ctx, common.ArgoCDSecretName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// dataMergePatch returns a merge patch updating the given keys within the data
+// of a ConfigMap or Secret. Keys with a nil value are removed.
+func dataMergePatch(data map[string]any) ([]byte, error) {
+	return json.Marshal(map[string]any{"data": data})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLocalAccountLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url":         "https://argocd.example.com",
+				"accounts.ci": "apiKey",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+			Data: map[string][]byte{
+				"server.secretkey":                []byte("secret"),
+				"accounts.image-updater.password": []byte("hash"),
+			},
+		},
+	)
+
+	a := &localAccount{
+		Name:         "image-updater",
+		Enabled:      false,
+		Capabilities: []string{"apiKey", "login"},
+	}
+
+	if err := createLocalAccount(ctx, kc, "argocd", a); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createLocalAccount(ctx, kc, "argocd", &localAccount{Name: "ci", Enabled: true, Capabilities: []string{"login"}}); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readLocalAccount(ctx, kc, "argocd", "image-updater")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, a, read)
+
+	a.Enabled = true
+	a.Capabilities = []string{"apiKey"}
+
+	if err = updateLocalAccount(ctx, kc, "argocd", a); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// ArgoCD omits the enabled key of enabled accounts, other settings are retained
+	assert.Equal(t, map[string]string{
+		"url":                    "https://argocd.example.com",
+		"accounts.ci":            "apiKey",
+		"accounts.image-updater": "apiKey",
+	}, cm.Data)
+
+	if err = deleteLocalAccount(ctx, kc, "argocd", "image-updater"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err = readLocalAccount(ctx, kc, "argocd", "image-updater"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	secret, err := kc.CoreV1().Secrets("argocd").Get(ctx, common.ArgoCDSecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string][]byte{"server.secretkey": []byte("secret")}, secret.Data)
+
+	if _, err = readLocalAccount(ctx, kc, "argocd", "ci"); err != nil {
+		t.Errorf("expected account ci to be retained, got %v", err)
+	}
+}
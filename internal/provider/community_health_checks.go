@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// The functions below fetch health checks from the community library of
+// ArgoCD, i.e. the `resource_customizations` directory of the
+// argoproj/argo-cd repository (see
+// https://github.com/argoproj/argo-cd/tree/master/resource_customizations).
+// Health checks are stored under `<group>/<kind>/health.lua`, or
+// `<kind>/health.lua` for resources of the core group, where wildcards of the
+// group are encoded as `_`.
+
+const communityHealthChecksBaseURL = "https://raw.githubusercontent.com/argoproj/argo-cd"
+
+// errCommunityHealthCheckNotFound is returned if the library does not contain
+// a health check for the given group and kind at the given revision.
+var errCommunityHealthCheckNotFound = errors.New("health check not found")
+
+// communityHealthCheckPath returns the path of the health check of resources
+// of the given group and kind within the library.
+func communityHealthCheckPath(group, kind string) string {
+	return strings.ReplaceAll(resourceCustomizationID(group, kind), "*", "_") + "/health.lua"
+}
+
+func fetchCommunityHealthCheck(ctx context.Context, client *http.Client, baseURL, revision, group, kind string) (string, error) {
+	u, err := url.JoinPath(baseURL, url.PathEscape(revision), "resource_customizations", communityHealthCheckPath(group, kind))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%w at %s", errCommunityHealthCheckNotFound, u)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("unexpected status %s fetching %s", resp.Status, u)
+	}
+
+	lua, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(lua), nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityHealthCheckPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "cert-manager.io/Certificate/health.lua", communityHealthCheckPath("cert-manager.io", "Certificate"))
+	assert.Equal(t, "_.crossplane.io/_/health.lua", communityHealthCheckPath("*.crossplane.io", "*"))
+	assert.Equal(t, "PersistentVolumeClaim/health.lua", communityHealthCheckPath("", "PersistentVolumeClaim"))
+}
+
+func TestFetchCommunityHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3.3.6/resource_customizations/cert-manager.io/Certificate/health.lua":
+			_, _ = w.Write([]byte("hs = {}\nreturn hs\n"))
+		case "/broken/resource_customizations/cert-manager.io/Certificate/health.lua":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	lua, err := fetchCommunityHealthCheck(ctx, server.Client(), server.URL, "v3.3.6", "cert-manager.io", "Certificate")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "hs = {}\nreturn hs\n", lua)
+
+	if _, err = fetchCommunityHealthCheck(ctx, server.Client(), server.URL, "v3.3.6", "example.com", "Widget"); !errors.Is(err, errCommunityHealthCheckNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if _, err = fetchCommunityHealthCheck(ctx, server.Client(), server.URL, "broken", "cert-manager.io", "Certificate"); err == nil || errors.Is(err, errCommunityHealthCheckNotFound) {
+		t.Errorf("expected an unexpected status error, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage the options ArgoCD diffs resources with, i.e.
+// the `resource.compareoptions` and `resource.ignoreResourceUpdatesEnabled`
+// keys of the `argocd-cm` ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/user-guide/diffing/#system-level-configuration).
+// Both keys are written through merge patches, so that any other settings are
+// retained.
+
+const (
+	compareOptionsKey               = "resource.compareoptions"
+	ignoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+)
+
+// compareOptions mirrors settings.ArgoCDDiffOptions. Its fields are always
+// written, since ArgoCD falls back to its defaults for omitted ones, some of
+// which are `true`.
+type compareOptions struct {
+	IgnoreAggregatedRoles              bool   `json:"ignoreAggregatedRoles"`
+	IgnoreResourceStatusField          string `json:"ignoreResourceStatusField"`
+	IgnoreDifferencesOnResourceUpdates bool   `json:"ignoreDifferencesOnResourceUpdates"`
+
+	// IgnoreResourceUpdatesEnabled is stored under its own key.
+	IgnoreResourceUpdatesEnabled bool `json:"-"`
+}
+
+func readCompareOptions(ctx context.Context, kc kubernetes.Interface, namespace string) (*compareOptions, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := cm.Data[compareOptionsKey]
+	if !ok {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), compareOptionsKey)
+	}
+
+	defaults := settings.GetDefaultDiffOptions()
+	o := &compareOptions{
+		IgnoreAggregatedRoles:              defaults.IgnoreAggregatedRoles,
+		IgnoreResourceStatusField:          string(defaults.IgnoreResourceStatusField),
+		IgnoreDifferencesOnResourceUpdates: defaults.IgnoreDifferencesOnResourceUpdates,
+		IgnoreResourceUpdatesEnabled:       true,
+	}
+
+	if err = yaml.Unmarshal([]byte(value), o); err != nil {
+		return nil, err
+	}
+
+	if enabled := cm.Data[ignoreResourceUpdatesEnabledKey]; enabled != "" {
+		if o.IgnoreResourceUpdatesEnabled, err = strconv.ParseBool(enabled); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+func createCompareOptions(ctx context.Context, kc kubernetes.Interface, namespace string, o *compareOptions) error {
+	_, err := readCompareOptions(ctx, kc, namespace)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), compareOptionsKey)
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateCompareOptions(ctx, kc, namespace, o)
+}
+
+func updateCompareOptions(ctx context.Context, kc kubernetes.Interface, namespace string, o *compareOptions) error {
+	value, err := yaml.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	patch, err := dataMergePatch(map[string]any{
+		compareOptionsKey:               string(value),
+		ignoreResourceUpdatesEnabledKey: strconv.FormatBool(o.IgnoreResourceUpdatesEnabled),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func deleteCompareOptions(ctx context.Context, kc kubernetes.Interface, namespace string) error {
+	patch, err := dataMergePatch(map[string]any{
+		compareOptionsKey:               nil,
+		ignoreResourceUpdatesEnabledKey: nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
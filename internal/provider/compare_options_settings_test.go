@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCompareOptionsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+			},
+		},
+	)
+
+	if _, err := readCompareOptions(ctx, kc, "argocd"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	o := &compareOptions{
+		IgnoreAggregatedRoles:              true,
+		IgnoreResourceStatusField:          "crd",
+		IgnoreDifferencesOnResourceUpdates: false,
+		IgnoreResourceUpdatesEnabled:       true,
+	}
+
+	if err := createCompareOptions(ctx, kc, "argocd", o); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createCompareOptions(ctx, kc, "argocd", o); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readCompareOptions(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, o, read)
+
+	o.IgnoreResourceUpdatesEnabled = false
+
+	if err = updateCompareOptions(ctx, kc, "argocd", o); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Options disabled explicitly are written, since ArgoCD would otherwise fall back to its defaults
+	assert.Contains(t, cm.Data["resource.compareoptions"], "ignoreDifferencesOnResourceUpdates: false")
+	assert.Equal(t, "false", cm.Data["resource.ignoreResourceUpdatesEnabled"])
+
+	if err = deleteCompareOptions(ctx, kc, "argocd"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{"url": "https://argocd.example.com"}, cm.Data)
+}
+
+func TestReadCompareOptionsDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"resource.compareoptions": "ignoreAggregatedRoles: true\n",
+			},
+		},
+	)
+
+	read, err := readCompareOptions(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Omitted options are read as the defaults of ArgoCD
+	assert.Equal(t, &compareOptions{
+		IgnoreAggregatedRoles:              true,
+		IgnoreResourceStatusField:          "all",
+		IgnoreDifferencesOnResourceUpdates: true,
+		IgnoreResourceUpdatesEnabled:       true,
+	}, read)
+}
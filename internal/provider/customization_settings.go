@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage resource customizations within the `argocd-cm`
+// ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/resource_actions/#resource-customizations).
+// Customizations are stored under keys of the form
+// `resource.customizations.<type>.<group>_<kind>`, or
+// `resource.customizations.<type>.<kind>` for resources of the core group.
+// Only the keys of a single group and kind are written, through merge
+// patches, so that any other settings are retained.
+//
+// Kubernetes does not permit `*` within the keys of a ConfigMap, hence the
+// customizations of wildcard groups or kinds are stored within the legacy
+// `resource.customizations` key instead, which holds the customizations of
+// any number of resources as YAML, keyed by `<group>/<kind>`. Only the fields
+// of the customization types managed by the provider are written, any other
+// fields and resources are retained.
+
+// resourceCustomizationsKey is the legacy key holding resource customizations
+// as YAML.
+const resourceCustomizationsKey = "resource.customizations"
+
+// legacyResourceCustomizationFields maps the customization types to the
+// fields holding them within the legacy key.
+var legacyResourceCustomizationFields = map[string]string{
+	"health":                "health.lua",
+	"useOpenLibs":           "health.lua.useOpenLibs",
+	"actions":               "actions",
+	"ignoreResourceUpdates": "ignoreResourceUpdates",
+}
+
+// resourceCustomizationKey returns the key of the given customization type,
+// e.g. `health`, for resources of the given group and kind.
+func resourceCustomizationKey(customizationType, group, kind string) string {
+	groupKind := kind
+	if group != "" {
+		groupKind = group + "_" + kind
+	}
+
+	return "resource.customizations." + customizationType + "." + groupKind
+}
+
+// isLegacyResourceCustomization returns whether the customizations of the
+// given group and kind are stored within the legacy key.
+func isLegacyResourceCustomization(group, kind string) bool {
+	return strings.Contains(group, "*") || strings.Contains(kind, "*")
+}
+
+// readLegacyResourceCustomizations returns the customizations stored within
+// the legacy key, keyed by `<group>/<kind>`.
+func readLegacyResourceCustomizations(cm *corev1.ConfigMap) (map[string]map[string]any, error) {
+	customizations := make(map[string]map[string]any)
+
+	if err := yaml.Unmarshal([]byte(cm.Data[resourceCustomizationsKey]), &customizations); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", resourceCustomizationsKey, err)
+	}
+
+	return customizations, nil
+}
+
+// readResourceCustomization returns the value of the given customization type
+// for resources of the given group and kind, or a not found error if it is
+// not set.
+func readResourceCustomization(cm *corev1.ConfigMap, customizationType, group, kind string) (string, error) {
+	if !isLegacyResourceCustomization(group, kind) {
+		value, ok := cm.Data[resourceCustomizationKey(customizationType, group, kind)]
+		if !ok {
+			return "", apierrors.NewNotFound(corev1.Resource("configmaps"), resourceCustomizationKey(customizationType, group, kind))
+		}
+
+		return value, nil
+	}
+
+	customizations, err := readLegacyResourceCustomizations(cm)
+	if err != nil {
+		return "", err
+	}
+
+	id := resourceCustomizationID(group, kind)
+	field := legacyResourceCustomizationFields[customizationType]
+
+	switch value := customizations[id][field].(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case nil:
+		return "", apierrors.NewNotFound(corev1.Resource("configmaps"), resourceCustomizationsKey+"/"+id+"/"+field)
+	default:
+		return "", fmt.Errorf("unexpected value of %s for %s in %s", field, id, resourceCustomizationsKey)
+	}
+}
+
+// writeResourceCustomization sets the values of the given customization types
+// for resources of the given group and kind. Types with a nil value are
+// removed.
+func writeResourceCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string, values map[string]any) error {
+	if !isLegacyResourceCustomization(group, kind) {
+		data := make(map[string]any, len(values))
+		for customizationType, value := range values {
+			data[resourceCustomizationKey(customizationType, group, kind)] = value
+		}
+
+		patch, err := dataMergePatch(data)
+		if err != nil {
+			return err
+		}
+
+		_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+		return err
+	}
+
+	id := resourceCustomizationID(group, kind)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		customizations, err := readLegacyResourceCustomizations(cm)
+		if err != nil {
+			return err
+		}
+
+		fields := customizations[id]
+		if fields == nil {
+			fields = make(map[string]any)
+		}
+
+		for customizationType, value := range values {
+			field := legacyResourceCustomizationFields[customizationType]
+
+			switch {
+			case value == nil:
+				delete(fields, field)
+			case customizationType == "useOpenLibs":
+				// The legacy format holds a boolean rather than a string
+				fields[field] = value == "true"
+			default:
+				fields[field] = value
+			}
+		}
+
+		if len(fields) > 0 {
+			customizations[id] = fields
+		} else {
+			delete(customizations, id)
+		}
+
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+
+		if len(customizations) == 0 {
+			delete(cm.Data, resourceCustomizationsKey)
+		} else {
+			value, err := yaml.Marshal(customizations)
+			if err != nil {
+				return err
+			}
+
+			cm.Data[resourceCustomizationsKey] = string(value)
+		}
+
+		_, err = kc.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+
+		return err
+	})
+}
+
+type resourceHealthCustomization struct {
+	Group       string
+	Kind        string
+	Lua         string
+	UseOpenLibs bool
+}
+
+func readResourceHealthCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) (*resourceHealthCustomization, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	lua, err := readResourceCustomization(cm, "health", group, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &resourceHealthCustomization{
+		Group: group,
+		Kind:  kind,
+		Lua:   lua,
+	}
+
+	useOpenLibs, err := readResourceCustomization(cm, "useOpenLibs", group, kind)
+
+	switch {
+	case err == nil:
+		if c.UseOpenLibs, err = strconv.ParseBool(useOpenLibs); err != nil {
+			return nil, err
+		}
+	case !apierrors.IsNotFound(err):
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func createResourceHealthCustomization(ctx context.Context, kc kubernetes.Interface, namespace string, c *resourceHealthCustomization) error {
+	_, err := readResourceHealthCustomization(ctx, kc, namespace, c.Group, c.Kind)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), resourceCustomizationKey("health", c.Group, c.Kind))
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateResourceHealthCustomization(ctx, kc, namespace, c)
+}
+
+func updateResourceHealthCustomization(ctx context.Context, kc kubernetes.Interface, namespace string, c *resourceHealthCustomization) error {
+	// ArgoCD does not use the open libraries unless enabled explicitly
+	var useOpenLibs any
+	if c.UseOpenLibs {
+		useOpenLibs = "true"
+	}
+
+	return writeResourceCustomization(ctx, kc, namespace, c.Group, c.Kind, map[string]any{
+		"health":      c.Lua,
+		"useOpenLibs": useOpenLibs,
+	})
+}
+
+func deleteResourceHealthCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) error {
+	return writeResourceCustomization(ctx, kc, namespace, group, kind, map[string]any{
+		"health":      nil,
+		"useOpenLibs": nil,
+	})
+}
+
+func readResourceActionCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) (*v1alpha1.ResourceActions, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := readResourceCustomization(cm, "actions", group, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions v1alpha1.ResourceActions
+	if err = yaml.Unmarshal([]byte(value), &actions); err != nil {
+		return nil, err
+	}
+
+	return &actions, nil
+}
+
+func createResourceActionCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string, actions *v1alpha1.ResourceActions) error {
+	_, err := readResourceActionCustomization(ctx, kc, namespace, group, kind)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), resourceCustomizationKey("actions", group, kind))
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateResourceActionCustomization(ctx, kc, namespace, group, kind, actions)
+}
+
+func updateResourceActionCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string, actions *v1alpha1.ResourceActions) error {
+	value, err := yaml.Marshal(actions)
+	if err != nil {
+		return err
+	}
+
+	return writeResourceCustomization(ctx, kc, namespace, group, kind, map[string]any{
+		"actions": string(value),
+	})
+}
+
+func deleteResourceActionCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) error {
+	return writeResourceCustomization(ctx, kc, namespace, group, kind, map[string]any{
+		"actions": nil,
+	})
+}
+
+func readResourceIgnoreUpdatesCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) (*v1alpha1.OverrideIgnoreDiff, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := readResourceCustomization(cm, "ignoreResourceUpdates", group, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var ignore v1alpha1.OverrideIgnoreDiff
+	if err = yaml.Unmarshal([]byte(value), &ignore); err != nil {
+		return nil, err
+	}
+
+	return &ignore, nil
+}
+
+func createResourceIgnoreUpdatesCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string, ignore *v1alpha1.OverrideIgnoreDiff) error {
+	_, err := readResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), resourceCustomizationKey("ignoreResourceUpdates", group, kind))
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind, ignore)
+}
+
+func updateResourceIgnoreUpdatesCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string, ignore *v1alpha1.OverrideIgnoreDiff) error {
+	value, err := yaml.Marshal(ignore)
+	if err != nil {
+		return err
+	}
+
+	return writeResourceCustomization(ctx, kc, namespace, group, kind, map[string]any{
+		"ignoreResourceUpdates": string(value),
+	})
+}
+
+func deleteResourceIgnoreUpdatesCustomization(ctx context.Context, kc kubernetes.Interface, namespace, group, kind string) error {
+	return writeResourceCustomization(ctx, kc, namespace, group, kind, map[string]any{
+		"ignoreResourceUpdates": nil,
+	})
+}
+
+// resourceCustomizationID returns the ID of the customizations of resources
+// of the given group and kind, which matches the keys of the resource
+// overrides in the ArgoCD settings.
+func resourceCustomizationID(group, kind string) string {
+	if group == "" {
+		return kind
+	}
+
+	return group + "/" + kind
+}
+
+// parseResourceCustomizationID splits an ID of the form `<group>/<kind>`, or
+// `<kind>` for resources of the core group, into its components.
+func parseResourceCustomizationID(id string) (group, kind string, err error) {
+	parts := strings.Split(id, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("invalid resource customization ID %q, expected format `<group>/<kind>` or `<kind>`", id)
+}
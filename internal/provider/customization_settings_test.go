@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func TestResourceHealthCustomizationLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+				"resource.customizations.health.argoproj.io_Rollout": "hs = {}\nreturn hs\n",
+			},
+		},
+	)
+
+	if _, err := readResourceHealthCustomization(ctx, kc, "argocd", "cert-manager.io", "Certificate"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	c := &resourceHealthCustomization{
+		Group: "cert-manager.io",
+		Kind:  "Certificate",
+		Lua:   "hs = {}\nhs.status = \"Healthy\"\nreturn hs\n",
+	}
+
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", c); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	// Health checks managed elsewhere are not overwritten
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", &resourceHealthCustomization{Group: "argoproj.io", Kind: "Rollout"}); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readResourceHealthCustomization(ctx, kc, "argocd", "cert-manager.io", "Certificate")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, c, read)
+
+	c.UseOpenLibs = true
+
+	if err = updateResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "true", cm.Data["resource.customizations.useOpenLibs.cert-manager.io_Certificate"])
+
+	read, err = readResourceHealthCustomization(ctx, kc, "argocd", "cert-manager.io", "Certificate")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, c, read)
+
+	c.UseOpenLibs = false
+
+	if err = updateResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.NotContains(t, cm.Data, "resource.customizations.useOpenLibs.cert-manager.io_Certificate")
+
+	if err = deleteResourceHealthCustomization(ctx, kc, "argocd", "cert-manager.io", "Certificate"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Any other settings are retained
+	assert.NotContains(t, cm.Data, "resource.customizations.health.cert-manager.io_Certificate")
+	assert.Len(t, cm.Data, 2)
+}
+
+func TestResourceHealthCustomizationCoreGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		},
+	)
+
+	c := &resourceHealthCustomization{Kind: "PersistentVolumeClaim", Lua: "return {}\n"}
+
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{"resource.customizations.health.PersistentVolumeClaim": "return {}\n"}, cm.Data)
+}
+
+func TestResourceActionCustomizationLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+				"resource.customizations.health.argoproj.io_Rollout": "hs = {}\nreturn hs\n",
+			},
+		},
+	)
+
+	if _, err := readResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	actions := &v1alpha1.ResourceActions{
+		ActionDiscoveryLua: "actions = {}\nactions[\"restart\"] = {}\nreturn actions\n",
+		Definitions: []v1alpha1.ResourceActionDefinition{
+			{Name: "restart", ActionLua: "return obj\n"},
+		},
+	}
+
+	if err := createResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout", actions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout", actions); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, actions, read)
+
+	actions.Definitions = append(actions.Definitions, v1alpha1.ResourceActionDefinition{Name: "promote", ActionLua: "return obj\n"})
+	actions.MergeBuiltinActions = true
+
+	if err = updateResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout", actions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err = readResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, actions, read)
+
+	if err = deleteResourceActionCustomization(ctx, kc, "argocd", "argoproj.io", "Rollout"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Any other settings, including the health check of the same kind, are retained
+	assert.NotContains(t, cm.Data, "resource.customizations.actions.argoproj.io_Rollout")
+	assert.Len(t, cm.Data, 2)
+}
+
+func TestResourceIgnoreUpdatesCustomizationLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+				"resource.customizations.ignoreResourceUpdates.all": "jsonPointers:\n- /status\n",
+			},
+		},
+	)
+
+	read, err := readResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "", "all")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, []string{"/status"}, read.JSONPointers)
+
+	if _, err = readResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	ignore := &v1alpha1.OverrideIgnoreDiff{
+		JQPathExpressions: []string{".metadata.annotations.\"deployment.kubernetes.io/revision\""},
+	}
+
+	if err = createResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment", ignore); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = createResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment", ignore); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err = readResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, ignore, read)
+
+	ignore.ManagedFieldsManagers = []string{"kube-controller-manager"}
+
+	if err = updateResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment", ignore); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err = readResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, ignore, read)
+
+	m := newResourceIgnoreUpdatesCustomization("apps", "Deployment", read)
+
+	assert.Equal(t, types.StringValue("apps/Deployment"), m.ID)
+	assert.Nil(t, m.JSONPointers)
+	assert.Equal(t, []types.String{types.StringValue("kube-controller-manager")}, m.ManagedFieldsManagers)
+
+	if err = deleteResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "apps", "Deployment"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.NotContains(t, cm.Data, "resource.customizations.ignoreResourceUpdates.apps_Deployment")
+	assert.Len(t, cm.Data, 2)
+}
+
+func TestResourceCustomizationWildcards(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url":                     "https://argocd.example.com",
+				"resource.customizations": "'*.crossplane.io/*':\n  ignoreDifferences: |\n    jsonPointers:\n    - /spec/deletionPolicy\n",
+			},
+		},
+	)
+
+	c := &resourceHealthCustomization{
+		Group:       "*.crossplane.io",
+		Kind:        "*",
+		Lua:         "return {}\n",
+		UseOpenLibs: true,
+	}
+
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createResourceHealthCustomization(ctx, kc, "argocd", c); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readResourceHealthCustomization(ctx, kc, "argocd", "*.crossplane.io", "*")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, c, read)
+
+	actions := &v1alpha1.ResourceActions{
+		Definitions: []v1alpha1.ResourceActionDefinition{
+			{Name: "pause", ActionLua: "return obj\n"},
+		},
+	}
+
+	if err = createResourceActionCustomization(ctx, kc, "argocd", "*.crossplane.io", "*", actions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ignore := &v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/status"}}
+
+	if err = createResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "*", "*", ignore); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Kubernetes rejects ConfigMaps with invalid keys
+	for key := range cm.Data {
+		assert.Empty(t, validation.IsConfigMapKey(key), key)
+	}
+
+	// The customizations are parsed by ArgoCD, retaining any other fields
+	var overrides map[string]v1alpha1.ResourceOverride
+	if err = yaml.Unmarshal([]byte(cm.Data["resource.customizations"]), &overrides); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "return {}\n", overrides["*.crossplane.io/*"].HealthLua)
+	assert.True(t, overrides["*.crossplane.io/*"].UseOpenLibs)
+	assert.Contains(t, overrides["*.crossplane.io/*"].Actions, "pause")
+	assert.Equal(t, []string{"/spec/deletionPolicy"}, overrides["*.crossplane.io/*"].IgnoreDifferences.JSONPointers)
+	assert.Equal(t, []string{"/status"}, overrides["*/*"].IgnoreResourceUpdates.JSONPointers)
+
+	readActions, err := readResourceActionCustomization(ctx, kc, "argocd", "*.crossplane.io", "*")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, actions, readActions)
+
+	c.UseOpenLibs = false
+
+	if err = updateResourceHealthCustomization(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err = readResourceHealthCustomization(ctx, kc, "argocd", "*.crossplane.io", "*")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, c, read)
+
+	if err = deleteResourceHealthCustomization(ctx, kc, "argocd", "*.crossplane.io", "*"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = deleteResourceActionCustomization(ctx, kc, "argocd", "*.crossplane.io", "*"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = deleteResourceIgnoreUpdatesCustomization(ctx, kc, "argocd", "*", "*"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err = readResourceHealthCustomization(ctx, kc, "argocd", "*.crossplane.io", "*"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Customizations managed elsewhere are retained
+	assert.Equal(t, "'*.crossplane.io/*':\n  ignoreDifferences: |\n    jsonPointers:\n    - /spec/deletionPolicy\n", cm.Data["resource.customizations"])
+	assert.Len(t, cm.Data, 2)
+}
+
+func TestParseResourceCustomizationID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id        string
+		wantGroup string
+		wantKind  string
+		wantErr   bool
+	}{
+		{id: "argoproj.io/Rollout", wantGroup: "argoproj.io", wantKind: "Rollout"},
+		{id: "PersistentVolumeClaim", wantKind: "PersistentVolumeClaim"},
+		{id: "", wantErr: true},
+		{id: "argoproj.io/", wantErr: true},
+		{id: "/Rollout", wantErr: true},
+		{id: "a/b/c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			group, kind, err := parseResourceCustomizationID(tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantGroup, group)
+			assert.Equal(t, tt.wantKind, kind)
+			assert.Equal(t, tt.id, resourceCustomizationID(group, kind))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &accountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+// accountDataSource defines the data source implementation.
+type accountDataSource struct {
+	si *ServerInterface
+}
+
+type accountLookupModel struct {
+	accountDataSourceModel
+	Exists types.Bool `tfsdk:"exists"`
+}
+
+func (d *accountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *accountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := accountDataSourceSchemaAttributes(true)
+	attributes["exists"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the account exists in ArgoCD. All other computed attributes are null if it does not.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an [account](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/) known to ArgoCD by its name, including the metadata of the tokens issued for it. " +
+			"Unlike most data sources, it does not fail if the account does not exist, so that it can be used to check whether an account exists, e.g. before granting it permissions with `argocd_rbac`.",
+		Attributes: attributes,
+	}
+}
+
+func (d *accountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data accountLookupModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name
+
+	sync.AccountMutex.RLock()
+	a, err := d.si.AccountClient.GetAccount(ctx, &account.GetAccountRequest{
+		Name: name.ValueString(),
+	})
+	sync.AccountMutex.RUnlock()
+
+	switch {
+	case err != nil && strings.Contains(err.Error(), "NotFound"):
+		// The zero values of all other attributes are null
+		data = accountLookupModel{
+			accountDataSourceModel: accountDataSourceModel{ID: name, Name: name},
+			Exists:                 types.BoolValue(false),
+		}
+	case err != nil:
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "account", name.ValueString(), err)...)
+		return
+	default:
+		data = accountLookupModel{
+			accountDataSourceModel: newAccountDataSourceModel(a),
+			Exists:                 types.BoolValue(true),
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read account %s", name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDAccountDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_account" "test" {
+  name = "test"
+}
+
+data "argocd_account" "does_not_exist" {
+  name = "does-not-exist"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_account.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.argocd_account.test", "id", "test"),
+					resource.TestCheckResourceAttr("data.argocd_account.test", "enabled", "true"),
+					resource.TestCheckTypeSetElemAttr("data.argocd_account.test", "capabilities.*", "apiKey"),
+					resource.TestCheckResourceAttrSet("data.argocd_account.test", "tokens.#"),
+					resource.TestCheckResourceAttr("data.argocd_account.does_not_exist", "exists", "false"),
+					resource.TestCheckResourceAttr("data.argocd_account.does_not_exist", "name", "does-not-exist"),
+					resource.TestCheckNoResourceAttr("data.argocd_account.does_not_exist", "enabled"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDAccountsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_accounts" "all" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_accounts.all", "accounts.0.name", "admin"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_accounts.all", "accounts.*", map[string]string{
+						"name":           "test",
+						"enabled":        "true",
+						"capabilities.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &certificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &certificatesDataSource{}
+}
+
+// certificatesDataSource defines the data source implementation.
+type certificatesDataSource struct {
+	si *ServerInterface
+}
+
+type certificatesModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	HostNamePattern types.String                 `tfsdk:"host_name_pattern"`
+	CertType        types.String                 `tfsdk:"cert_type"`
+	CertSubType     types.String                 `tfsdk:"cert_subtype"`
+	Certificates    []certificateDataSourceModel `tfsdk:"certificates"`
+}
+
+type certificateDataSourceModel struct {
+	ServerName  types.String `tfsdk:"server_name"`
+	CertType    types.String `tfsdk:"cert_type"`
+	CertSubType types.String `tfsdk:"cert_subtype"`
+	CertInfo    types.String `tfsdk:"cert_info"`
+}
+
+func (d *certificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *certificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [repository certificates](https://argo-cd.readthedocs.io/en/stable/user-guide/private-repositories/#self-signed-untrusted-tls-certificates) configured in ArgoCD, " +
+			"i.e. the TLS certificates of HTTPS repository servers and the SSH known hosts entries, e.g. to audit them or to check that a server is known before repositories hosted on it are added.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"host_name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Glob pattern the server names of the certificates must match, e.g. `*.example.com`. Defaults to all server names.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cert_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the certificates, either `https` or `ssh`. Defaults to both.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("https", sshCertType),
+				},
+			},
+			"cert_subtype": schema.StringAttribute{
+				MarkdownDescription: "Sub type of the certificates, e.g. `ssh-ed25519`. Defaults to all sub types.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "Certificates, sorted by server name, type and sub type. TLS certificate bundles are listed as one certificate per entry of the bundle. The certificate data itself is not returned by ArgoCD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "DNS name of the server the certificate is intended for.",
+							Computed:            true,
+						},
+						"cert_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the certificate, either `https` or `ssh`.",
+							Computed:            true,
+						},
+						"cert_subtype": schema.StringAttribute{
+							MarkdownDescription: "Sub type of the certificate, i.e. the key type of SSH known hosts entries, e.g. `ssh-ed25519`, or the public key algorithm of TLS certificates, e.g. `rsa`.",
+							Computed:            true,
+						},
+						"cert_info": schema.StringAttribute{
+							MarkdownDescription: "Additional information about the certificate, i.e. the SHA256 fingerprint of SSH known hosts entries or the subject of TLS certificates.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *certificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *certificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data certificatesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.CertificateMutex.RLock()
+	certs, err := d.si.CertificateClient.ListCertificates(ctx, &certificate.RepositoryCertificateQuery{
+		HostNamePattern: data.HostNamePattern.ValueString(),
+		CertType:        data.CertType.ValueString(),
+		CertSubType:     data.CertSubType.ValueString(),
+	})
+	sync.CertificateMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list repository certificates", err)...)
+		return
+	}
+
+	slices.SortFunc(certs.Items, func(a, b v1alpha1.RepositoryCertificate) int {
+		return cmp.Or(
+			cmp.Compare(a.ServerName, b.ServerName),
+			cmp.Compare(a.CertType, b.CertType),
+			cmp.Compare(a.CertSubType, b.CertSubType),
+		)
+	})
+
+	data.ID = types.StringValue("certificates")
+	data.Certificates = make([]certificateDataSourceModel, 0, len(certs.Items))
+
+	for _, c := range certs.Items {
+		data.Certificates = append(data.Certificates, certificateDataSourceModel{
+			ServerName:  types.StringValue(c.ServerName),
+			CertType:    types.StringValue(c.CertType),
+			CertSubType: types.StringValue(c.CertSubType),
+			CertInfo:    types.StringValue(c.CertInfo),
+		})
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read %d repository certificates", len(data.Certificates)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
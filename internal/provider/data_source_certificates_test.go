@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDCertificatesDataSource(t *testing.T) {
+	serverName := acctest.RandomWithPrefix("certificates") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDSSHKnownHosts(map[string]string{
+					serverName: testSSHKnownHostGitLab,
+				}) + fmt.Sprintf(`
+data "argocd_certificates" "ssh" {
+  host_name_pattern = "%s"
+  cert_type         = "ssh"
+
+  depends_on = [argocd_ssh_known_hosts.test]
+}
+
+data "argocd_certificates" "https" {
+  host_name_pattern = "%[1]s"
+  cert_type         = "https"
+
+  depends_on = [argocd_ssh_known_hosts.test]
+}
+`, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_certificates.ssh", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_certificates.ssh", "certificates.0.server_name", serverName),
+					resource.TestCheckResourceAttr("data.argocd_certificates.ssh", "certificates.0.cert_type", "ssh"),
+					resource.TestCheckResourceAttr("data.argocd_certificates.ssh", "certificates.0.cert_subtype", "ecdsa-sha2-nistp256"),
+					resource.TestCheckResourceAttr("data.argocd_certificates.ssh", "certificates.0.cert_info", "SHA256:HbW3g8zUjNSksFbqTiUWPWg2Bq1x8xdGUrliXFzSnUw"),
+					resource.TestCheckResourceAttr("data.argocd_certificates.https", "certificates.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &clusterDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &clusterDataSource{}
+
+func NewClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+// clusterDataSource defines the data source implementation.
+type clusterDataSource struct {
+	si *ServerInterface
+}
+
+func (d *clusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a [cluster](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters) registered in ArgoCD by its `name` or `server`, including the connection state last observed by ArgoCD.",
+		Attributes:          clusterSchemaAttributes(true),
+	}
+}
+
+func (d *clusterDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("server"),
+		),
+	}
+}
+
+func (d *clusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clusterModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := &cluster.ClusterID{Type: "server", Value: data.Server.ValueString()}
+	if !data.Name.IsNull() {
+		id = &cluster.ClusterID{Type: "name", Value: data.Name.ValueString()}
+	}
+
+	sync.ClusterMutex.RLock()
+	c, err := d.si.ClusterClient.Get(ctx, &cluster.ClusterQuery{Id: id})
+	sync.ClusterMutex.RUnlock()
+
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") || strings.Contains(err.Error(), "PermissionDenied") {
+			resp.Diagnostics.AddError(
+				"Cluster Not Found",
+				fmt.Sprintf("no cluster with %s %s is registered in ArgoCD, or it cannot be read", id.Type, id.Value),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "cluster", id.Value, err)...)
+
+		return
+	}
+
+	data = newCluster(*c)
+
+	tflog.Trace(ctx, fmt.Sprintf("read cluster %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
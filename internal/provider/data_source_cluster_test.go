@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDClusterDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_cluster" "by_server" {
+  server = "https://kubernetes.default.svc"
+}
+
+data "argocd_cluster" "by_name" {
+  name = "in-cluster"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_server", "name", "in-cluster"),
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_server", "id", "https://kubernetes.default.svc/in-cluster"),
+					resource.TestCheckResourceAttrSet("data.argocd_cluster.by_server", "info.connection_state.status"),
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_name", "server", "https://kubernetes.default.svc"),
+					resource.TestCheckResourceAttrPair("data.argocd_cluster.by_name", "id", "data.argocd_cluster.by_server", "id"),
+				),
+			},
+			{
+				Config: `
+data "argocd_cluster" "missing" {
+  name = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile("no cluster with name does-not-exist is registered in ArgoCD"),
+			},
+			{
+				Config: `
+data "argocd_cluster" "ambiguous" {
+  name   = "in-cluster"
+  server = "https://kubernetes.default.svc"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &clustersDataSource{}
+
+func NewClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource defines the data source implementation.
+type clustersDataSource struct {
+	si *ServerInterface
+}
+
+type clustersModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Selector     types.String   `tfsdk:"selector"`
+	NamePrefix   types.String   `tfsdk:"name_prefix"`
+	ServerPrefix types.String   `tfsdk:"server_prefix"`
+	Clusters     []clusterModel `tfsdk:"clusters"`
+}
+
+func (d *clustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [clusters](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters) registered in ArgoCD, optionally filtered by the labels of their cluster secret, their name or their server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"selector": schema.StringAttribute{
+				MarkdownDescription: "[Label selector](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors) the labels of the cluster secrets must match, e.g. `environment=production,region in (eu, us)`.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix the name of the clusters must start with.",
+				Optional:            true,
+			},
+			"server_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix the API server URL of the clusters must start with.",
+				Optional:            true,
+			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters matching all filters, sorted by server and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: clusterSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clustersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selector, err := labels.Parse(data.Selector.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("selector"),
+			"Invalid Label Selector",
+			fmt.Sprintf("label selector %q could not be parsed: %s", data.Selector.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	sync.ClusterMutex.RLock()
+	clusters, err := d.si.ClusterClient.List(ctx, &cluster.ClusterQuery{})
+	sync.ClusterMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list clusters", err)...)
+		return
+	}
+
+	matching := slices.DeleteFunc(clusters.Items, func(c v1alpha1.Cluster) bool {
+		return !selector.Matches(labels.Set(c.Labels)) ||
+			!strings.HasPrefix(c.Name, data.NamePrefix.ValueString()) ||
+			!strings.HasPrefix(c.Server, data.ServerPrefix.ValueString())
+	})
+
+	slices.SortFunc(matching, func(a, b v1alpha1.Cluster) int {
+		return cmp.Or(cmp.Compare(a.Server, b.Server), cmp.Compare(a.Name, b.Name))
+	})
+
+	data.ID = types.StringValue("clusters")
+	data.Clusters = make([]clusterModel, 0, len(matching))
+
+	for _, c := range matching {
+		data.Clusters = append(data.Clusters, newCluster(c))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read %d clusters", len(data.Clusters)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
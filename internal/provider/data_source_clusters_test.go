@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDClustersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_clusters" "in_cluster" {
+  name_prefix   = "in-"
+  server_prefix = "https://kubernetes.default.svc"
+}
+
+data "argocd_clusters" "none" {
+  selector = "environment in (does-not-exist)"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_clusters.in_cluster", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_clusters.in_cluster", "clusters.0.name", "in-cluster"),
+					resource.TestCheckResourceAttr("data.argocd_clusters.none", "clusters.#", "0"),
+				),
+			},
+			{
+				Config: `
+data "argocd_clusters" "invalid" {
+  selector = "environment in production"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Label Selector"),
+			},
+		},
+	})
+}
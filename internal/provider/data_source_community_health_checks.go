@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &communityHealthChecksDataSource{}
+
+func NewCommunityHealthChecksDataSource() datasource.DataSource {
+	return &communityHealthChecksDataSource{}
+}
+
+// communityHealthChecksDataSource defines the data source implementation.
+type communityHealthChecksDataSource struct{}
+
+type communityHealthChecksModel struct {
+	ID           types.String                                `tfsdk:"id"`
+	Revision     types.String                                `tfsdk:"revision"`
+	BaseURL      types.String                                `tfsdk:"base_url"`
+	Resources    []communityHealthChecksResourceModel        `tfsdk:"resources"`
+	HealthChecks map[string]communityHealthChecksHealthModel `tfsdk:"health_checks"`
+}
+
+type communityHealthChecksResourceModel struct {
+	Group types.String `tfsdk:"group"`
+	Kind  types.String `tfsdk:"kind"`
+}
+
+type communityHealthChecksHealthModel struct {
+	Group types.String `tfsdk:"group"`
+	Kind  types.String `tfsdk:"kind"`
+	Lua   types.String `tfsdk:"lua"`
+}
+
+func (d *communityHealthChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_community_health_checks"
+}
+
+func (d *communityHealthChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches health checks of the given resources from the [community library](https://github.com/argoproj/argo-cd/tree/master/resource_customizations) of ArgoCD at a pinned revision, " +
+			"to be passed to `argocd_resource_health_customization`, rather than copying the Lua scripts into the configuration. " +
+			"This allows using health checks which have been added or fixed in later versions of ArgoCD than the one which is installed, since ArgoCD only ships the health checks of its own version.\n\n" +
+			"The health checks are fetched from GitHub by Terraform, not by ArgoCD. Fetching a health check which does not exist at the given revision fails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, i.e. the revision.",
+				Computed:            true,
+			},
+			"revision": schema.StringAttribute{
+				MarkdownDescription: "Revision of the argoproj/argo-cd repository to fetch the health checks from, e.g. a tag such as `v3.3.6` or, preferably, a commit SHA, so that the health checks only change when the revision is bumped.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "URL the raw files of the repository are served from, e.g. a mirror of it. Defaults to `" + communityHealthChecksBaseURL + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(oidcURLRegex, "must be an HTTP(S) URL"),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources to fetch the health checks of.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							MarkdownDescription: "API group of the resources, e.g. `cert-manager.io`. Wildcards are supported, e.g. `*.crossplane.io`. Omit for resources of the core group.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+							},
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the resources, e.g. `Certificate`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+							},
+						},
+					},
+				},
+			},
+			"health_checks": schema.MapNestedAttribute{
+				MarkdownDescription: "Health checks of the resources, keyed by `<group>/<kind>`, or `<kind>` for resources of the core group, i.e. the ID of the matching `argocd_resource_health_customization`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							MarkdownDescription: "API group of the resources.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the resources.",
+							Computed:            true,
+						},
+						"lua": schema.StringAttribute{
+							MarkdownDescription: "Lua script of the health check.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *communityHealthChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data communityHealthChecksModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revision := data.Revision.ValueString()
+
+	baseURL := communityHealthChecksBaseURL
+	if !data.BaseURL.IsNull() {
+		baseURL = data.BaseURL.ValueString()
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	data.ID = types.StringValue(revision)
+	data.HealthChecks = make(map[string]communityHealthChecksHealthModel, len(data.Resources))
+
+	for _, r := range data.Resources {
+		group, kind := r.Group.ValueString(), r.Kind.ValueString()
+		id := resourceCustomizationID(group, kind)
+
+		lua, err := fetchCommunityHealthCheck(ctx, client, baseURL, revision, group, kind)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to fetch health check for %s at revision %s", id, revision), err)...)
+			return
+		}
+
+		data.HealthChecks[id] = communityHealthChecksHealthModel{
+			Group: r.Group,
+			Kind:  r.Kind,
+			Lua:   types.StringValue(lua),
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("fetched %d health checks at revision %s", len(data.HealthChecks), revision))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
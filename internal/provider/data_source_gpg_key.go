@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &gpgKeyDataSource{}
+
+func NewGPGKeyDataSource() datasource.DataSource {
+	return &gpgKeyDataSource{}
+}
+
+// gpgKeyDataSource defines the data source implementation.
+type gpgKeyDataSource struct {
+	si *ServerInterface
+}
+
+func (d *gpgKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gpg_key"
+}
+
+func (d *gpgKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a key in the [GnuPG keyring](https://argo-cd.readthedocs.io/en/stable/user-guide/gpg-verification/) of ArgoCD by its ID, e.g. to reference a key managed outside of Terraform in the `signature_keys` of a project. " +
+			"Reading a key which is not in the keyring fails.",
+		Attributes: gpgKeyDataSourceSchemaAttributes(true),
+	}
+}
+
+func (d *gpgKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *gpgKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data gpgKeyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ArgoCD stores key IDs in upper case
+	keyID := strings.ToUpper(data.KeyID.ValueString())
+
+	sync.GPGKeysMutex.RLock()
+	k, err := d.si.GPGKeysClient.Get(ctx, &gpgkey.GnuPGPublicKeyQuery{
+		KeyID: keyID,
+	})
+	sync.GPGKeysMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "GPG key", keyID, err)...)
+		return
+	}
+
+	data = newGPGKeyDataSourceModel(k)
+
+	tflog.Trace(ctx, fmt.Sprintf("read GPG key %s", keyID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &gpgKeysDataSource{}
+
+func NewGPGKeysDataSource() datasource.DataSource {
+	return &gpgKeysDataSource{}
+}
+
+// gpgKeysDataSource defines the data source implementation.
+type gpgKeysDataSource struct {
+	si *ServerInterface
+}
+
+type gpgKeysModel struct {
+	ID     types.String            `tfsdk:"id"`
+	KeyIDs []types.String          `tfsdk:"key_ids"`
+	Keys   []gpgKeyDataSourceModel `tfsdk:"keys"`
+}
+
+func (d *gpgKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gpg_keys"
+}
+
+func (d *gpgKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the keys in the [GnuPG keyring](https://argo-cd.readthedocs.io/en/stable/user-guide/gpg-verification/) of ArgoCD, e.g. to check that the `signature_keys` of a project exist or to audit the owners of the keys.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"key_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the GPG keys in the keyring, sorted alphabetically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "GPG keys in the keyring, sorted by key ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: gpgKeyDataSourceSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *gpgKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *gpgKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data gpgKeysModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, diags := listGPGKeys(ctx, d.si)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("gpg_keys")
+	data.KeyIDs = make([]types.String, 0, len(keys))
+	data.Keys = make([]gpgKeyDataSourceModel, 0, len(keys))
+
+	for _, k := range keys {
+		data.KeyIDs = append(data.KeyIDs, types.StringValue(k.KeyID))
+		data.Keys = append(data.Keys, newGPGKeyDataSourceModel(&k))
+	}
+
+	tflog.Trace(ctx, "read ArgoCD GPG keys")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listGPGKeys returns all keys in the GnuPG keyring of ArgoCD, sorted by ID.
+func listGPGKeys(ctx context.Context, si *ServerInterface) ([]v1alpha1.GnuPGPublicKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sync.GPGKeysMutex.RLock()
+
+	keys, err := si.GPGKeysClient.List(ctx, &gpgkey.GnuPGPublicKeyQuery{})
+
+	sync.GPGKeysMutex.RUnlock()
+
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to list GPG keys", err)...)
+		return nil, diags
+	}
+
+	slices.SortFunc(keys.Items, func(a, b v1alpha1.GnuPGPublicKey) int {
+		return strings.Compare(a.KeyID, b.KeyID)
+	})
+
+	return keys.Items, diags
+}
+
+// gpgKeyIDs returns the sorted IDs of all keys in the GnuPG keyring of ArgoCD.
+func gpgKeyIDs(ctx context.Context, si *ServerInterface) ([]string, diag.Diagnostics) {
+	keys, diags := listGPGKeys(ctx, si)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, k.KeyID)
+	}
+
+	return ids, diags
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &projectTokensDataSource{}
+
+func NewProjectTokensDataSource() datasource.DataSource {
+	return &projectTokensDataSource{}
+}
+
+// projectTokensDataSource defines the data source implementation.
+type projectTokensDataSource struct {
+	si *ServerInterface
+}
+
+type projectTokensModel struct {
+	ID      types.String                  `tfsdk:"id"`
+	Project types.String                  `tfsdk:"project"`
+	Role    types.String                  `tfsdk:"role"`
+	Tokens  []projectTokenDataSourceModel `tfsdk:"tokens"`
+}
+
+type projectTokenDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssuedAt  types.String `tfsdk:"issued_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Managed   types.Bool   `tfsdk:"managed"`
+}
+
+func (d *projectTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tokens"
+}
+
+func (d *projectTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the JWT tokens issued for a [project role](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-roles), e.g. to audit them or to find stale tokens. The tokens themselves are not returned by ArgoCD.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, of the form `<project>/<role>`.",
+				Computed:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Name of the project.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Name of the project role.",
+				Required:            true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				MarkdownDescription: "Tokens issued for the role, sorted by issue date.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Token identifier. Tokens issued by older versions of ArgoCD do not have an identifier, in which case the Unix timestamp at which they were issued is used instead.",
+							Computed:            true,
+						},
+						"issued_at": schema.StringAttribute{
+							MarkdownDescription: "Unix timestamp at which the token was issued.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "Unix timestamp upon which the token will expire, if it expires at all.",
+							Computed:            true,
+						},
+						"managed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the token is managed by `argocd_project_token`, i.e. whether its identifier is recorded in the `" + projectManagedTokensAnnotation + "` annotation of the project.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *projectTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectTokensModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	role := data.Role.ValueString()
+
+	projectMutex := sync.GetProjectMutex(projectName)
+	projectMutex.RLock()
+	p, err := d.si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	projectMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", projectName, err)...)
+		return
+	}
+
+	if _, _, err = p.GetRoleByName(role); err != nil {
+		resp.Diagnostics.AddError(
+			"Project Role Not Found",
+			fmt.Sprintf("role %s does not exist in project %s", role, projectName),
+		)
+
+		return
+	}
+
+	tokens := projectRoleTokens(p, role)
+
+	managed, err := projectManagedTokenIDs(p)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to determine managed tokens of project %s", projectName), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(projectName + "/" + role)
+	data.Tokens = make([]projectTokenDataSourceModel, 0, len(tokens))
+
+	for _, t := range tokens {
+		token := projectTokenDataSourceModel{
+			ID:        types.StringValue(projectTokenID(t)),
+			IssuedAt:  types.StringValue(strconv.FormatInt(t.IssuedAt, 10)),
+			ExpiresAt: types.StringNull(),
+			Managed:   types.BoolValue(slices.Contains(managed[role], projectTokenID(t))),
+		}
+
+		if t.ExpiresAt > 0 {
+			token.ExpiresAt = types.StringValue(strconv.FormatInt(t.ExpiresAt, 10))
+		}
+
+		data.Tokens = append(data.Tokens, token)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read %d tokens of role %s in project %s", len(data.Tokens), role, projectName))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDProjectTokensDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-tokens")
+
+	config := fmt.Sprintf(`
+resource "argocd_project" "this" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "ci"
+      policies = ["p, proj:%[1]s:ci, applications, get, %[1]s/*, allow"]
+    }
+  }
+}
+
+resource "argocd_project_token" "ci" {
+  project    = argocd_project.this.metadata.0.name
+  role       = "ci"
+  expires_in = "1h"
+}
+`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+data "argocd_project_tokens" "ci" {
+  project = argocd_project.this.metadata.0.name
+  role    = "ci"
+
+  depends_on = [argocd_project_token.ci]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_project_tokens.ci", "id", name+"/ci"),
+					resource.TestCheckResourceAttr("data.argocd_project_tokens.ci", "tokens.#", "1"),
+					resource.TestCheckResourceAttrPair("data.argocd_project_tokens.ci", "tokens.0.id", "argocd_project_token.ci", "id"),
+					resource.TestCheckResourceAttrPair("data.argocd_project_tokens.ci", "tokens.0.expires_at", "argocd_project_token.ci", "expires_at"),
+					resource.TestCheckResourceAttr("data.argocd_project_tokens.ci", "tokens.0.managed", "true"),
+				),
+			},
+			{
+				Config: config + `
+data "argocd_project_tokens" "missing" {
+  project = argocd_project.this.metadata.0.name
+  role    = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`role missing does not exist in project`),
+			},
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &rbacCanIDataSource{}
+
+func NewRBACCanIDataSource() datasource.DataSource {
+	return &rbacCanIDataSource{}
+}
+
+// rbacCanIDataSource defines the data source implementation.
+type rbacCanIDataSource struct {
+	si *ServerInterface
+}
+
+type rbacCanIModel struct {
+	ID            types.String `tfsdk:"id"`
+	Subject       types.String `tfsdk:"subject"`
+	Action        types.String `tfsdk:"action"`
+	Resource      types.String `tfsdk:"resource"`
+	Object        types.String `tfsdk:"object"`
+	PolicyCSV     types.String `tfsdk:"policy_csv"`
+	PolicyDefault types.String `tfsdk:"policy_default"`
+	MatchMode     types.String `tfsdk:"match_mode"`
+	Allowed       types.Bool   `tfsdk:"allowed"`
+}
+
+func (d *rbacCanIDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbac_can_i"
+}
+
+func (d *rbacCanIDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether an account, SSO group or role is allowed to perform an action according to the [RBAC configuration](https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/) of ArgoCD, " +
+			"the same way `argocd admin settings rbac can` does. The built-in policy of ArgoCD is always evaluated along with the configured one.\n\n" +
+			"The policy is read from the `argocd-rbac-cm` ConfigMap, which requires the provider to be configured with `core = true`, unless `policy_csv` is set. " +
+			"Passing the attributes of an `argocd_rbac` resource allows asserting the effect of policy changes with preconditions in the same plan which modifies them.\n\n" +
+			"**Note**: the policies of project roles are stored in the projects themselves and are therefore not evaluated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, of the form `<subject>/<resource>/<action>/<object>`.",
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Account, SSO group or role to check, e.g. `admin`, `my-org:team-alpha` or `role:readonly`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action to check, e.g. `get`, `sync` or `action/apps/Deployment/restart`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resource": schema.StringAttribute{
+				MarkdownDescription: "Resource to check, one of `" + strings.Join(rbac.Resources, "`, `") + "`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rbac.Resources...),
+				},
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "Object of the resource to check, e.g. `my-project/my-app` for applications. Defaults to any object of the resource.",
+				Optional:            true,
+			},
+			"policy_csv": schema.StringAttribute{
+				MarkdownDescription: "Policies and role assignments in CSV format to evaluate instead of the ones stored in `argocd-rbac-cm`, e.g. `argocd_rbac.this.policy_csv`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.RBACPolicy(),
+				},
+			},
+			"policy_default": schema.StringAttribute{
+				MarkdownDescription: "Role granted to all subjects which are not granted any other role, e.g. `role:readonly`. Can only be set along with `policy_csv`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("policy_csv")),
+				},
+			},
+			"match_mode": schema.StringAttribute{
+				MarkdownDescription: "Matcher used to evaluate the objects of the policies, either `glob` or `regex`. Defaults to `glob`. Can only be set along with `policy_csv`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rbac.GlobMatchMode, rbac.RegexMatchMode),
+					stringvalidator.AlsoRequires(path.MatchRoot("policy_csv")),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subject is allowed to perform the action.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *rbacCanIDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *rbacCanIDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rbacCanIModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := &rbacPolicy{
+		PolicyCSV:     data.PolicyCSV.ValueString(),
+		PolicyDefault: data.PolicyDefault.ValueString(),
+		MatchMode:     data.MatchMode.ValueString(),
+	}
+
+	if data.PolicyCSV.IsNull() {
+		kc, namespace, err := d.si.KubernetesClient()
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+			return
+		}
+
+		sync.RBACMutex.RLock()
+		p, err = readRBACPolicy(ctx, kc, namespace)
+		sync.RBACMutex.RUnlock()
+
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error("failed to read RBAC configuration", err)...)
+			return
+		}
+	}
+
+	subject := data.Subject.ValueString()
+	resource := data.Resource.ValueString()
+	action := data.Action.ValueString()
+	object := data.Object.ValueString()
+
+	allowed, err := p.enforce(subject, resource, action, object)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to evaluate RBAC policy", err)...)
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{subject, resource, action, object}, "/"))
+	data.Allowed = types.BoolValue(allowed)
+
+	tflog.Trace(ctx, fmt.Sprintf("checked whether %s can %s %s %s: %t", subject, action, resource, object, allowed))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDRBACCanIDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  policy_csv = <<-EOT
+    p, role:deployer, applications, sync, team-alpha/*, allow
+    g, ci, role:deployer
+  EOT
+}
+
+data "argocd_rbac_can_i" "allowed" {
+  subject    = "ci"
+  action     = "sync"
+  resource   = "applications"
+  object     = "team-alpha/my-app"
+  policy_csv = local.policy_csv
+}
+
+data "argocd_rbac_can_i" "denied" {
+  subject    = "ci"
+  action     = "sync"
+  resource   = "applications"
+  object     = "team-beta/my-app"
+  policy_csv = local.policy_csv
+}
+
+data "argocd_rbac_can_i" "default" {
+  subject        = "ci"
+  action         = "get"
+  resource       = "clusters"
+  policy_csv     = local.policy_csv
+  policy_default = "role:readonly"
+}
+
+data "argocd_rbac_can_i" "builtin" {
+  subject    = "admin"
+  action     = "delete"
+  resource   = "clusters"
+  policy_csv = local.policy_csv
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_rbac_can_i.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.argocd_rbac_can_i.allowed", "id", "ci/applications/sync/team-alpha/my-app"),
+					resource.TestCheckResourceAttr("data.argocd_rbac_can_i.denied", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.argocd_rbac_can_i.default", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.argocd_rbac_can_i.builtin", "allowed", "true"),
+				),
+			},
+			{
+				Config: `
+data "argocd_rbac_can_i" "invalid" {
+  subject        = "ci"
+  action         = "get"
+  resource       = "clusters"
+  policy_default = "role:readonly"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
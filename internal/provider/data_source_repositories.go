@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoriesDataSource{}
+
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &repositoriesDataSource{}
+}
+
+// repositoriesDataSource defines the data source implementation.
+type repositoriesDataSource struct {
+	si *ServerInterface
+}
+
+type repositoriesModel struct {
+	ID           types.String                `tfsdk:"id"`
+	Type         types.String                `tfsdk:"type"`
+	Project      types.String                `tfsdk:"project"`
+	URLPrefix    types.String                `tfsdk:"url_prefix"`
+	Repositories []repositoryDataSourceModel `tfsdk:"repositories"`
+}
+
+func (d *repositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *repositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [repositories](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) registered in ArgoCD, optionally filtered by their type, project or URL.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type the repositories must have. Can be either `git`, `helm` or `oci`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("git", "helm", "oci"),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Project the repositories must be scoped to.",
+				Optional:            true,
+			},
+			"url_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix the URL of the repositories must start with.",
+				Optional:            true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				MarkdownDescription: "Repositories matching all filters, sorted by URL and project.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: repositoryDataSourceSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *repositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoriesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.RepositoryMutex.RLock()
+	repos, err := d.si.RepositoryClient.List(ctx, &repository.RepoQuery{
+		AppProject: data.Project.ValueString(),
+	})
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list repositories", err)...)
+		return
+	}
+
+	matching := slices.DeleteFunc(repos.Items, func(r *v1alpha1.Repository) bool {
+		return (!data.Type.IsNull() && r.Type != data.Type.ValueString()) ||
+			(!data.Project.IsNull() && r.Project != data.Project.ValueString()) ||
+			!strings.HasPrefix(r.Repo, data.URLPrefix.ValueString())
+	})
+
+	slices.SortFunc(matching, func(a, b *v1alpha1.Repository) int {
+		return cmp.Or(cmp.Compare(a.Repo, b.Repo), cmp.Compare(a.Project, b.Project))
+	})
+
+	data.ID = types.StringValue("repositories")
+	data.Repositories = make([]repositoryDataSourceModel, 0, len(matching))
+
+	for _, r := range matching {
+		data.Repositories = append(data.Repositories, newRepositoryDataSourceModel(*r))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read %d repositories", len(data.Repositories)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDRepositoriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_repository" "git" {
+  repo = "https://github.com/kubernetes-sigs/kustomize"
+}
+
+resource "argocd_repository" "helm" {
+  repo = "https://helm.nginx.com/stable"
+  name = "nginx-stable"
+  type = "helm"
+}
+
+data "argocd_repositories" "kubernetes_sigs" {
+  url_prefix = "https://github.com/kubernetes-sigs/"
+  depends_on = [argocd_repository.git, argocd_repository.helm]
+}
+
+data "argocd_repositories" "helm" {
+  type       = "helm"
+  url_prefix = "https://helm.nginx.com/"
+  depends_on = [argocd_repository.git, argocd_repository.helm]
+}
+
+data "argocd_repositories" "none" {
+  project    = "does-not-exist"
+  depends_on = [argocd_repository.git, argocd_repository.helm]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_repositories.kubernetes_sigs", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.kubernetes_sigs", "repositories.0.repo", "https://github.com/kubernetes-sigs/kustomize"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.kubernetes_sigs", "repositories.0.type", "git"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.helm", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.helm", "repositories.0.name", "nginx-stable"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.none", "repositories.#", "0"),
+				),
+			},
+			{
+				Config: `
+data "argocd_repositories" "invalid" {
+  type = "svn"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+		},
+	})
+}
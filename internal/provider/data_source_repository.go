@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoryDataSource{}
+
+func NewRepositoryDataSource() datasource.DataSource {
+	return &repositoryDataSource{}
+}
+
+// repositoryDataSource defines the data source implementation.
+type repositoryDataSource struct {
+	si *ServerInterface
+}
+
+type repositoryLookupModel struct {
+	repositoryDataSourceModel
+	Exists types.Bool `tfsdk:"exists"`
+}
+
+func (d *repositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (d *repositoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := repositoryDataSourceSchemaAttributes(true)
+	attributes["exists"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the repository is registered in ArgoCD. All other computed attributes are null if it is not.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a [repository](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) registered in ArgoCD by its URL and project, including the connection state last observed by ArgoCD. " +
+			"Unlike most data sources, it does not fail if the repository is not registered, so that it can be used to check whether a repository exists.",
+		Attributes: attributes,
+	}
+}
+
+func (d *repositoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoryLookupModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoURL, project := data.Repo, data.Project
+
+	repo, diags := readRepository(ctx, d.si, repoURL.ValueString(), project.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if repo == nil {
+		// The zero values of all other attributes are null
+		data = repositoryLookupModel{
+			repositoryDataSourceModel: repositoryDataSourceModel{ID: repoURL},
+			Exists:                    types.BoolValue(false),
+		}
+
+		if project.ValueString() != "" {
+			data.ID = types.StringValue(repoURL.ValueString() + "|" + project.ValueString())
+		}
+	} else {
+		data = repositoryLookupModel{
+			repositoryDataSourceModel: newRepositoryDataSourceModel(*repo),
+			Exists:                    types.BoolValue(true),
+		}
+	}
+
+	// Keep the configured URL and project, as OCI Helm repositories may be
+	// looked up with the `oci://` scheme that ArgoCD does not store
+	data.Repo, data.Project = repoURL, project
+
+	tflog.Trace(ctx, fmt.Sprintf("read repository %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
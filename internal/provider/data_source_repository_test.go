@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDRepositoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_repository" "simple" {
+  repo = "https://github.com/kubernetes-sigs/kustomize"
+}
+
+data "argocd_repository" "simple" {
+  repo = argocd_repository.simple.repo
+}
+
+data "argocd_repository" "missing" {
+  repo    = "https://github.com/argoproj/does-not-exist"
+  project = "default"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "exists", "true"),
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "id", "https://github.com/kubernetes-sigs/kustomize"),
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "type", "git"),
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "insecure", "false"),
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "enable_lfs", "false"),
+					resource.TestCheckResourceAttr("data.argocd_repository.simple", "connection_state_status", "Successful"),
+					resource.TestCheckNoResourceAttr("data.argocd_repository.simple", "project"),
+					resource.TestCheckResourceAttr("data.argocd_repository.missing", "exists", "false"),
+					resource.TestCheckResourceAttr("data.argocd_repository.missing", "id", "https://github.com/argoproj/does-not-exist|default"),
+					resource.TestCheckNoResourceAttr("data.argocd_repository.missing", "type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage individual Dex connectors within the
+// `dex.config` key of the `argocd-cm` ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#dex).
+// As all connectors are stored within the same YAML document, it is updated
+// connector by connector and written with the resource version it has been
+// read at, so that concurrent changes to other connectors or settings of Dex
+// are neither lost nor overwritten.
+
+const dexConfigKey = "dex.config"
+
+type dexConnector struct {
+	Type   string         `json:"type"`
+	ID     string         `json:"id"`
+	Name   string         `json:"name"`
+	Config map[string]any `json:"config,omitempty"`
+}
+
+// The types below mirror the fields of the connector configurations of Dex
+// which can be managed (see https://dexidp.io/docs/connectors/). Any other
+// fields are dropped when a connector is written.
+
+type dexGitHubConfig struct {
+	ClientID      string         `json:"clientID"`
+	ClientSecret  string         `json:"clientSecret"`
+	Orgs          []dexGitHubOrg `json:"orgs,omitempty"`
+	HostName      string         `json:"hostName,omitempty"`
+	RootCA        string         `json:"rootCA,omitempty"`
+	LoadAllGroups bool           `json:"loadAllGroups,omitempty"`
+	TeamNameField string         `json:"teamNameField,omitempty"`
+	UseLoginAsID  bool           `json:"useLoginAsID,omitempty"`
+}
+
+type dexGitHubOrg struct {
+	Name  string   `json:"name"`
+	Teams []string `json:"teams,omitempty"`
+}
+
+type dexLDAPConfig struct {
+	Host               string              `json:"host"`
+	InsecureNoSSL      bool                `json:"insecureNoSSL,omitempty"`
+	InsecureSkipVerify bool                `json:"insecureSkipVerify,omitempty"`
+	StartTLS           bool                `json:"startTLS,omitempty"`
+	RootCA             string              `json:"rootCA,omitempty"`
+	BindDN             string              `json:"bindDN,omitempty"`
+	BindPW             string              `json:"bindPW,omitempty"`
+	UsernamePrompt     string              `json:"usernamePrompt,omitempty"`
+	UserSearch         dexLDAPUserSearch   `json:"userSearch"`
+	GroupSearch        *dexLDAPGroupSearch `json:"groupSearch,omitempty"`
+}
+
+type dexLDAPUserSearch struct {
+	BaseDN                string `json:"baseDN"`
+	Filter                string `json:"filter,omitempty"`
+	Username              string `json:"username"`
+	IDAttr                string `json:"idAttr"`
+	EmailAttr             string `json:"emailAttr"`
+	NameAttr              string `json:"nameAttr,omitempty"`
+	PreferredUsernameAttr string `json:"preferredUsernameAttr,omitempty"`
+}
+
+type dexLDAPGroupSearch struct {
+	BaseDN       string               `json:"baseDN"`
+	Filter       string               `json:"filter,omitempty"`
+	UserMatchers []dexLDAPUserMatcher `json:"userMatchers"`
+	NameAttr     string               `json:"nameAttr"`
+}
+
+type dexLDAPUserMatcher struct {
+	UserAttr  string `json:"userAttr"`
+	GroupAttr string `json:"groupAttr"`
+}
+
+type dexSAMLConfig struct {
+	SSOURL                          string `json:"ssoURL"`
+	CA                              string `json:"ca,omitempty"`
+	CAData                          string `json:"caData,omitempty"`
+	EntityIssuer                    string `json:"entityIssuer,omitempty"`
+	SSOIssuer                       string `json:"ssoIssuer,omitempty"`
+	RedirectURI                     string `json:"redirectURI"`
+	UsernameAttr                    string `json:"usernameAttr,omitempty"`
+	EmailAttr                       string `json:"emailAttr,omitempty"`
+	GroupsAttr                      string `json:"groupsAttr,omitempty"`
+	GroupsDelim                     string `json:"groupsDelim,omitempty"`
+	NameIDPolicyFormat              string `json:"nameIDPolicyFormat,omitempty"`
+	InsecureSkipSignatureValidation bool   `json:"insecureSkipSignatureValidation,omitempty"`
+}
+
+type dexMicrosoftConfig struct {
+	ClientID           string   `json:"clientID"`
+	ClientSecret       string   `json:"clientSecret"`
+	Tenant             string   `json:"tenant,omitempty"`
+	Groups             []string `json:"groups,omitempty"`
+	OnlySecurityGroups bool     `json:"onlySecurityGroups,omitempty"`
+	GroupNameFormat    string   `json:"groupNameFormat,omitempty"`
+}
+
+// newDexConnectorConfig converts the typed configuration of a connector into
+// its generic representation.
+func newDexConnectorConfig(config any) (map[string]any, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	err = json.Unmarshal(b, &m)
+
+	return m, err
+}
+
+// decodeConfig converts the generic configuration of the connector into the
+// given typed configuration.
+func (c *dexConnector) decodeConfig(config any) error {
+	b, err := json.Marshal(c.Config)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, config)
+}
+
+// readDexConfig returns the configuration of Dex, which is empty if it has
+// not been configured yet, along with its connectors.
+func readDexConfig(cm *corev1.ConfigMap) (map[string]any, []dexConnector, error) {
+	config := make(map[string]any)
+
+	if err := yaml.Unmarshal([]byte(cm.Data[dexConfigKey]), &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", dexConfigKey, err)
+	}
+
+	if config == nil {
+		config = make(map[string]any)
+	}
+
+	var connectors []dexConnector
+
+	if raw, ok := config["connectors"]; ok {
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = json.Unmarshal(b, &connectors); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse connectors of %s: %w", dexConfigKey, err)
+		}
+	}
+
+	return config, connectors, nil
+}
+
+func readDexConnector(ctx context.Context, kc kubernetes.Interface, namespace, id string) (*dexConnector, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	_, connectors, err := readDexConfig(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range connectors {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), dexConfigKey+"/"+id)
+}
+
+// modifyDexConnectors applies fn to the connectors of Dex and writes them back,
+// retrying if the ConfigMap has been modified concurrently. Any other settings
+// of Dex are retained.
+func modifyDexConnectors(ctx context.Context, kc kubernetes.Interface, namespace string, fn func([]dexConnector) ([]dexConnector, error)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		config, connectors, err := readDexConfig(cm)
+		if err != nil {
+			return err
+		}
+
+		if connectors, err = fn(connectors); err != nil {
+			return err
+		}
+
+		if len(connectors) > 0 {
+			config["connectors"] = connectors
+		} else {
+			delete(config, "connectors")
+		}
+
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+
+		// The key is removed once it no longer holds any configuration, so
+		// that ArgoCD does not deploy Dex needlessly
+		if len(config) == 0 {
+			delete(cm.Data, dexConfigKey)
+		} else {
+			value, err := yaml.Marshal(config)
+			if err != nil {
+				return err
+			}
+
+			cm.Data[dexConfigKey] = string(value)
+		}
+
+		_, err = kc.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+
+		return err
+	})
+}
+
+func createDexConnector(ctx context.Context, kc kubernetes.Interface, namespace string, connector *dexConnector) error {
+	return modifyDexConnectors(ctx, kc, namespace, func(connectors []dexConnector) ([]dexConnector, error) {
+		for _, c := range connectors {
+			if c.ID == connector.ID {
+				return nil, apierrors.NewAlreadyExists(corev1.Resource("configmaps"), dexConfigKey+"/"+connector.ID)
+			}
+		}
+
+		return append(connectors, *connector), nil
+	})
+}
+
+func updateDexConnector(ctx context.Context, kc kubernetes.Interface, namespace string, connector *dexConnector) error {
+	return modifyDexConnectors(ctx, kc, namespace, func(connectors []dexConnector) ([]dexConnector, error) {
+		for i, c := range connectors {
+			if c.ID == connector.ID {
+				connectors[i] = *connector
+				return connectors, nil
+			}
+		}
+
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), dexConfigKey+"/"+connector.ID)
+	})
+}
+
+func deleteDexConnector(ctx context.Context, kc kubernetes.Interface, namespace, id string) error {
+	return modifyDexConnectors(ctx, kc, namespace, func(connectors []dexConnector) ([]dexConnector, error) {
+		for i, c := range connectors {
+			if c.ID == id {
+				return append(connectors[:i], connectors[i+1:]...), nil
+			}
+		}
+
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), dexConfigKey+"/"+id)
+	})
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDexConnectorLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+				"dex.config": `logger:
+  level: debug
+connectors:
+- type: oidc
+  id: okta
+  name: Okta
+  config:
+    issuer: https://example.okta.com
+    insecureEnableGroups: true
+`,
+			},
+		},
+	)
+
+	if _, err := readDexConnector(ctx, kc, "argocd", "github"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	config, err := newDexConnectorConfig(dexGitHubConfig{
+		ClientID:     "client-id",
+		ClientSecret: "$dex.github.clientSecret",
+		Orgs:         []dexGitHubOrg{{Name: "my-org", Teams: []string{"platform"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	github := &dexConnector{Type: "github", ID: "github", Name: "GitHub", Config: config}
+
+	if err = createDexConnector(ctx, kc, "argocd", github); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = createDexConnector(ctx, kc, "argocd", github); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readDexConnector(ctx, kc, "argocd", "github")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, github, read)
+
+	github.Name = "GitHub Enterprise"
+
+	if err = updateDexConnector(ctx, kc, "argocd", github); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = updateDexConnector(ctx, kc, "argocd", &dexConnector{ID: "ldap"}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Other connectors and settings of Dex are retained
+	assert.Equal(t, `connectors:
+- config:
+    insecureEnableGroups: true
+    issuer: https://example.okta.com
+  id: okta
+  name: Okta
+  type: oidc
+- config:
+    clientID: client-id
+    clientSecret: $dex.github.clientSecret
+    orgs:
+    - name: my-org
+      teams:
+      - platform
+  id: github
+  name: GitHub Enterprise
+  type: github
+logger:
+  level: debug
+`, cm.Data["dex.config"])
+
+	if err = deleteDexConnector(ctx, kc, "argocd", "github"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = deleteDexConnector(ctx, kc, "argocd", "okta"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "logger:\n  level: debug\n", cm.Data["dex.config"])
+	assert.Equal(t, "https://argocd.example.com", cm.Data["url"])
+}
+
+func TestDexConnectorRemovesEmptyConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		},
+	)
+
+	connector := &dexConnector{Type: "saml", ID: "saml", Name: "SAML"}
+
+	if err := createDexConnector(ctx, kc, "argocd", connector); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := deleteDexConnector(ctx, kc, "argocd", "saml"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.NotContains(t, cm.Data, "dex.config")
+}
+
+func TestDexConnectorModel(t *testing.T) {
+	t.Parallel()
+
+	m := &dexConnectorModel{
+		ConnectorID: types.StringValue("ldap"),
+		Name:        types.StringValue("LDAP"),
+		LDAP: &dexLDAPConnectorModel{
+			Host:               types.StringValue("ldap.example.com:636"),
+			InsecureNoSSL:      types.BoolValue(false),
+			InsecureSkipVerify: types.BoolValue(true),
+			StartTLS:           types.BoolValue(false),
+			BindDN:             types.StringValue("uid=serviceaccount,cn=users,dc=example,dc=com"),
+			BindPW:             types.StringValue("$dex.ldap.bindPW"),
+			UserSearch: &dexLDAPUserSearchModel{
+				BaseDN:    types.StringValue("cn=users,dc=example,dc=com"),
+				Username:  types.StringValue("uid"),
+				IDAttr:    types.StringValue("uid"),
+				EmailAttr: types.StringValue("mail"),
+			},
+			GroupSearch: &dexLDAPGroupSearchModel{
+				BaseDN:   types.StringValue("cn=groups,dc=example,dc=com"),
+				NameAttr: types.StringValue("cn"),
+				UserMatchers: []dexLDAPUserMatcherModel{
+					{UserAttr: types.StringValue("DN"), GroupAttr: types.StringValue("member")},
+				},
+			},
+		},
+	}
+
+	c, err := m.toDexConnector()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "ldap", c.Type)
+	assert.Equal(t, map[string]any{
+		"host":               "ldap.example.com:636",
+		"insecureSkipVerify": true,
+		"bindDN":             "uid=serviceaccount,cn=users,dc=example,dc=com",
+		"bindPW":             "$dex.ldap.bindPW",
+		"userSearch": map[string]any{
+			"baseDN":    "cn=users,dc=example,dc=com",
+			"username":  "uid",
+			"idAttr":    "uid",
+			"emailAttr": "mail",
+		},
+		"groupSearch": map[string]any{
+			"baseDN":   "cn=groups,dc=example,dc=com",
+			"nameAttr": "cn",
+			"userMatchers": []any{
+				map[string]any{"userAttr": "DN", "groupAttr": "member"},
+			},
+		},
+	}, c.Config)
+
+	read, err := newDexConnector(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, types.StringValue("ldap"), read.ID)
+	assert.Equal(t, types.StringNull(), read.LDAP.RootCA)
+	assert.Equal(t, types.StringNull(), read.LDAP.UserSearch.Filter)
+	assert.Equal(t, m.LDAP.GroupSearch.UserMatchers, read.LDAP.GroupSearch.UserMatchers)
+	assert.Nil(t, read.GitHub)
+
+	if _, err = newDexConnector(&dexConnector{Type: "oidc", ID: "okta"}); err == nil {
+		t.Error("expected an error for an unsupported connector type")
+	}
+}
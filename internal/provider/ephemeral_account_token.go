@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &accountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &accountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &accountTokenEphemeralResource{}
+
+const accountTokenPrivateStateKey = "token"
+
+func NewAccountTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accountTokenEphemeralResource{}
+}
+
+type accountTokenEphemeralResource struct {
+	si *ServerInterface
+}
+
+type accountTokenEphemeralModel struct {
+	ID            types.String `tfsdk:"id"`
+	Account       types.String `tfsdk:"account"`
+	ExpiresIn     types.String `tfsdk:"expires_in"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	JWT           types.String `tfsdk:"jwt"`
+	IssuedAt      types.String `tfsdk:"issued_at"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+// accountTokenPrivateState holds what is needed to revoke the token once
+// Terraform no longer needs it.
+type accountTokenPrivateState struct {
+	ID      string `json:"id"`
+	Account string `json:"account"`
+}
+
+func (r *accountTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_token"
+}
+
+func (r *accountTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived API token for a [local account](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts) for the duration of a Terraform run, e.g. to configure other providers or `argocd-image-updater`. The account requires the `apiKey` capability. The token is never stored in the plan or state, and is revoked once Terraform no longer needs it unless `revoke_on_close` is disabled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Token identifier.",
+				Computed:    true,
+			},
+			"account": schema.StringAttribute{
+				Description: "Account name. Defaults to the current account. I.e. the account configured on the `provider` block.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "Duration before the token will expire. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`. E.g. `30m`, `12h`. Default: `1h`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.DurationValidator(),
+				},
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether the token is deleted from the account once Terraform no longer needs it. Default: `true`.",
+				Optional:    true,
+			},
+			"jwt": schema.StringAttribute{
+				Description: "The raw JWT.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"issued_at": schema.StringAttribute{
+				Description: "Unix timestamp at which the token was issued.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Unix timestamp upon which the token will expire.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *accountTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *accountTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data accountTokenEphemeralModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountName := data.Account.ValueString()
+
+	if accountName == "" {
+		userInfo, err := r.si.SessionClient.GetUserInfo(ctx, &session.GetUserInfoRequest{})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error("failed to get current account", err)...)
+			return
+		}
+
+		accountName = userInfo.Username
+	}
+
+	expiresIn := time.Hour
+
+	if !data.ExpiresIn.IsNull() {
+		var err error
+
+		expiresIn, err = time.ParseDuration(data.ExpiresIn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Expiration Duration",
+				fmt.Sprintf("token expiration duration for account %s could not be parsed: %s", accountName, err.Error()),
+			)
+
+			return
+		}
+	}
+
+	argocdSync.AccountMutex.Lock()
+	tokenResp, err := r.si.AccountClient.CreateToken(ctx, &account.CreateTokenRequest{
+		Name:      accountName,
+		ExpiresIn: int64(expiresIn.Seconds()),
+	})
+	argocdSync.AccountMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "token for account", accountName, err)...)
+		return
+	}
+
+	token, claims, diags := parseToken("account "+accountName, tokenResp.GetToken())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if claims.ExpiresAt == nil {
+		resp.Diagnostics.AddError(
+			"Missing JWT Expiration Date",
+			fmt.Sprintf("token claims expiration date for account %s is missing", accountName),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(claims.ID)
+	data.Account = types.StringValue(accountName)
+	data.JWT = types.StringValue(token.String())
+	data.IssuedAt = types.StringValue(strconv.FormatInt(claims.IssuedAt.Unix(), 10))
+	data.ExpiresAt = types.StringValue(strconv.FormatInt(claims.ExpiresAt.Unix(), 10))
+
+	tflog.Trace(ctx, fmt.Sprintf("created ephemeral account token %s for account %s", claims.ID, accountName))
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		privateState, err := json.Marshal(accountTokenPrivateState{
+			ID:      claims.ID,
+			Account: accountName,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Private State Encoding Failed",
+				fmt.Sprintf("token %s for account %s could not be tracked for revocation: %s", claims.ID, accountName, err.Error()),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, accountTokenPrivateStateKey, privateState)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *accountTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateState, diags := req.Private.GetKey(ctx, accountTokenPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	// Tokens opened with revoke_on_close disabled are not tracked
+	if resp.Diagnostics.HasError() || privateState == nil {
+		return
+	}
+
+	var token accountTokenPrivateState
+	if err := json.Unmarshal(privateState, &token); err != nil {
+		resp.Diagnostics.AddError(
+			"Private State Decoding Failed",
+			fmt.Sprintf("ephemeral account token could not be revoked: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	argocdSync.AccountMutex.Lock()
+	_, err := r.si.AccountClient.DeleteToken(ctx, &account.DeleteTokenRequest{
+		Name: token.Account,
+		Id:   token.ID,
+	})
+	argocdSync.AccountMutex.Unlock()
+
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("delete", "token for account", token.Account, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked ephemeral account token %s for account %s", token.ID, token.Account))
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDAccountTokenEphemeral(t *testing.T) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for k, v := range testAccProtoV6ProviderFactories {
+		factories[k] = v
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "argocd_account_token" "test" {
+  account    = "test"
+  expires_in = "10m"
+}
+
+provider "echo" {
+  data = ephemeral.argocd_account_token.test
+}
+
+resource "echo" "token" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("account"), knownvalue.StringExact("test")),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("jwt"), knownvalue.StringRegexp(regexp.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`))),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+			{
+				// The account defaults to the one the provider is authenticated as
+				Config: `
+ephemeral "argocd_account_token" "admin" {}
+
+provider "echo" {
+  data = ephemeral.argocd_account_token.admin
+}
+
+resource "echo" "token" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("account"), knownvalue.StringExact("admin")),
+				},
+			},
+		},
+	})
+}
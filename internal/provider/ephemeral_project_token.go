@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &projectTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &projectTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &projectTokenEphemeralResource{}
+
+const projectTokenPrivateStateKey = "token"
+
+func NewProjectTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &projectTokenEphemeralResource{}
+}
+
+type projectTokenEphemeralResource struct {
+	si *ServerInterface
+}
+
+type projectTokenEphemeralModel struct {
+	ID            types.String `tfsdk:"id"`
+	Project       types.String `tfsdk:"project"`
+	Role          types.String `tfsdk:"role"`
+	ExpiresIn     types.String `tfsdk:"expires_in"`
+	Description   types.String `tfsdk:"description"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	JWT           types.String `tfsdk:"jwt"`
+	IssuedAt      types.String `tfsdk:"issued_at"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+// projectTokenPrivateState holds what is needed to revoke the token once
+// Terraform no longer needs it.
+type projectTokenPrivateState struct {
+	ID      string `json:"id"`
+	Project string `json:"project"`
+	Role    string `json:"role"`
+}
+
+func (r *projectTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_token"
+}
+
+func (r *projectTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived JWT for a [project role](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-roles) for the duration of a Terraform run. The token is never stored in the plan or state, and is revoked once Terraform no longer needs it unless `revoke_on_close` is disabled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Token identifier.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The project associated with the token.",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The name of the role in the project associated with the token.",
+				Required:    true,
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "Duration before the token will expire. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`. E.g. `30m`, `12h`. Default: `1h`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.DurationValidator(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the token.",
+				Optional:    true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether the token is deleted from the project role once Terraform no longer needs it. Default: `true`.",
+				Optional:    true,
+			},
+			"jwt": schema.StringAttribute{
+				Description: "The raw JWT.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"issued_at": schema.StringAttribute{
+				Description: "Unix timestamp at which the token was issued.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Unix timestamp upon which the token will expire.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *projectTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *projectTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data projectTokenEphemeralModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	role := data.Role.ValueString()
+
+	expiresIn := time.Hour
+
+	if !data.ExpiresIn.IsNull() {
+		var err error
+
+		expiresIn, err = time.ParseDuration(data.ExpiresIn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Expiration Duration",
+				fmt.Sprintf("token expiration duration for project %s could not be parsed: %s", projectName, err.Error()),
+			)
+
+			return
+		}
+	}
+
+	opts := &project.ProjectTokenCreateRequest{
+		Project:   projectName,
+		Role:      role,
+		ExpiresIn: int64(expiresIn.Seconds()),
+	}
+
+	if !data.Description.IsNull() {
+		opts.Description = data.Description.ValueString()
+	}
+
+	// Get or create project mutex safely
+	projectMutex := argocdSync.GetProjectMutex(projectName)
+	projectMutex.Lock()
+	defer projectMutex.Unlock()
+
+	tokenResp, err := r.si.ProjectClient.CreateToken(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "token for project", projectName, err)...)
+		return
+	}
+
+	token, claims, diags := parseToken("project "+projectName, tokenResp.GetToken())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if claims.ExpiresAt == nil {
+		resp.Diagnostics.AddError(
+			"Missing JWT Expiration Date",
+			fmt.Sprintf("token claims expiration date for project %s is missing", projectName),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(claims.ID)
+	data.JWT = types.StringValue(token.String())
+	data.IssuedAt = types.StringValue(strconv.FormatInt(claims.IssuedAt.Unix(), 10))
+	data.ExpiresAt = types.StringValue(strconv.FormatInt(claims.ExpiresAt.Unix(), 10))
+
+	tflog.Trace(ctx, fmt.Sprintf("created ephemeral project token %s for project %s", claims.ID, projectName))
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		// Tokens which are revoked on close are managed while they are open,
+		// other tokens are pruned by argocd_project
+		if err = recordProjectToken(ctx, r.si, projectName, role, claims.ID, true); err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "token for project", projectName, err)...)
+			return
+		}
+
+		privateState, err := json.Marshal(projectTokenPrivateState{
+			ID:      claims.ID,
+			Project: projectName,
+			Role:    role,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Private State Encoding Failed",
+				fmt.Sprintf("token %s for project %s could not be tracked for revocation: %s", claims.ID, projectName, err.Error()),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectTokenPrivateStateKey, privateState)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *projectTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateState, diags := req.Private.GetKey(ctx, projectTokenPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	// Tokens opened with revoke_on_close disabled are not tracked
+	if resp.Diagnostics.HasError() || privateState == nil {
+		return
+	}
+
+	var token projectTokenPrivateState
+	if err := json.Unmarshal(privateState, &token); err != nil {
+		resp.Diagnostics.AddError(
+			"Private State Decoding Failed",
+			fmt.Sprintf("ephemeral project token could not be revoked: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get or create project mutex safely
+	projectMutex := argocdSync.GetProjectMutex(token.Project)
+	projectMutex.Lock()
+	defer projectMutex.Unlock()
+
+	_, err := r.si.ProjectClient.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{
+		Id:      token.ID,
+		Project: token.Project,
+		Role:    token.Role,
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("delete", "token for project", token.Project, err)...)
+		return
+	}
+
+	err = recordProjectToken(ctx, r.si, token.Project, token.Role, token.ID, false)
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "revocation of token for project", token.Project, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked ephemeral project token %s for project %s", token.ID, token.Project))
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDProjectTokenEphemeral(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-acc-ephemeral")
+
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for k, v := range testAccProtoV6ProviderFactories {
+		factories[k] = v
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectTokenEphemeral(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("project"), knownvalue.StringExact(projectName)),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("jwt"), knownvalue.StringRegexp(regexp.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`))),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectTokenEphemeral(projectName string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "ephemeral" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "ci"
+      policies = ["p, proj:%[1]s:ci, applications, sync, %[1]s/*, allow"]
+    }
+  }
+}
+
+ephemeral "argocd_project_token" "ci" {
+  project    = argocd_project.ephemeral.metadata[0].name
+  role       = "ci"
+  expires_in = "10m"
+}
+
+provider "echo" {
+  data = ephemeral.argocd_project_token.ci
+}
+
+resource "echo" "token" {}
+`, projectName)
+}
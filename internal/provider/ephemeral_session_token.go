@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &sessionTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &sessionTokenEphemeralResource{}
+
+func NewSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionTokenEphemeralResource{}
+}
+
+type sessionTokenEphemeralResource struct {
+	si *ServerInterface
+}
+
+type sessionTokenEphemeralModel struct {
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Token     types.String `tfsdk:"token"`
+	IssuedAt  types.String `tfsdk:"issued_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *sessionTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+func (r *sessionTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exchanges the credentials of a [local user](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts) for a session token for the duration of a Terraform run, " +
+			"e.g. to call ArgoCD API endpoints which are not covered by the provider with the `restapi` provider. Unlike `argocd_account_token`, the account does not require the `apiKey` capability, but the `login` one. " +
+			"The token is never stored in the plan or state. Note that session tokens cannot be revoked through the ArgoCD API, they expire once the session duration configured in ArgoCD (`24h` by default) has elapsed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Session identifier.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Name of the user to authenticate as. Defaults to the username configured on the `provider` block.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the user to authenticate as. Defaults to the password configured on the `provider` block.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The session token, to be sent as a bearer token in the `Authorization` header.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"issued_at": schema.StringAttribute{
+				Description: "Unix timestamp at which the token was issued.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Unix timestamp upon which the token will expire.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *sessionTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *sessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sessionTokenEphemeralModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username, password := data.Username.ValueString(), data.Password.ValueString()
+
+	if data.Username.IsNull() {
+		username = getDefaultString(r.si.config.Username, "ARGOCD_AUTH_USERNAME")
+		password = getDefaultString(r.si.config.Password, "ARGOCD_AUTH_PASSWORD")
+	}
+
+	if username == "" || password == "" {
+		resp.Diagnostics.AddError(
+			"Missing Credentials",
+			"a session token can only be issued if `username` and `password` are configured, either on the ephemeral resource or on the `provider` block",
+		)
+
+		return
+	}
+
+	sessionResp, err := r.si.SessionClient.Create(ctx, &session.SessionCreateRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create session for user %s", username), err)...)
+		return
+	}
+
+	token, claims, diags := parseToken("user "+username, sessionResp.GetToken())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(claims.ID)
+	data.Username = types.StringValue(username)
+	data.Token = types.StringValue(token.String())
+	data.IssuedAt = types.StringValue(strconv.FormatInt(claims.IssuedAt.Unix(), 10))
+	data.ExpiresAt = types.StringNull()
+
+	if claims.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(strconv.FormatInt(claims.ExpiresAt.Unix(), 10))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created session %s for user %s", claims.ID, username))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDSessionTokenEphemeral(t *testing.T) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for k, v := range testAccProtoV6ProviderFactories {
+		factories[k] = v
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The credentials default to the ones configured on the provider
+				Config: `
+ephemeral "argocd_session_token" "admin" {}
+
+provider "echo" {
+  data = ephemeral.argocd_session_token.admin
+}
+
+resource "echo" "token" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("admin")),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`))),
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("issued_at"), knownvalue.NotNull()),
+				},
+			},
+			{
+				// The test account only has the apiKey capability
+				Config: `
+ephemeral "argocd_session_token" "invalid" {
+  username = "test"
+  password = "invalid"
+}
+
+provider "echo" {
+  data = ephemeral.argocd_session_token.invalid
+}
+
+resource "echo" "token" {}
+`,
+				ExpectError: regexp.MustCompile(`failed to create session for user test`),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage the backends of UI extensions, i.e. proxy
+// extensions (see
+// https://argo-cd.readthedocs.io/en/stable/developer-guide/extensions/proxy-extensions/),
+// within the `argocd-cm` ConfigMap. Each extension is stored as YAML under its
+// own `extension.config.<name>` key and written through merge patches, so that
+// any other settings are retained.
+
+const uiExtensionsKey = "extension.config"
+
+func uiExtensionKey(name string) string {
+	return uiExtensionsKey + "." + name
+}
+
+type uiExtensionBackend struct {
+	ConnectionTimeout     string               `json:"connectionTimeout,omitempty"`
+	KeepAlive             string               `json:"keepAlive,omitempty"`
+	IdleConnectionTimeout string               `json:"idleConnectionTimeout,omitempty"`
+	MaxIdleConnections    int64                `json:"maxIdleConnections,omitempty"`
+	Services              []uiExtensionService `json:"services"`
+}
+
+type uiExtensionService struct {
+	URL     string              `json:"url"`
+	Cluster *uiExtensionCluster `json:"cluster,omitempty"`
+	Headers []uiExtensionHeader `json:"headers,omitempty"`
+}
+
+type uiExtensionCluster struct {
+	Name   string `json:"name,omitempty"`
+	Server string `json:"server,omitempty"`
+}
+
+type uiExtensionHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func readUIExtension(ctx context.Context, kc kubernetes.Interface, namespace, name string) (*uiExtensionBackend, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := cm.Data[uiExtensionKey(name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), uiExtensionKey(name))
+	}
+
+	var b uiExtensionBackend
+	if err = yaml.Unmarshal([]byte(value), &b); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// createUIExtension fails if the extension is already configured, either
+// under its own key or within the `extension.config` key, since ArgoCD does
+// not register any extension if their names are not unique.
+func createUIExtension(ctx context.Context, kc kubernetes.Interface, namespace, name string, b *uiExtensionBackend) error {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if _, ok := cm.Data[uiExtensionKey(name)]; ok {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), uiExtensionKey(name))
+	}
+
+	var extensions struct {
+		Extensions []struct {
+			Name string `json:"name"`
+		} `json:"extensions"`
+	}
+
+	if err = yaml.Unmarshal([]byte(cm.Data[uiExtensionsKey]), &extensions); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", uiExtensionsKey, err)
+	}
+
+	for _, e := range extensions.Extensions {
+		if e.Name == name {
+			return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), uiExtensionsKey+"/"+name)
+		}
+	}
+
+	return updateUIExtension(ctx, kc, namespace, name, b)
+}
+
+func updateUIExtension(ctx context.Context, kc kubernetes.Interface, namespace, name string, b *uiExtensionBackend) error {
+	value, err := yaml.Marshal(b)
+	if err != nil {
+		return err
+	}
+
+	patch, err := dataMergePatch(map[string]any{
+		uiExtensionKey(name): string(value),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func deleteUIExtension(ctx context.Context, kc kubernetes.Interface, namespace, name string) error {
+	patch, err := dataMergePatch(map[string]any{
+		uiExtensionKey(name): nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUIExtensionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+				"extension.config": `extensions:
+- name: httpbin
+  backend:
+    services:
+    - url: http://httpbin.org
+`,
+			},
+		},
+	)
+
+	if _, err := readUIExtension(ctx, kc, "argocd", "metrics"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	metrics := &uiExtensionBackend{
+		ConnectionTimeout: "5s",
+		Services: []uiExtensionService{
+			{
+				URL:     "http://argo-rollouts-metrics.argo-rollouts:8080",
+				Headers: []uiExtensionHeader{{Name: "Authorization", Value: "Bearer $extension.metrics.token"}},
+			},
+		},
+	}
+
+	if err := createUIExtension(ctx, kc, "argocd", "metrics", metrics); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createUIExtension(ctx, kc, "argocd", "metrics", metrics); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	// Extensions configured within the extension.config key are not overwritten
+	if err := createUIExtension(ctx, kc, "argocd", "httpbin", metrics); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readUIExtension(ctx, kc, "argocd", "metrics")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, metrics, read)
+
+	metrics.ConnectionTimeout = ""
+	metrics.Services = append(metrics.Services, uiExtensionService{
+		URL:     "http://argo-rollouts-metrics.argo-rollouts:8080",
+		Cluster: &uiExtensionCluster{Name: "in-cluster"},
+	})
+
+	if err = updateUIExtension(ctx, kc, "argocd", "metrics", metrics); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err = readUIExtension(ctx, kc, "argocd", "metrics")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, metrics, read)
+
+	if err = deleteUIExtension(ctx, kc, "argocd", "metrics"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.NotContains(t, cm.Data, "extension.config.metrics")
+	assert.Contains(t, cm.Data, "extension.config")
+	assert.Equal(t, "https://argocd.example.com", cm.Data["url"])
+}
+
+func TestUIExtensionModel(t *testing.T) {
+	t.Parallel()
+
+	m := newUIExtension("metrics", &uiExtensionBackend{
+		MaxIdleConnections: 10,
+		Services: []uiExtensionService{
+			{
+				URL:     "http://metrics.example.com",
+				Cluster: &uiExtensionCluster{Server: "https://kubernetes.default.svc"},
+			},
+		},
+	})
+
+	assert.Equal(t, types.StringValue("metrics"), m.ID)
+	assert.Equal(t, types.StringNull(), m.ConnectionTimeout)
+	assert.Equal(t, types.Int64Value(10), m.MaxIdleConnections)
+	assert.Equal(t, types.StringNull(), m.Services[0].Cluster.Name)
+	assert.Equal(t, types.StringValue("https://kubernetes.default.svc"), m.Services[0].Cluster.Server)
+	assert.Nil(t, m.Services[0].Headers)
+
+	b := m.toUIExtensionBackend()
+
+	assert.Equal(t, int64(10), b.MaxIdleConnections)
+	assert.Equal(t, &uiExtensionCluster{Server: "https://kubernetes.default.svc"}, b.Services[0].Cluster)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &rbacPolicyFunction{}
+
+func NewRBACPolicyFunction() function.Function {
+	return &rbacPolicyFunction{}
+}
+
+// rbacPolicyFunction defines the function implementation.
+type rbacPolicyFunction struct{}
+
+func (f *rbacPolicyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rbac_policy"
+}
+
+func (f *rbacPolicyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders an ArgoCD RBAC policy line",
+		MarkdownDescription: "Renders an [RBAC policy](https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/) line of the form `p, <role>, <resource>, <action>, <object>, <effect>`, " +
+			"as used by the `policy_csv` attribute of `argocd_rbac` and the `policies` of `argocd_project` roles. The rendered line is validated when planning, " +
+			"so that e.g. misplaced commas or misspelled effects are reported before they reach ArgoCD.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "role",
+				MarkdownDescription: "Subject the policy applies to, usually a role such as `role:org-admin`, but users and SSO groups can be used as well.",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action which is allowed or denied, e.g. `get`, `sync` or `*` for any action.",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "Resource type the action applies to, e.g. `applications`, `clusters` or `repositories`.",
+			},
+			function.StringParameter{
+				Name:                "object",
+				MarkdownDescription: "Object the action applies to, e.g. `<project>/<application>` for applications. Patterns are matched according to the `match_mode` of `argocd_rbac`.",
+			},
+			function.StringParameter{
+				Name:                "effect",
+				MarkdownDescription: "Either `allow` or `deny`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *rbacPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var role, action, resource, object, effect string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &role, &action, &resource, &object, &effect))
+	if resp.Error != nil {
+		return
+	}
+
+	policy := strings.Join([]string{"p", role, resource, action, object, effect}, ", ")
+
+	if err := validators.ValidateRBACPolicy(policy); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, policy))
+}
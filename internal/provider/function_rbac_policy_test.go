@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDRBACPolicyFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "policy" {
+  value = provider::argocd::rbac_policy("role:org-admin", "sync", "applications", "my-project/*", "allow")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("policy", knownvalue.StringExact("p, role:org-admin, applications, sync, my-project/*, allow")),
+				},
+			},
+			{
+				Config: `
+output "policy" {
+  value = provider::argocd::rbac_policy("role:org-admin", "sync", "applications", "my-project/*", "allowed")
+}
+`,
+				ExpectError: regexp.MustCompile("effect must be either 'allow' or 'deny'"),
+			},
+			{
+				Config: `
+output "policy" {
+  value = provider::argocd::rbac_policy("role:org-admin", "get, sync", "applications", "my-project/*", "allow")
+}
+`,
+				ExpectError: regexp.MustCompile("policies must be of the form"),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &rbacValidateFunction{}
+
+func NewRBACValidateFunction() function.Function {
+	return &rbacValidateFunction{}
+}
+
+// rbacValidateFunction defines the function implementation.
+type rbacValidateFunction struct{}
+
+func (f *rbacValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rbac_validate"
+}
+
+func (f *rbacValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validates an ArgoCD RBAC policy in CSV format",
+		MarkdownDescription: "Validates an [RBAC policy](https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/) in CSV format and returns it unchanged, " +
+			"so that policies which are assembled from several sources, e.g. with `templatefile()` or `join()`, are validated when planning. " +
+			"Each line must either be empty, a comment starting with `#`, a policy of the form `p, <role>, <resource>, <action>, <object>, <effect>` " +
+			"or a role assignment of the form `g, <subject>, <role>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "csv",
+				MarkdownDescription: "RBAC policy in CSV format.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *rbacValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var csv string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &csv))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validators.ValidateRBACPolicy(csv); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, csv))
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDRBACValidateFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "policy" {
+  value = provider::argocd::rbac_validate(join("\n", [
+    "# Platform team",
+    "p, role:org-admin, applications, *, */*, allow",
+    "g, my-org:platform, role:org-admin",
+  ]))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("policy", knownvalue.StringExact("# Platform team\np, role:org-admin, applications, *, */*, allow\ng, my-org:platform, role:org-admin")),
+				},
+			},
+			{
+				Config: `
+output "policy" {
+  value = provider::argocd::rbac_validate(join("\n", [
+    "p, role:org-admin, applications, *, */*, allow",
+    "g, my-org:platform",
+  ]))
+}
+`,
+				ExpectError: regexp.MustCompile("line 2: role assignments must be of the form"),
+			},
+		},
+	})
+}
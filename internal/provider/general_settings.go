@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The functions below manage general settings within the `argocd-cm`
+// ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/argocd-cm-yaml/).
+// Settings are owned per key: only the keys which are configured are written,
+// through merge patches, so that the remaining ones may be managed by other
+// tools, e.g. the Helm chart of ArgoCD.
+
+const (
+	settingsURLKey                   = "url"
+	settingsAnonymousUsersEnabledKey = "users.anonymous.enabled"
+	settingsStatusBadgeEnabledKey    = "statusbadge.enabled"
+	settingsReconciliationTimeoutKey = "timeout.reconciliation"
+	settingsInstanceLabelKeyKey      = "application.instanceLabelKey"
+	settingsExecEnabledKey           = "exec.enabled"
+	settingsAdminEnabledKey          = "admin.enabled"
+)
+
+// generalSettingsKeys lists the keys of the ConfigMap which may be managed.
+var generalSettingsKeys = []string{
+	settingsURLKey,
+	settingsAnonymousUsersEnabledKey,
+	settingsStatusBadgeEnabledKey,
+	settingsReconciliationTimeoutKey,
+	settingsInstanceLabelKeyKey,
+	settingsExecEnabledKey,
+	settingsAdminEnabledKey,
+}
+
+// readGeneralSettings returns the values of the general settings which are
+// present in the ConfigMap.
+func readGeneralSettings(ctx context.Context, kc kubernetes.Interface, namespace string) (map[string]string, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]string)
+
+	for _, k := range generalSettingsKeys {
+		if v, ok := cm.Data[k]; ok {
+			settings[k] = v
+		}
+	}
+
+	return settings, nil
+}
+
+// updateGeneralSettings writes the given settings and removes the previously
+// managed ones which are no longer part of them, so that ArgoCD falls back to
+// its defaults.
+func updateGeneralSettings(ctx context.Context, kc kubernetes.Interface, namespace string, settings, previous map[string]string) error {
+	data := make(map[string]any, len(settings)+len(previous))
+
+	for k := range previous {
+		data[k] = nil
+	}
+
+	for k, v := range settings {
+		data[k] = v
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	patch, err := dataMergePatch(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGeneralSettingsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url":                    "https://argocd.example.com",
+				"exec.enabled":           "false",
+				"accounts.ci":            "apiKey",
+				"timeout.reconciliation": "180s",
+			},
+		},
+	)
+
+	settings, err := readGeneralSettings(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"url":                    "https://argocd.example.com",
+		"exec.enabled":           "false",
+		"timeout.reconciliation": "180s",
+	}, settings)
+
+	// Only the managed keys are written
+	managed := map[string]string{
+		"exec.enabled":        "true",
+		"statusbadge.enabled": "true",
+	}
+
+	if err = updateGeneralSettings(ctx, kc, "argocd", managed, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	updated := map[string]string{
+		"exec.enabled": "true",
+	}
+
+	if err = updateGeneralSettings(ctx, kc, "argocd", updated, managed); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"url":                    "https://argocd.example.com",
+		"exec.enabled":           "true",
+		"accounts.ci":            "apiKey",
+		"timeout.reconciliation": "180s",
+	}, cm.Data)
+
+	if err = updateGeneralSettings(ctx, kc, "argocd", nil, updated); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.NotContains(t, cm.Data, "exec.enabled")
+	assert.Len(t, cm.Data, 3)
+}
+
+func TestSettingsModelUpdateFromGeneralSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := map[string]string{
+		"url":                     "https://argocd.example.com",
+		"users.anonymous.enabled": "false",
+		"exec.enabled":            "true",
+	}
+
+	// Only the managed settings are refreshed
+	m := settingsModel{
+		ExecEnabled:      types.BoolValue(false),
+		InstanceLabelKey: types.StringValue("argocd.argoproj.io/instance"),
+	}
+
+	assert.False(t, m.updateFromGeneralSettings(settings, false).HasError())
+	assert.Equal(t, types.StringValue(common.ArgoCDConfigMapName), m.ID)
+	assert.Equal(t, types.BoolValue(true), m.ExecEnabled)
+	assert.Equal(t, types.StringNull(), m.InstanceLabelKey)
+	assert.Equal(t, types.StringNull(), m.URL)
+	assert.Equal(t, types.BoolNull(), m.AnonymousUsersEnabled)
+
+	// All settings present are taken over when importing
+	m = settingsModel{}
+
+	assert.True(t, m.isEmpty())
+	assert.False(t, m.updateFromGeneralSettings(settings, true).HasError())
+	assert.Equal(t, types.StringValue("https://argocd.example.com"), m.URL)
+	assert.Equal(t, types.BoolValue(false), m.AnonymousUsersEnabled)
+	assert.Equal(t, types.BoolValue(true), m.ExecEnabled)
+	assert.Equal(t, types.BoolNull(), m.AdminEnabled)
+	assert.Equal(t, settings, m.toGeneralSettings())
+
+	settings["admin.enabled"] = "maybe"
+
+	assert.True(t, m.updateFromGeneralSettings(settings, true).HasError())
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Enabled      types.Bool     `tfsdk:"enabled"`
+	Capabilities []types.String `tfsdk:"capabilities"`
+}
+
+func accountSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Account identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the local account, e.g. `ci` or `image-updater`. The built-in `admin` account cannot be managed.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must only contain alphanumeric characters, `-` and `_`"),
+				stringvalidator.NoneOf(common.ArgoCDAdminUsername),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the account is enabled. Disabled accounts can neither log in nor use their tokens.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"capabilities": schema.SetAttribute{
+			MarkdownDescription: "Capabilities of the account. `login` allows logging in through the UI and CLI, `apiKey` allows generating API tokens, e.g. with `argocd_account_token`.",
+			Required:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf("login", "apiKey")),
+			},
+		},
+	}
+}
+
+func (m *accountModel) toLocalAccount() *localAccount {
+	return &localAccount{
+		Name:         m.Name.ValueString(),
+		Enabled:      m.Enabled.ValueBool(),
+		Capabilities: pie.Sort(pie.Map(m.Capabilities, types.String.ValueString)),
+	}
+}
+
+func newAccount(a *localAccount) *accountModel {
+	return &accountModel{
+		ID:           types.StringValue(a.Name),
+		Name:         types.StringValue(a.Name),
+		Enabled:      types.BoolValue(a.Enabled),
+		Capabilities: pie.Map(a.Capabilities, types.StringValue),
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountDataSourceModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Name         types.String                  `tfsdk:"name"`
+	Enabled      types.Bool                    `tfsdk:"enabled"`
+	Capabilities []types.String                `tfsdk:"capabilities"`
+	Tokens       []accountTokenDataSourceModel `tfsdk:"tokens"`
+}
+
+type accountTokenDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssuedAt  types.String `tfsdk:"issued_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// accountDataSourceSchemaAttributes returns the attributes of an account
+// known to ArgoCD. The name is only configurable when it is used to look up
+// the account.
+func accountDataSourceSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Account identifier",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the account.",
+			Required:            lookup,
+			Computed:            !lookup,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the account is enabled.",
+			Computed:            true,
+		},
+		"capabilities": schema.SetAttribute{
+			MarkdownDescription: "Capabilities of the account, i.e. `login` and/or `apiKey`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"tokens": schema.ListNestedAttribute{
+			MarkdownDescription: "Tokens issued for the account, sorted by issue date. The tokens themselves are not returned by ArgoCD.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Token identifier.",
+						Computed:            true,
+					},
+					"issued_at": schema.StringAttribute{
+						MarkdownDescription: "Unix timestamp at which the token was issued.",
+						Computed:            true,
+					},
+					"expires_at": schema.StringAttribute{
+						MarkdownDescription: "Unix timestamp upon which the token will expire, if it expires at all.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAccountDataSourceModel(a *account.Account) accountDataSourceModel {
+	m := accountDataSourceModel{
+		ID:           types.StringValue(a.Name),
+		Name:         types.StringValue(a.Name),
+		Enabled:      types.BoolValue(a.Enabled),
+		Capabilities: pie.Map(a.Capabilities, types.StringValue),
+		Tokens:       make([]accountTokenDataSourceModel, 0, len(a.Tokens)),
+	}
+
+	slices.SortFunc(a.Tokens, func(a, b *account.Token) int {
+		return cmp.Compare(a.IssuedAt, b.IssuedAt)
+	})
+
+	for _, t := range a.Tokens {
+		token := accountTokenDataSourceModel{
+			ID:        types.StringValue(t.Id),
+			IssuedAt:  types.StringValue(strconv.FormatInt(t.IssuedAt, 10)),
+			ExpiresAt: types.StringNull(),
+		}
+
+		if t.ExpiresAt > 0 {
+			token.ExpiresAt = types.StringValue(strconv.FormatInt(t.ExpiresAt, 10))
+		}
+
+		m.Tokens = append(m.Tokens, token)
+	}
+
+	return m
+}
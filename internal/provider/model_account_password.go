@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountPasswordModel struct {
+	ID                types.String `tfsdk:"id"`
+	Account           types.String `tfsdk:"account"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.String `tfsdk:"password_wo_version"`
+	CurrentPasswordWO types.String `tfsdk:"current_password_wo"`
+}
+
+func accountPasswordSchemaAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Account password identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"account": schema.StringAttribute{
+			MarkdownDescription: "Name of the local account whose password is set, e.g. as managed by `argocd_account`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "Password of the account. Must match the password pattern configured in ArgoCD.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+			},
+		},
+		"current_password_wo": schema.StringAttribute{
+			MarkdownDescription: "Current password of the local user the provider is authenticated as, which ArgoCD requires to change the password of any account. Defaults to the `password` the provider is configured with. Not required when the provider is authenticated through SSO. Never stored in the plan or state. Requires Terraform 1.11 or later.",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+		},
+	}
+
+	addWriteOnlyVariant(attributes, "password")
+
+	return attributes
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type clusterModel struct {
+	ID               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Server           types.String            `tfsdk:"server"`
+	Project          types.String            `tfsdk:"project"`
+	Shard            types.Int64             `tfsdk:"shard"`
+	Namespaces       []types.String          `tfsdk:"namespaces"`
+	ClusterResources types.Bool              `tfsdk:"cluster_resources"`
+	Labels           map[string]types.String `tfsdk:"labels"`
+	Annotations      map[string]types.String `tfsdk:"annotations"`
+	Info             clusterInfo             `tfsdk:"info"`
+}
+
+// clusterSchemaAttributes returns the attributes of a cluster registered in
+// ArgoCD. The name and server are only optional when they are used to look up
+// the cluster.
+func clusterSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Cluster identifier, of the form `<server>/<name>`, or `<server>` when the name of the cluster is the same as its server.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the cluster.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"server": schema.StringAttribute{
+			MarkdownDescription: "API server URL of the Kubernetes cluster.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Project the cluster is scoped to, if any.",
+			Computed:            true,
+		},
+		"shard": schema.Int64Attribute{
+			MarkdownDescription: "Shard of the application controller managing the cluster, null if it is calculated on the fly by the application controller.",
+			Computed:            true,
+		},
+		"namespaces": schema.ListAttribute{
+			MarkdownDescription: "Namespaces which are accessible in the cluster. Empty if all namespaces are accessible.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"cluster_resources": schema.BoolAttribute{
+			MarkdownDescription: "Whether cluster level resources are managed when the cluster is restricted to `namespaces`.",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"annotations": schema.MapAttribute{
+			MarkdownDescription: "Annotations of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"info": clusterInfoSchemaAttribute(),
+	}
+}
+
+func newCluster(c v1alpha1.Cluster) clusterModel {
+	m := clusterModel{
+		ID:               types.StringValue(c.Server),
+		Name:             types.StringValue(c.Name),
+		Server:           types.StringValue(c.Server),
+		Project:          types.StringValue(c.Project),
+		Shard:            utils.OptionalInt64(c.Shard),
+		Namespaces:       pie.Map(c.Namespaces, types.StringValue),
+		ClusterResources: types.BoolValue(c.ClusterResources),
+		Labels:           utils.MapMap(c.Labels, types.StringValue),
+		Annotations:      utils.MapMap(c.Annotations, types.StringValue),
+		Info:             newClusterInfo(c.Info),
+	}
+
+	if c.Name != "" && c.Name != c.Server {
+		m.ID = types.StringValue(c.Server + "/" + c.Name)
+	}
+
+	return m
+}
+
+type clusterInfo struct {
+	ServerVersion     types.String           `tfsdk:"server_version"`
+	ApplicationsCount types.Int64            `tfsdk:"applications_count"`
+	APIVersions       []types.String         `tfsdk:"api_versions"`
+	ConnectionState   clusterConnectionState `tfsdk:"connection_state"`
+	CacheInfo         clusterCacheInfo       `tfsdk:"cache_info"`
+}
+
+type clusterConnectionState struct {
+	Status     types.String `tfsdk:"status"`
+	Message    types.String `tfsdk:"message"`
+	ModifiedAt types.String `tfsdk:"modified_at"`
+}
+
+type clusterCacheInfo struct {
+	ResourcesCount    types.Int64  `tfsdk:"resources_count"`
+	APIsCount         types.Int64  `tfsdk:"apis_count"`
+	LastCacheSyncTime types.String `tfsdk:"last_cache_sync_time"`
+}
+
+func clusterInfoSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Information about the cluster cache and state, as last observed by ArgoCD.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"server_version": schema.StringAttribute{
+				MarkdownDescription: "Kubernetes version of the cluster.",
+				Computed:            true,
+			},
+			"applications_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of applications managed by ArgoCD on the cluster.",
+				Computed:            true,
+			},
+			"api_versions": schema.ListAttribute{
+				MarkdownDescription: "API versions supported by the cluster.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"connection_state": schema.SingleNestedAttribute{
+				MarkdownDescription: "Information about the connection to the cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Current status of the connection, one of `Successful`, `Failed` or `Unknown`.",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Human readable information about the connection status.",
+						Computed:            true,
+					},
+					"modified_at": schema.StringAttribute{
+						MarkdownDescription: "When the connection status was determined.",
+						Computed:            true,
+					},
+				},
+			},
+			"cache_info": schema.SingleNestedAttribute{
+				MarkdownDescription: "Information about the cluster cache of the application controller.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"resources_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of observed Kubernetes resources.",
+						Computed:            true,
+					},
+					"apis_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of observed Kubernetes APIs.",
+						Computed:            true,
+					},
+					"last_cache_sync_time": schema.StringAttribute{
+						MarkdownDescription: "When the cache was last synchronized.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newClusterInfo(ci v1alpha1.ClusterInfo) clusterInfo {
+	return clusterInfo{
+		ServerVersion:     types.StringValue(ci.ServerVersion),
+		ApplicationsCount: types.Int64Value(ci.ApplicationsCount),
+		APIVersions:       pie.Map(ci.APIVersions, types.StringValue),
+		ConnectionState: clusterConnectionState{
+			Status:     types.StringValue(string(ci.ConnectionState.Status)),
+			Message:    types.StringValue(ci.ConnectionState.Message),
+			ModifiedAt: utils.OptionalTimeString(ci.ConnectionState.ModifiedAt),
+		},
+		CacheInfo: clusterCacheInfo{
+			ResourcesCount:    types.Int64Value(ci.CacheInfo.ResourcesCount),
+			APIsCount:         types.Int64Value(ci.CacheInfo.APIsCount),
+			LastCacheSyncTime: utils.OptionalTimeString(ci.CacheInfo.LastCacheSyncTime),
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type compareOptionsModel struct {
+	ID                                 types.String `tfsdk:"id"`
+	IgnoreAggregatedRoles              types.Bool   `tfsdk:"ignore_aggregated_roles"`
+	IgnoreResourceStatusField          types.String `tfsdk:"ignore_resource_status_field"`
+	IgnoreDifferencesOnResourceUpdates types.Bool   `tfsdk:"ignore_differences_on_resource_updates"`
+	IgnoreResourceUpdatesEnabled       types.Bool   `tfsdk:"ignore_resource_updates_enabled"`
+}
+
+func compareOptionsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Compare options identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ignore_aggregated_roles": schema.BoolAttribute{
+			MarkdownDescription: "Whether the rules of [aggregated cluster roles](https://kubernetes.io/docs/reference/access-authn-authz/rbac/#aggregated-clusterroles), which are populated by the control plane, are ignored when diffing. Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ignore_resource_status_field": schema.StringAttribute{
+			MarkdownDescription: "Resources whose `status` field is ignored when diffing, one of `crd` (custom resources only), `all` or `none`. Default: `all`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(settings.IgnoreResourceStatusInAll)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(settings.IgnoreResourceStatusInCRD),
+					string(settings.IgnoreResourceStatusInAll),
+					string(settings.IgnoreResourceStatusInNone),
+				),
+			},
+		},
+		"ignore_differences_on_resource_updates": schema.BoolAttribute{
+			MarkdownDescription: "Whether the fields ignored when diffing, e.g. through the `ignore_differences` of applications, are also ignored when determining whether an update of a resource triggers a refresh of its application. Default: `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"ignore_resource_updates_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether updates of resources which only modify fields configured through `argocd_resource_ignore_updates_customization` are ignored, i.e. do not trigger a refresh of their application. Default: `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+func (m *compareOptionsModel) toCompareOptions() *compareOptions {
+	return &compareOptions{
+		IgnoreAggregatedRoles:              m.IgnoreAggregatedRoles.ValueBool(),
+		IgnoreResourceStatusField:          m.IgnoreResourceStatusField.ValueString(),
+		IgnoreDifferencesOnResourceUpdates: m.IgnoreDifferencesOnResourceUpdates.ValueBool(),
+		IgnoreResourceUpdatesEnabled:       m.IgnoreResourceUpdatesEnabled.ValueBool(),
+	}
+}
+
+func newCompareOptions(o *compareOptions) *compareOptionsModel {
+	return &compareOptionsModel{
+		ID:                                 types.StringValue(common.ArgoCDConfigMapName),
+		IgnoreAggregatedRoles:              types.BoolValue(o.IgnoreAggregatedRoles),
+		IgnoreResourceStatusField:          types.StringValue(o.IgnoreResourceStatusField),
+		IgnoreDifferencesOnResourceUpdates: types.BoolValue(o.IgnoreDifferencesOnResourceUpdates),
+		IgnoreResourceUpdatesEnabled:       types.BoolValue(o.IgnoreResourceUpdatesEnabled),
+	}
+}
@@ -0,0 +1,690 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dexConnectorModel struct {
+	ID          types.String                `tfsdk:"id"`
+	ConnectorID types.String                `tfsdk:"connector_id"`
+	Name        types.String                `tfsdk:"name"`
+	GitHub      *dexGitHubConnectorModel    `tfsdk:"github"`
+	LDAP        *dexLDAPConnectorModel      `tfsdk:"ldap"`
+	SAML        *dexSAMLConnectorModel      `tfsdk:"saml"`
+	Microsoft   *dexMicrosoftConnectorModel `tfsdk:"microsoft"`
+}
+
+type dexGitHubConnectorModel struct {
+	ClientID      types.String        `tfsdk:"client_id"`
+	ClientSecret  types.String        `tfsdk:"client_secret"`
+	Orgs          []dexGitHubOrgModel `tfsdk:"orgs"`
+	HostName      types.String        `tfsdk:"host_name"`
+	RootCA        types.String        `tfsdk:"root_ca"`
+	LoadAllGroups types.Bool          `tfsdk:"load_all_groups"`
+	TeamNameField types.String        `tfsdk:"team_name_field"`
+	UseLoginAsID  types.Bool          `tfsdk:"use_login_as_id"`
+}
+
+type dexGitHubOrgModel struct {
+	Name  types.String   `tfsdk:"name"`
+	Teams []types.String `tfsdk:"teams"`
+}
+
+type dexLDAPConnectorModel struct {
+	Host               types.String             `tfsdk:"host"`
+	InsecureNoSSL      types.Bool               `tfsdk:"insecure_no_ssl"`
+	InsecureSkipVerify types.Bool               `tfsdk:"insecure_skip_verify"`
+	StartTLS           types.Bool               `tfsdk:"start_tls"`
+	RootCA             types.String             `tfsdk:"root_ca"`
+	BindDN             types.String             `tfsdk:"bind_dn"`
+	BindPW             types.String             `tfsdk:"bind_pw"`
+	UsernamePrompt     types.String             `tfsdk:"username_prompt"`
+	UserSearch         *dexLDAPUserSearchModel  `tfsdk:"user_search"`
+	GroupSearch        *dexLDAPGroupSearchModel `tfsdk:"group_search"`
+}
+
+type dexLDAPUserSearchModel struct {
+	BaseDN                types.String `tfsdk:"base_dn"`
+	Filter                types.String `tfsdk:"filter"`
+	Username              types.String `tfsdk:"username"`
+	IDAttr                types.String `tfsdk:"id_attr"`
+	EmailAttr             types.String `tfsdk:"email_attr"`
+	NameAttr              types.String `tfsdk:"name_attr"`
+	PreferredUsernameAttr types.String `tfsdk:"preferred_username_attr"`
+}
+
+type dexLDAPGroupSearchModel struct {
+	BaseDN       types.String              `tfsdk:"base_dn"`
+	Filter       types.String              `tfsdk:"filter"`
+	UserMatchers []dexLDAPUserMatcherModel `tfsdk:"user_matchers"`
+	NameAttr     types.String              `tfsdk:"name_attr"`
+}
+
+type dexLDAPUserMatcherModel struct {
+	UserAttr  types.String `tfsdk:"user_attr"`
+	GroupAttr types.String `tfsdk:"group_attr"`
+}
+
+type dexSAMLConnectorModel struct {
+	SSOURL                          types.String `tfsdk:"sso_url"`
+	CA                              types.String `tfsdk:"ca"`
+	CAData                          types.String `tfsdk:"ca_data"`
+	EntityIssuer                    types.String `tfsdk:"entity_issuer"`
+	SSOIssuer                       types.String `tfsdk:"sso_issuer"`
+	RedirectURI                     types.String `tfsdk:"redirect_uri"`
+	UsernameAttr                    types.String `tfsdk:"username_attr"`
+	EmailAttr                       types.String `tfsdk:"email_attr"`
+	GroupsAttr                      types.String `tfsdk:"groups_attr"`
+	GroupsDelim                     types.String `tfsdk:"groups_delim"`
+	NameIDPolicyFormat              types.String `tfsdk:"name_id_policy_format"`
+	InsecureSkipSignatureValidation types.Bool   `tfsdk:"insecure_skip_signature_validation"`
+}
+
+type dexMicrosoftConnectorModel struct {
+	ClientID           types.String   `tfsdk:"client_id"`
+	ClientSecret       types.String   `tfsdk:"client_secret"`
+	Tenant             types.String   `tfsdk:"tenant"`
+	Groups             []types.String `tfsdk:"groups"`
+	OnlySecurityGroups types.Bool     `tfsdk:"only_security_groups"`
+	GroupNameFormat    types.String   `tfsdk:"group_name_format"`
+}
+
+// dexConnectorTypes lists the connector types which can be managed, which are
+// also the names of their attributes.
+var dexConnectorTypes = []string{"github", "ldap", "saml", "microsoft"}
+
+const dexSecretDescription = " Since the value is stored in the `argocd-cm` ConfigMap, it should reference a key of a Secret, e.g. `$dex.github.clientSecret`, " +
+	"see [sensitive data and SSO client secrets](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#sensitive-data-and-sso-client-secrets)."
+
+func dexConnectorSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Dex connector identifier, i.e. its `connector_id`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"connector_id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the connector within Dex, e.g. `github`. Users logged in through the connector are identified by it, hence changing it forces a new resource.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[-._a-zA-Z0-9]+$`), "must consist of alphanumeric characters, '-', '_' or '.'"),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the connector displayed on the login page, e.g. `GitHub`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"github": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration of a [GitHub connector](https://dexidp.io/docs/connectors/github/).",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"client_id": schema.StringAttribute{
+					MarkdownDescription: "Client ID of the GitHub OAuth app.",
+					Required:            true,
+				},
+				"client_secret": schema.StringAttribute{
+					MarkdownDescription: "Client secret of the GitHub OAuth app." + dexSecretDescription,
+					Required:            true,
+					Sensitive:           true,
+				},
+				"orgs": schema.ListNestedAttribute{
+					MarkdownDescription: "Organizations users must be a member of to log in. Users of any organization may log in if unset.",
+					Optional:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Name of the organization.",
+								Required:            true,
+							},
+							"teams": schema.ListAttribute{
+								MarkdownDescription: "Teams of the organization users must be a member of. Members of any team may log in if unset.",
+								Optional:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+				},
+				"host_name": schema.StringAttribute{
+					MarkdownDescription: "Host name of a GitHub Enterprise instance, e.g. `git.example.com`.",
+					Optional:            true,
+				},
+				"root_ca": schema.StringAttribute{
+					MarkdownDescription: "Path to the CA certificate of the GitHub Enterprise instance within the Dex container.",
+					Optional:            true,
+				},
+				"load_all_groups": schema.BoolAttribute{
+					MarkdownDescription: "Whether all teams of the user are returned as groups, rather than the ones of `orgs` only. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"team_name_field": schema.StringAttribute{
+					MarkdownDescription: "Field of the teams used as group names, one of `name`, `slug` or `both`. Dex defaults to `name` if unset.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("name", "slug", "both"),
+					},
+				},
+				"use_login_as_id": schema.BoolAttribute{
+					MarkdownDescription: "Whether the login of the user is used as its identifier, rather than its numeric ID. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
+		"ldap": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration of an [LDAP connector](https://dexidp.io/docs/connectors/ldap/).",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Host and optional port of the LDAP server, e.g. `ldap.example.com:636`.",
+					Required:            true,
+				},
+				"insecure_no_ssl": schema.BoolAttribute{
+					MarkdownDescription: "Whether to connect without TLS. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"insecure_skip_verify": schema.BoolAttribute{
+					MarkdownDescription: "Whether to skip the verification of the certificate of the LDAP server. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"start_tls": schema.BoolAttribute{
+					MarkdownDescription: "Whether to connect without TLS and upgrade the connection with StartTLS. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"root_ca": schema.StringAttribute{
+					MarkdownDescription: "Path to the CA certificate of the LDAP server within the Dex container.",
+					Optional:            true,
+				},
+				"bind_dn": schema.StringAttribute{
+					MarkdownDescription: "DN of the account used to search users and groups, e.g. `uid=serviceaccount,cn=users,dc=example,dc=com`. Anonymous binds are used if unset.",
+					Optional:            true,
+				},
+				"bind_pw": schema.StringAttribute{
+					MarkdownDescription: "Password of the account used to search users and groups." + dexSecretDescription,
+					Optional:            true,
+					Sensitive:           true,
+				},
+				"username_prompt": schema.StringAttribute{
+					MarkdownDescription: "Label of the username field on the login page, e.g. `Email Address`.",
+					Optional:            true,
+				},
+				"user_search": schema.SingleNestedAttribute{
+					MarkdownDescription: "Search mapping a username to a user entry.",
+					Required:            true,
+					Attributes: map[string]schema.Attribute{
+						"base_dn": schema.StringAttribute{
+							MarkdownDescription: "DN to start the search from, e.g. `cn=users,dc=example,dc=com`.",
+							Required:            true,
+						},
+						"filter": schema.StringAttribute{
+							MarkdownDescription: "Filter applied to the search, e.g. `(objectClass=person)`.",
+							Optional:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Attribute matched against the username, e.g. `uid`.",
+							Required:            true,
+						},
+						"id_attr": schema.StringAttribute{
+							MarkdownDescription: "Attribute used as the identifier of the user, e.g. `uid`.",
+							Required:            true,
+						},
+						"email_attr": schema.StringAttribute{
+							MarkdownDescription: "Attribute used as the email of the user, e.g. `mail`.",
+							Required:            true,
+						},
+						"name_attr": schema.StringAttribute{
+							MarkdownDescription: "Attribute used as the display name of the user, e.g. `cn`.",
+							Optional:            true,
+						},
+						"preferred_username_attr": schema.StringAttribute{
+							MarkdownDescription: "Attribute used as the preferred username of the user, e.g. `uid`.",
+							Optional:            true,
+						},
+					},
+				},
+				"group_search": schema.SingleNestedAttribute{
+					MarkdownDescription: "Search mapping a user entry to the groups of the user. Users are not a member of any group if unset.",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"base_dn": schema.StringAttribute{
+							MarkdownDescription: "DN to start the search from, e.g. `cn=groups,dc=example,dc=com`.",
+							Required:            true,
+						},
+						"filter": schema.StringAttribute{
+							MarkdownDescription: "Filter applied to the search, e.g. `(objectClass=group)`.",
+							Optional:            true,
+						},
+						"user_matchers": schema.ListNestedAttribute{
+							MarkdownDescription: "Pairs of attributes of the user and group entries, of which one must match for the user to be a member of the group.",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user_attr": schema.StringAttribute{
+										MarkdownDescription: "Attribute of the user entry, e.g. `DN`.",
+										Required:            true,
+									},
+									"group_attr": schema.StringAttribute{
+										MarkdownDescription: "Attribute of the group entry, e.g. `member`.",
+										Required:            true,
+									},
+								},
+							},
+						},
+						"name_attr": schema.StringAttribute{
+							MarkdownDescription: "Attribute used as the name of the group, e.g. `cn`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+		"saml": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration of a [SAML 2.0 connector](https://dexidp.io/docs/connectors/saml/).",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"sso_url": schema.StringAttribute{
+					MarkdownDescription: "URL of the SSO endpoint of the identity provider.",
+					Required:            true,
+				},
+				"ca": schema.StringAttribute{
+					MarkdownDescription: "Path to the CA certificate signing the responses of the identity provider within the Dex container.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ca_data")),
+					},
+				},
+				"ca_data": schema.StringAttribute{
+					MarkdownDescription: "Base64 encoded CA certificate signing the responses of the identity provider.",
+					Optional:            true,
+				},
+				"entity_issuer": schema.StringAttribute{
+					MarkdownDescription: "Issuer sent in the authentication requests, e.g. `https://argocd.example.com/api/dex/callback`.",
+					Optional:            true,
+				},
+				"sso_issuer": schema.StringAttribute{
+					MarkdownDescription: "Issuer the responses of the identity provider are expected to have.",
+					Optional:            true,
+				},
+				"redirect_uri": schema.StringAttribute{
+					MarkdownDescription: "Callback URL of Dex, i.e. `<url>/api/dex/callback` where `<url>` is the external URL of ArgoCD.",
+					Required:            true,
+				},
+				"username_attr": schema.StringAttribute{
+					MarkdownDescription: "Attribute of the assertions used as the username, e.g. `name`.",
+					Optional:            true,
+				},
+				"email_attr": schema.StringAttribute{
+					MarkdownDescription: "Attribute of the assertions used as the email, e.g. `email`.",
+					Optional:            true,
+				},
+				"groups_attr": schema.StringAttribute{
+					MarkdownDescription: "Attribute of the assertions used as the groups, e.g. `groups`.",
+					Optional:            true,
+				},
+				"groups_delim": schema.StringAttribute{
+					MarkdownDescription: "Delimiter of the groups, if they are returned as a single value, e.g. `,`.",
+					Optional:            true,
+				},
+				"name_id_policy_format": schema.StringAttribute{
+					MarkdownDescription: "Format of the NameID requested from the identity provider, e.g. `persistent`.",
+					Optional:            true,
+				},
+				"insecure_skip_signature_validation": schema.BoolAttribute{
+					MarkdownDescription: "Whether to skip the validation of the signatures of the responses. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
+		"microsoft": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration of a [Microsoft connector](https://dexidp.io/docs/connectors/microsoft/).",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"client_id": schema.StringAttribute{
+					MarkdownDescription: "Client ID of the app registration.",
+					Required:            true,
+				},
+				"client_secret": schema.StringAttribute{
+					MarkdownDescription: "Client secret of the app registration." + dexSecretDescription,
+					Required:            true,
+					Sensitive:           true,
+				},
+				"tenant": schema.StringAttribute{
+					MarkdownDescription: "Tenant users must belong to, either its ID or domain, e.g. `example.onmicrosoft.com`. Dex defaults to `common`, i.e. any tenant, if unset.",
+					Optional:            true,
+				},
+				"groups": schema.ListAttribute{
+					MarkdownDescription: "Groups users must be a member of to log in. Groups are only returned if `tenant` is set.",
+					Optional:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tenant")),
+					},
+				},
+				"only_security_groups": schema.BoolAttribute{
+					MarkdownDescription: "Whether only security groups are returned. Default: `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"group_name_format": schema.StringAttribute{
+					MarkdownDescription: "Format of the groups, either `name` or `id`. Dex defaults to `name` if unset.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("name", "id"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// optionalString returns a null value for empty strings, which Dex treats as
+// unset.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
+
+func (m *dexConnectorModel) toDexConnector() (*dexConnector, error) {
+	c := &dexConnector{
+		ID:   m.ConnectorID.ValueString(),
+		Name: m.Name.ValueString(),
+	}
+
+	var config any
+
+	switch {
+	case m.GitHub != nil:
+		c.Type = "github"
+		config = m.GitHub.toConfig()
+	case m.LDAP != nil:
+		c.Type = "ldap"
+		config = m.LDAP.toConfig()
+	case m.SAML != nil:
+		c.Type = "saml"
+		config = m.SAML.toConfig()
+	case m.Microsoft != nil:
+		c.Type = "microsoft"
+		config = m.Microsoft.toConfig()
+	default:
+		return nil, fmt.Errorf("exactly one of %v must be configured", dexConnectorTypes)
+	}
+
+	var err error
+	c.Config, err = newDexConnectorConfig(config)
+
+	return c, err
+}
+
+func newDexConnector(c *dexConnector) (*dexConnectorModel, error) {
+	m := &dexConnectorModel{
+		ID:          types.StringValue(c.ID),
+		ConnectorID: types.StringValue(c.ID),
+		Name:        types.StringValue(c.Name),
+	}
+
+	switch c.Type {
+	case "github":
+		var config dexGitHubConfig
+		if err := c.decodeConfig(&config); err != nil {
+			return nil, err
+		}
+
+		m.GitHub = newDexGitHubConnector(config)
+	case "ldap":
+		var config dexLDAPConfig
+		if err := c.decodeConfig(&config); err != nil {
+			return nil, err
+		}
+
+		m.LDAP = newDexLDAPConnector(config)
+	case "saml":
+		var config dexSAMLConfig
+		if err := c.decodeConfig(&config); err != nil {
+			return nil, err
+		}
+
+		m.SAML = newDexSAMLConnector(config)
+	case "microsoft":
+		var config dexMicrosoftConfig
+		if err := c.decodeConfig(&config); err != nil {
+			return nil, err
+		}
+
+		m.Microsoft = newDexMicrosoftConnector(config)
+	default:
+		return nil, fmt.Errorf("connector %s is of type %q, which is not supported, supported types are %v", c.ID, c.Type, dexConnectorTypes)
+	}
+
+	return m, nil
+}
+
+func (m *dexGitHubConnectorModel) toConfig() dexGitHubConfig {
+	c := dexGitHubConfig{
+		ClientID:      m.ClientID.ValueString(),
+		ClientSecret:  m.ClientSecret.ValueString(),
+		HostName:      m.HostName.ValueString(),
+		RootCA:        m.RootCA.ValueString(),
+		LoadAllGroups: m.LoadAllGroups.ValueBool(),
+		TeamNameField: m.TeamNameField.ValueString(),
+		UseLoginAsID:  m.UseLoginAsID.ValueBool(),
+	}
+
+	for _, o := range m.Orgs {
+		c.Orgs = append(c.Orgs, dexGitHubOrg{
+			Name:  o.Name.ValueString(),
+			Teams: pie.Map(o.Teams, types.String.ValueString),
+		})
+	}
+
+	return c
+}
+
+func newDexGitHubConnector(c dexGitHubConfig) *dexGitHubConnectorModel {
+	m := &dexGitHubConnectorModel{
+		ClientID:      types.StringValue(c.ClientID),
+		ClientSecret:  types.StringValue(c.ClientSecret),
+		HostName:      optionalString(c.HostName),
+		RootCA:        optionalString(c.RootCA),
+		LoadAllGroups: types.BoolValue(c.LoadAllGroups),
+		TeamNameField: optionalString(c.TeamNameField),
+		UseLoginAsID:  types.BoolValue(c.UseLoginAsID),
+	}
+
+	for _, o := range c.Orgs {
+		org := dexGitHubOrgModel{Name: types.StringValue(o.Name)}
+
+		if len(o.Teams) > 0 {
+			org.Teams = pie.Map(o.Teams, types.StringValue)
+		}
+
+		m.Orgs = append(m.Orgs, org)
+	}
+
+	return m
+}
+
+func (m *dexLDAPConnectorModel) toConfig() dexLDAPConfig {
+	c := dexLDAPConfig{
+		Host:               m.Host.ValueString(),
+		InsecureNoSSL:      m.InsecureNoSSL.ValueBool(),
+		InsecureSkipVerify: m.InsecureSkipVerify.ValueBool(),
+		StartTLS:           m.StartTLS.ValueBool(),
+		RootCA:             m.RootCA.ValueString(),
+		BindDN:             m.BindDN.ValueString(),
+		BindPW:             m.BindPW.ValueString(),
+		UsernamePrompt:     m.UsernamePrompt.ValueString(),
+	}
+
+	if m.UserSearch != nil {
+		c.UserSearch = dexLDAPUserSearch{
+			BaseDN:                m.UserSearch.BaseDN.ValueString(),
+			Filter:                m.UserSearch.Filter.ValueString(),
+			Username:              m.UserSearch.Username.ValueString(),
+			IDAttr:                m.UserSearch.IDAttr.ValueString(),
+			EmailAttr:             m.UserSearch.EmailAttr.ValueString(),
+			NameAttr:              m.UserSearch.NameAttr.ValueString(),
+			PreferredUsernameAttr: m.UserSearch.PreferredUsernameAttr.ValueString(),
+		}
+	}
+
+	if m.GroupSearch != nil {
+		c.GroupSearch = &dexLDAPGroupSearch{
+			BaseDN:   m.GroupSearch.BaseDN.ValueString(),
+			Filter:   m.GroupSearch.Filter.ValueString(),
+			NameAttr: m.GroupSearch.NameAttr.ValueString(),
+		}
+
+		for _, um := range m.GroupSearch.UserMatchers {
+			c.GroupSearch.UserMatchers = append(c.GroupSearch.UserMatchers, dexLDAPUserMatcher{
+				UserAttr:  um.UserAttr.ValueString(),
+				GroupAttr: um.GroupAttr.ValueString(),
+			})
+		}
+	}
+
+	return c
+}
+
+func newDexLDAPConnector(c dexLDAPConfig) *dexLDAPConnectorModel {
+	m := &dexLDAPConnectorModel{
+		Host:               types.StringValue(c.Host),
+		InsecureNoSSL:      types.BoolValue(c.InsecureNoSSL),
+		InsecureSkipVerify: types.BoolValue(c.InsecureSkipVerify),
+		StartTLS:           types.BoolValue(c.StartTLS),
+		RootCA:             optionalString(c.RootCA),
+		BindDN:             optionalString(c.BindDN),
+		BindPW:             optionalString(c.BindPW),
+		UsernamePrompt:     optionalString(c.UsernamePrompt),
+		UserSearch: &dexLDAPUserSearchModel{
+			BaseDN:                types.StringValue(c.UserSearch.BaseDN),
+			Filter:                optionalString(c.UserSearch.Filter),
+			Username:              types.StringValue(c.UserSearch.Username),
+			IDAttr:                types.StringValue(c.UserSearch.IDAttr),
+			EmailAttr:             types.StringValue(c.UserSearch.EmailAttr),
+			NameAttr:              optionalString(c.UserSearch.NameAttr),
+			PreferredUsernameAttr: optionalString(c.UserSearch.PreferredUsernameAttr),
+		},
+	}
+
+	if c.GroupSearch != nil {
+		m.GroupSearch = &dexLDAPGroupSearchModel{
+			BaseDN:   types.StringValue(c.GroupSearch.BaseDN),
+			Filter:   optionalString(c.GroupSearch.Filter),
+			NameAttr: types.StringValue(c.GroupSearch.NameAttr),
+		}
+
+		for _, um := range c.GroupSearch.UserMatchers {
+			m.GroupSearch.UserMatchers = append(m.GroupSearch.UserMatchers, dexLDAPUserMatcherModel{
+				UserAttr:  types.StringValue(um.UserAttr),
+				GroupAttr: types.StringValue(um.GroupAttr),
+			})
+		}
+	}
+
+	return m
+}
+
+func (m *dexSAMLConnectorModel) toConfig() dexSAMLConfig {
+	return dexSAMLConfig{
+		SSOURL:                          m.SSOURL.ValueString(),
+		CA:                              m.CA.ValueString(),
+		CAData:                          m.CAData.ValueString(),
+		EntityIssuer:                    m.EntityIssuer.ValueString(),
+		SSOIssuer:                       m.SSOIssuer.ValueString(),
+		RedirectURI:                     m.RedirectURI.ValueString(),
+		UsernameAttr:                    m.UsernameAttr.ValueString(),
+		EmailAttr:                       m.EmailAttr.ValueString(),
+		GroupsAttr:                      m.GroupsAttr.ValueString(),
+		GroupsDelim:                     m.GroupsDelim.ValueString(),
+		NameIDPolicyFormat:              m.NameIDPolicyFormat.ValueString(),
+		InsecureSkipSignatureValidation: m.InsecureSkipSignatureValidation.ValueBool(),
+	}
+}
+
+func newDexSAMLConnector(c dexSAMLConfig) *dexSAMLConnectorModel {
+	return &dexSAMLConnectorModel{
+		SSOURL:                          types.StringValue(c.SSOURL),
+		CA:                              optionalString(c.CA),
+		CAData:                          optionalString(c.CAData),
+		EntityIssuer:                    optionalString(c.EntityIssuer),
+		SSOIssuer:                       optionalString(c.SSOIssuer),
+		RedirectURI:                     types.StringValue(c.RedirectURI),
+		UsernameAttr:                    optionalString(c.UsernameAttr),
+		EmailAttr:                       optionalString(c.EmailAttr),
+		GroupsAttr:                      optionalString(c.GroupsAttr),
+		GroupsDelim:                     optionalString(c.GroupsDelim),
+		NameIDPolicyFormat:              optionalString(c.NameIDPolicyFormat),
+		InsecureSkipSignatureValidation: types.BoolValue(c.InsecureSkipSignatureValidation),
+	}
+}
+
+func (m *dexMicrosoftConnectorModel) toConfig() dexMicrosoftConfig {
+	return dexMicrosoftConfig{
+		ClientID:           m.ClientID.ValueString(),
+		ClientSecret:       m.ClientSecret.ValueString(),
+		Tenant:             m.Tenant.ValueString(),
+		Groups:             pie.Map(m.Groups, types.String.ValueString),
+		OnlySecurityGroups: m.OnlySecurityGroups.ValueBool(),
+		GroupNameFormat:    m.GroupNameFormat.ValueString(),
+	}
+}
+
+func newDexMicrosoftConnector(c dexMicrosoftConfig) *dexMicrosoftConnectorModel {
+	m := &dexMicrosoftConnectorModel{
+		ClientID:           types.StringValue(c.ClientID),
+		ClientSecret:       types.StringValue(c.ClientSecret),
+		Tenant:             optionalString(c.Tenant),
+		OnlySecurityGroups: types.BoolValue(c.OnlySecurityGroups),
+		GroupNameFormat:    optionalString(c.GroupNameFormat),
+	}
+
+	if len(c.Groups) > 0 {
+		m.Groups = pie.Map(c.Groups, types.StringValue)
+	}
+
+	return m
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var gpgKeyIDRegex = regexp.MustCompile(`^[0-9A-Fa-f]{16}$`)
+
+type gpgKeyDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	KeyID       types.String `tfsdk:"key_id"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Owner       types.String `tfsdk:"owner"`
+	SubType     types.String `tfsdk:"sub_type"`
+	Trust       types.String `tfsdk:"trust"`
+	PublicKey   types.String `tfsdk:"public_key"`
+}
+
+// gpgKeyDataSourceSchemaAttributes returns the attributes of a key in the
+// GnuPG keyring of ArgoCD. The key ID is only configurable when it is used to
+// look up the key.
+func gpgKeyDataSourceSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	var keyIDValidators []validator.String
+	if lookup {
+		keyIDValidators = append(keyIDValidators, stringvalidator.RegexMatches(gpgKeyIDRegex, "must consist of 16 hexadecimal digits"))
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "GPG key identifier",
+			Computed:            true,
+		},
+		"key_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the key, i.e. the last 16 hexadecimal digits of its fingerprint, e.g. `4AEE18F83AFDEB23`, as used in the `signature_keys` of projects.",
+			Required:            lookup,
+			Computed:            !lookup,
+			Validators:          keyIDValidators,
+		},
+		"fingerprint": schema.StringAttribute{
+			MarkdownDescription: "Fingerprint of the key.",
+			Computed:            true,
+		},
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "Owner of the key, e.g. a name and e-mail address.",
+			Computed:            true,
+		},
+		"sub_type": schema.StringAttribute{
+			MarkdownDescription: "Sub type of the key, e.g. `rsa4096`.",
+			Computed:            true,
+		},
+		"trust": schema.StringAttribute{
+			MarkdownDescription: "Level of trust assigned to the key.",
+			Computed:            true,
+		},
+		"public_key": schema.StringAttribute{
+			MarkdownDescription: "Raw key data of the key, in ASCII-armored format.",
+			Computed:            true,
+		},
+	}
+}
+
+func newGPGKeyDataSourceModel(k *v1alpha1.GnuPGPublicKey) gpgKeyDataSourceModel {
+	return gpgKeyDataSourceModel{
+		ID:          types.StringValue(k.KeyID),
+		KeyID:       types.StringValue(k.KeyID),
+		Fingerprint: types.StringValue(k.Fingerprint),
+		Owner:       types.StringValue(k.Owner),
+		SubType:     types.StringValue(k.SubType),
+		Trust:       types.StringValue(k.Trust),
+		PublicKey:   types.StringValue(k.KeyData),
+	}
+}
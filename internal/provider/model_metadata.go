@@ -141,6 +141,11 @@ func objectMetaSchemaListBlock(objectName string) schema.Block {
 						validators.MetadataLabels(),
 					},
 				},
+				"finalizers": schema.ListAttribute{
+					MarkdownDescription: fmt.Sprintf("Finalizers of the %s. Only the finalizers declared here are tracked, finalizers added by other controllers are preserved on update and are not reported as drift. Finalizers are not tracked after import, since it is unknown which of them are declared. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/", objectName),
+					Optional:            true,
+					ElementType:         types.StringType,
+				},
 				"generation": schema.Int64Attribute{
 					MarkdownDescription: "A sequence number representing a specific generation of the desired state.",
 					Computed:            true,
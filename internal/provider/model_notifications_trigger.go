@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type notificationsTriggerModel struct {
+	ID         types.String                         `tfsdk:"id"`
+	Name       types.String                         `tfsdk:"name"`
+	Conditions []notificationsTriggerConditionModel `tfsdk:"conditions"`
+}
+
+type notificationsTriggerConditionModel struct {
+	When        types.String   `tfsdk:"when"`
+	Send        []types.String `tfsdk:"send"`
+	OncePer     types.String   `tfsdk:"once_per"`
+	Description types.String   `tfsdk:"description"`
+}
+
+func notificationsTriggerSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Notification trigger identifier, i.e. its name.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the trigger, e.g. `on-sync-succeeded`. Applications subscribe to the trigger through annotations of the form `notifications.argoproj.io/subscribe.<name>.<service>`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[-._a-zA-Z0-9]+$`), "must consist of alphanumeric characters, '-', '_' or '.'"),
+			},
+		},
+		"conditions": schema.ListNestedAttribute{
+			MarkdownDescription: "Conditions of the trigger. A notification is sent using the templates of each condition which evaluates to `true`.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"when": schema.StringAttribute{
+						MarkdownDescription: "[Expression](https://expr-lang.org/docs/language-definition) evaluated against the application, e.g. `app.status.operationState.phase in ['Succeeded']`.",
+						Required:            true,
+						Validators: []validator.String{
+							validators.NotificationsExpression(),
+						},
+					},
+					"send": schema.ListAttribute{
+						MarkdownDescription: "Names of the templates used to render the notification, e.g. `app-sync-succeeded`. The templates must be declared in the `argocd-notifications-cm` ConfigMap.",
+						Required:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+					"once_per": schema.StringAttribute{
+						MarkdownDescription: "Expression evaluated against the application, so that the notification is only sent once per distinct value, e.g. `app.status.sync.revision`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NotificationsExpression(),
+						},
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the condition.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *notificationsTriggerModel) toConditions() []triggers.Condition {
+	conditions := make([]triggers.Condition, 0, len(m.Conditions))
+
+	for _, c := range m.Conditions {
+		conditions = append(conditions, triggers.Condition{
+			When:        c.When.ValueString(),
+			Send:        pie.Map(c.Send, types.String.ValueString),
+			OncePer:     c.OncePer.ValueString(),
+			Description: c.Description.ValueString(),
+		})
+	}
+
+	return conditions
+}
+
+func newNotificationsTrigger(name string, conditions []triggers.Condition) *notificationsTriggerModel {
+	m := &notificationsTriggerModel{
+		ID:         types.StringValue(name),
+		Name:       types.StringValue(name),
+		Conditions: make([]notificationsTriggerConditionModel, 0, len(conditions)),
+	}
+
+	for _, c := range conditions {
+		condition := notificationsTriggerConditionModel{
+			When:        types.StringValue(c.When),
+			Send:        pie.Map(c.Send, types.StringValue),
+			OncePer:     types.StringNull(),
+			Description: types.StringNull(),
+		}
+
+		if c.OncePer != "" {
+			condition.OncePer = types.StringValue(c.OncePer)
+		}
+
+		if c.Description != "" {
+			condition.Description = types.StringValue(c.Description)
+		}
+
+		m.Conditions = append(m.Conditions, condition)
+	}
+
+	return m
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type oidcConfigModel struct {
+	ID                       types.String              `tfsdk:"id"`
+	Name                     types.String              `tfsdk:"name"`
+	Issuer                   types.String              `tfsdk:"issuer"`
+	ClientID                 types.String              `tfsdk:"client_id"`
+	ClientSecret             *oidcClientSecretModel    `tfsdk:"client_secret"`
+	CLIClientID              types.String              `tfsdk:"cli_client_id"`
+	RequestedScopes          []types.String            `tfsdk:"requested_scopes"`
+	RequestedIDTokenClaims   map[string]oidcClaimModel `tfsdk:"requested_id_token_claims"`
+	LogoutURL                types.String              `tfsdk:"logout_url"`
+	RootCA                   types.String              `tfsdk:"root_ca"`
+	EnablePKCEAuthentication types.Bool                `tfsdk:"enable_pkce_authentication"`
+}
+
+type oidcClientSecretModel struct {
+	Name types.String `tfsdk:"name"`
+	Key  types.String `tfsdk:"key"`
+}
+
+type oidcClaimModel struct {
+	Essential types.Bool     `tfsdk:"essential"`
+	Value     types.String   `tfsdk:"value"`
+	Values    []types.String `tfsdk:"values"`
+}
+
+var oidcURLRegex = regexp.MustCompile(`^https?://[^\s]+$`)
+
+func oidcConfigSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "OIDC configuration identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the OIDC provider displayed on the login button, e.g. `Okta`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"issuer": schema.StringAttribute{
+			MarkdownDescription: "Issuer URL of the OIDC provider, e.g. `https://example.okta.com`. ArgoCD discovers the endpoints of the provider from `<issuer>/.well-known/openid-configuration`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(oidcURLRegex, "must be an HTTP(S) URL"),
+			},
+		},
+		"client_id": schema.StringAttribute{
+			MarkdownDescription: "Client ID of ArgoCD registered with the OIDC provider.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"client_secret": schema.SingleNestedAttribute{
+			MarkdownDescription: "Reference to the Secret key holding the client secret of ArgoCD, so that the secret itself is neither stored in the ConfigMap nor in the Terraform state. " +
+				"Secrets other than `argocd-secret` must be labeled with `app.kubernetes.io/part-of: argocd`, see [sensitive data and SSO client secrets](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#sensitive-data-and-sso-client-secrets). " +
+				"Public clients, e.g. when using PKCE, do not require a client secret.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the Secret in the namespace of ArgoCD. Defaults to `" + common.ArgoCDSecretName + "`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(common.ArgoCDSecretName),
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`), "must be a valid Secret name"),
+					},
+				},
+				"key": schema.StringAttribute{
+					MarkdownDescription: "Key of the Secret holding the client secret, e.g. `oidc.clientSecret`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[-._a-zA-Z0-9]+$`), "must consist of alphanumeric characters, '-', '_' or '.'"),
+					},
+				},
+			},
+		},
+		"cli_client_id": schema.StringAttribute{
+			MarkdownDescription: "Client ID used by the ArgoCD CLI, if it differs from `client_id`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"requested_scopes": schema.ListAttribute{
+			MarkdownDescription: "Scopes requested from the OIDC provider, e.g. `[\"openid\", \"profile\", \"email\", \"groups\"]`. ArgoCD defaults to `[\"openid\", \"profile\", \"email\"]` if unset.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"requested_id_token_claims": schema.MapNestedAttribute{
+			MarkdownDescription: "Claims requested to be included in the ID token, keyed by claim name, e.g. `groups`.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"essential": schema.BoolAttribute{
+						MarkdownDescription: "Whether the claim is essential for the authorization of the user. Default: `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Value the claim is requested to have.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("values")),
+						},
+					},
+					"values": schema.ListAttribute{
+						MarkdownDescription: "Values the claim is requested to have one of.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"logout_url": schema.StringAttribute{
+			MarkdownDescription: "URL users are redirected to when logging out, to end their session with the OIDC provider. `{{token}}` and `{{logoutRedirectURL}}` are substituted with the ID token and the URL of ArgoCD.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(oidcURLRegex, "must be an HTTP(S) URL"),
+			},
+		},
+		"root_ca": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded certificate of the CA which issued the certificate of the OIDC provider, if it is not trusted by default.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`-----BEGIN CERTIFICATE-----`), "must be a PEM encoded certificate"),
+			},
+		},
+		"enable_pkce_authentication": schema.BoolAttribute{
+			MarkdownDescription: "Whether the UI authenticates using [PKCE](https://oauth.net/2/pkce/). Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (m *oidcConfigModel) toOIDCConfig() *oidcConfig {
+	c := &oidcConfig{
+		Name:                     m.Name.ValueString(),
+		Issuer:                   m.Issuer.ValueString(),
+		ClientID:                 m.ClientID.ValueString(),
+		CLIClientID:              m.CLIClientID.ValueString(),
+		RequestedScopes:          pie.Map(m.RequestedScopes, types.String.ValueString),
+		LogoutURL:                m.LogoutURL.ValueString(),
+		RootCA:                   m.RootCA.ValueString(),
+		EnablePKCEAuthentication: m.EnablePKCEAuthentication.ValueBool(),
+	}
+
+	if m.ClientSecret != nil {
+		c.ClientSecret = oidcSecretReference(m.ClientSecret.Name.ValueString(), m.ClientSecret.Key.ValueString())
+	}
+
+	if len(m.RequestedIDTokenClaims) > 0 {
+		c.RequestedIDTokenClaims = make(map[string]*oidcClaim, len(m.RequestedIDTokenClaims))
+
+		for name, claim := range m.RequestedIDTokenClaims {
+			c.RequestedIDTokenClaims[name] = &oidcClaim{
+				Essential: claim.Essential.ValueBool(),
+				Value:     claim.Value.ValueString(),
+				Values:    pie.Map(claim.Values, types.String.ValueString),
+			}
+		}
+	}
+
+	return c
+}
+
+func newOIDCConfig(c *oidcConfig) *oidcConfigModel {
+	m := &oidcConfigModel{
+		ID:                       types.StringValue(common.ArgoCDConfigMapName),
+		Name:                     types.StringValue(c.Name),
+		Issuer:                   types.StringValue(c.Issuer),
+		ClientID:                 types.StringValue(c.ClientID),
+		CLIClientID:              types.StringNull(),
+		LogoutURL:                types.StringNull(),
+		RootCA:                   types.StringNull(),
+		EnablePKCEAuthentication: types.BoolValue(c.EnablePKCEAuthentication),
+	}
+
+	if c.CLIClientID != "" {
+		m.CLIClientID = types.StringValue(c.CLIClientID)
+	}
+
+	if c.LogoutURL != "" {
+		m.LogoutURL = types.StringValue(c.LogoutURL)
+	}
+
+	if c.RootCA != "" {
+		m.RootCA = types.StringValue(c.RootCA)
+	}
+
+	// Client secrets which are stored in plain text are not tracked, so that
+	// they are replaced by a reference
+	if name, key, err := parseOIDCSecretReference(c.ClientSecret); err == nil {
+		m.ClientSecret = &oidcClientSecretModel{
+			Name: types.StringValue(name),
+			Key:  types.StringValue(key),
+		}
+	}
+
+	if len(c.RequestedScopes) > 0 {
+		m.RequestedScopes = pie.Map(c.RequestedScopes, types.StringValue)
+	}
+
+	if len(c.RequestedIDTokenClaims) > 0 {
+		m.RequestedIDTokenClaims = make(map[string]oidcClaimModel, len(c.RequestedIDTokenClaims))
+
+		for name, claim := range c.RequestedIDTokenClaims {
+			if claim == nil {
+				claim = &oidcClaim{}
+			}
+
+			cm := oidcClaimModel{
+				Essential: types.BoolValue(claim.Essential),
+				Value:     types.StringNull(),
+			}
+
+			if claim.Value != "" {
+				cm.Value = types.StringValue(claim.Value)
+			}
+
+			if len(claim.Values) > 0 {
+				cm.Values = pie.Map(claim.Values, types.StringValue)
+			}
+
+			m.RequestedIDTokenClaims[name] = cm
+		}
+	}
+
+	return m
+}
@@ -1,35 +1,72 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/utils"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type projectModel struct {
-	ID       types.String       `tfsdk:"id"`
-	Metadata []objectMeta       `tfsdk:"metadata"`
-	Spec     []projectSpecModel `tfsdk:"spec"`
+	ID                       types.String       `tfsdk:"id"`
+	GlobalProjects           types.List         `tfsdk:"global_projects"`
+	EffectiveSpec            types.Object       `tfsdk:"effective_spec"`
+	ResolvedSourceNamespaces types.List         `tfsdk:"resolved_source_namespaces"`
+	DeletionPolicy           types.String       `tfsdk:"deletion_policy"`
+	PreserveUnmanagedRoles   types.Bool         `tfsdk:"preserve_unmanaged_roles"`
+	VerifySignatureKeys      types.Bool         `tfsdk:"verify_signature_keys"`
+	ReportEvents             types.Bool         `tfsdk:"report_events"`
+	AdoptExisting            types.Bool         `tfsdk:"adopt_existing"`
+	PruneUnmanagedTokens     types.Bool         `tfsdk:"prune_unmanaged_tokens"`
+	UnmanagedTokens          types.List         `tfsdk:"unmanaged_tokens"`
+	YAML                     types.String       `tfsdk:"yaml"`
+	Metadata                 []projectMetadata  `tfsdk:"metadata"`
+	Spec                     []projectSpecModel `tfsdk:"spec"`
+}
+
+type projectMetadata struct {
+	objectMeta
+	Finalizers []types.String `tfsdk:"finalizers"`
 }
 
 type projectSpecModel struct {
-	ClusterResourceBlacklist   []groupKindModel                 `tfsdk:"cluster_resource_blacklist"`
-	ClusterResourceWhitelist   []groupKindModel                 `tfsdk:"cluster_resource_whitelist"`
-	Description                types.String                     `tfsdk:"description"`
-	Destination                []destinationModel               `tfsdk:"destination"`
-	DestinationServiceAccount  []destinationServiceAccountModel `tfsdk:"destination_service_account"`
-	NamespaceResourceBlacklist []groupKindModel                 `tfsdk:"namespace_resource_blacklist"`
-	NamespaceResourceWhitelist []groupKindModel                 `tfsdk:"namespace_resource_whitelist"`
-	OrphanedResources          []orphanedResourcesModel         `tfsdk:"orphaned_resources"`
-	Role                       []projectRoleModel               `tfsdk:"role"`
-	SourceRepos                []types.String                   `tfsdk:"source_repos"`
-	SourceNamespaces           []types.String                   `tfsdk:"source_namespaces"`
-	SignatureKeys              []types.String                   `tfsdk:"signature_keys"`
-	SyncWindow                 []syncWindowModel                `tfsdk:"sync_window"`
+	ClusterResourceBlacklist        []groupKindModel                 `tfsdk:"cluster_resource_blacklist"`
+	ClusterResourceWhitelist        []groupKindModel                 `tfsdk:"cluster_resource_whitelist"`
+	Description                     types.String                     `tfsdk:"description"`
+	Destination                     []destinationModel               `tfsdk:"destination"`
+	DestinationServiceAccount       []destinationServiceAccountModel `tfsdk:"destination_service_account"`
+	NamespaceResourceBlacklist      []groupKindModel                 `tfsdk:"namespace_resource_blacklist"`
+	NamespaceResourceWhitelist      []groupKindModel                 `tfsdk:"namespace_resource_whitelist"`
+	OrphanedResources               []orphanedResourcesModel         `tfsdk:"orphaned_resources"`
+	PermitOnlyProjectScopedClusters types.Bool                       `tfsdk:"permit_only_project_scoped_clusters"`
+	Role                            []projectRoleModel               `tfsdk:"role"`
+	SourceRepos                     []types.String                   `tfsdk:"source_repos"`
+	SourceNamespaces                []types.String                   `tfsdk:"source_namespaces"`
+	SignatureKeys                   []types.String                   `tfsdk:"signature_keys"`
+	SyncWindow                      []syncWindowModel                `tfsdk:"sync_window"`
+}
+
+type projectEffectiveSpecModel struct {
+	ClusterResourceBlacklist   []groupKindModel   `tfsdk:"cluster_resource_blacklist"`
+	ClusterResourceWhitelist   []groupKindModel   `tfsdk:"cluster_resource_whitelist"`
+	Destination                []destinationModel `tfsdk:"destination"`
+	NamespaceResourceBlacklist []groupKindModel   `tfsdk:"namespace_resource_blacklist"`
+	NamespaceResourceWhitelist []groupKindModel   `tfsdk:"namespace_resource_whitelist"`
+	SourceRepos                []types.String     `tfsdk:"source_repos"`
+	SyncWindow                 []syncWindowModel  `tfsdk:"sync_window"`
 }
 
 type groupKindModel struct {
@@ -77,6 +114,7 @@ type jwtTokenModel struct {
 type syncWindowModel struct {
 	Applications   []types.String `tfsdk:"applications"`
 	Clusters       []types.String `tfsdk:"clusters"`
+	Description    types.String   `tfsdk:"description"`
 	Duration       types.String   `tfsdk:"duration"`
 	Kind           types.String   `tfsdk:"kind"`
 	ManualSync     types.Bool     `tfsdk:"manual_sync"`
@@ -130,14 +168,14 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 			NestedObject: schema.NestedBlockObject{
 				Attributes: map[string]schema.Attribute{
 					"group": schema.StringAttribute{
-						Description: "The Kubernetes resource Group to match for.",
+						Description: "The Kubernetes resource Group to match for. Supports glob patterns, use `*` to match any group; an empty group only matches the core API group (e.g. `Namespace`).",
 						Optional:    true,
 						Validators: []validator.String{
 							validators.GroupNameValidator(),
 						},
 					},
 					"kind": schema.StringAttribute{
-						Description: "The Kubernetes resource Kind to match for.",
+						Description: "The Kubernetes resource Kind to match for. Supports glob patterns and is case sensitive, e.g. `Deployment`.",
 						Optional:    true,
 					},
 				},
@@ -148,14 +186,14 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 			NestedObject: schema.NestedBlockObject{
 				Attributes: map[string]schema.Attribute{
 					"group": schema.StringAttribute{
-						Description: "The Kubernetes resource Group to match for.",
+						Description: "The Kubernetes resource Group to match for. Supports glob patterns, use `*` to match any group; an empty group only matches the core API group (e.g. `Namespace`).",
 						Optional:    true,
 						Validators: []validator.String{
 							validators.GroupNameValidator(),
 						},
 					},
 					"kind": schema.StringAttribute{
-						Description: "The Kubernetes resource Kind to match for.",
+						Description: "The Kubernetes resource Kind to match for. Supports glob patterns and is case sensitive, e.g. `Deployment`.",
 						Optional:    true,
 					},
 				},
@@ -166,14 +204,14 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 			NestedObject: schema.NestedBlockObject{
 				Attributes: map[string]schema.Attribute{
 					"group": schema.StringAttribute{
-						Description: "The Kubernetes resource Group to match for.",
+						Description: "The Kubernetes resource Group to match for. Supports glob patterns, use `*` to match any group; an empty group only matches the core API group (e.g. `Namespace`).",
 						Optional:    true,
 						Validators: []validator.String{
 							validators.GroupNameValidator(),
 						},
 					},
 					"kind": schema.StringAttribute{
-						Description: "The Kubernetes resource Kind to match for.",
+						Description: "The Kubernetes resource Kind to match for. Supports glob patterns and is case sensitive, e.g. `Deployment`.",
 						Optional:    true,
 					},
 				},
@@ -184,14 +222,14 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 			NestedObject: schema.NestedBlockObject{
 				Attributes: map[string]schema.Attribute{
 					"group": schema.StringAttribute{
-						Description: "The Kubernetes resource Group to match for.",
+						Description: "The Kubernetes resource Group to match for. Supports glob patterns, use `*` to match any group; an empty group only matches the core API group (e.g. `Namespace`).",
 						Optional:    true,
 						Validators: []validator.String{
 							validators.GroupNameValidator(),
 						},
 					},
 					"kind": schema.StringAttribute{
-						Description: "The Kubernetes resource Kind to match for.",
+						Description: "The Kubernetes resource Kind to match for. Supports glob patterns and is case sensitive, e.g. `Deployment`.",
 						Optional:    true,
 					},
 				},
@@ -212,19 +250,26 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"group": schema.StringAttribute{
-									Description: "The Kubernetes resource Group to match for.",
+									Description: "The Kubernetes resource Group to match for. Supports glob patterns (e.g. `*.example.com`).",
 									Optional:    true,
 									Validators: []validator.String{
 										validators.GroupNameValidator(),
+										validators.GlobPatternValidator(),
 									},
 								},
 								"kind": schema.StringAttribute{
-									Description: "The Kubernetes resource Kind to match for.",
+									Description: "The Kubernetes resource Kind to match for. Supports glob patterns (e.g. `Config*`). Matches any kind when omitted.",
 									Optional:    true,
+									Validators: []validator.String{
+										validators.GlobPatternValidator(),
+									},
 								},
 								"name": schema.StringAttribute{
-									Description: "The Kubernetes resource name to match for.",
+									Description: "The Kubernetes resource name to match for. Supports glob patterns (e.g. `prefix-*`). Matches any name when omitted.",
 									Optional:    true,
+									Validators: []validator.String{
+										validators.GlobPatternValidator(),
+									},
 								},
 							},
 						},
@@ -245,7 +290,7 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						Optional:    true,
 					},
 					"policies": schema.ListAttribute{
-						Description: "List of casbin formatted strings that define access policies for the role in the project. For more information, see the [ArgoCD RBAC reference](https://argoproj.github.io/argo-cd/operator-manual/rbac/#rbac-permission-structure).",
+						Description: "List of casbin formatted strings that define access policies for the role in the project. For more information, see the [ArgoCD RBAC reference](https://argoproj.github.io/argo-cd/operator-manual/rbac/#rbac-permission-structure). Policies are validated during plan: the subject must be `proj:<project>:<role>` and the object must be scoped to the project. ArgoCD rewrites the spacing of policies when saving them, which is not reported as drift.",
 						Required:    true,
 						ElementType: types.StringType,
 					},
@@ -255,8 +300,9 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						ElementType: types.StringType,
 					},
 					"jwt_tokens": schema.SetNestedAttribute{
-						Description: "List of JWT tokens issued for this role.",
-						Optional:    true,
+						Description:        "List of JWT tokens issued for this role. Tokens are bookkept by ArgoCD and never stored in the state of this resource, hence issuing tokens does not cause drift and existing tokens are preserved on update. Use `argocd_project_token` to manage tokens.",
+						DeprecationMessage: "jwt_tokens is ignored by argocd_project, tokens should be managed using argocd_project_token resources instead",
+						Optional:           true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"iat": schema.Int64Attribute{
@@ -304,6 +350,13 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						Description: "Defines if the AND operator should be used among the various conditions for the sync window.",
 						Optional:    true,
 					},
+					"description": schema.StringAttribute{
+						Description: "Description of the sync window, e.g. a ticket number or the reason for the window. Requires ArgoCD 2.14.0 or later.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(255),
+						},
+					},
 					"kind": schema.StringAttribute{
 						Description: "Defines if the window allows or blocks syncs, allowed values are `allow` or `deny`.",
 						Optional:    true,
@@ -331,8 +384,8 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						Optional:    true,
 					},
 					"schedule": schema.StringAttribute{
-						Description: "Time the window will begin, specified in cron format.",
-						Optional:    true,
+						Description: "Time the window will begin, specified as a five field cron expression (e.g. `10 1 * * *`). Descriptors such as `@daily` are not supported by ArgoCD.",
+						Required:    true,
 						Validators: []validator.String{
 							validators.SyncWindowScheduleValidator(),
 						},
@@ -345,7 +398,7 @@ func projectSpecSchemaBlocks() map[string]schema.Block {
 						},
 					},
 					"timezone": schema.StringAttribute{
-						Description: "Timezone that the schedule will be evaluated in.",
+						Description: "Timezone that the schedule will be evaluated in, as named in the IANA timezone database (e.g. `Europe/London`). ArgoCD falls back to UTC for timezones it does not know, hence unknown timezones are rejected at plan time. Deprecated aliases such as `US/Eastern` are saved using their canonical zone.",
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("UTC"),
@@ -365,15 +418,22 @@ func projectSpecSchemaAttributesOnly() map[string]schema.Attribute {
 			Description: "Project description.",
 			Optional:    true,
 		},
-		"source_repos": schema.ListAttribute{
-			Description: "List of repositories from which applications may be created.",
+		"permit_only_project_scoped_clusters": schema.BoolAttribute{
+			MarkdownDescription: "Whether applications of the project may only be deployed to clusters scoped to the project (i.e. `argocd_cluster` resources with `project` set to this project), even if other clusters match the destinations of the project.",
+			Optional:            true,
+		},
+		"source_repos": schema.SetAttribute{
+			Description: "Repositories from which applications may be created. ArgoCD does not preserve the order of source repositories, hence only membership changes are reported.",
 			Optional:    true,
 			ElementType: types.StringType,
 		},
 		"source_namespaces": schema.SetAttribute{
-			Description: "List of source namespaces for applications.",
+			Description: "Namespaces outside of the control plane namespace in which applications of this project may be created. Entries may be glob patterns or regular expressions wrapped in `/` and are validated at plan time; entries matching the namespace of the project itself are reported as a warning since applications in the control plane namespace are always permitted. The namespaces currently in use are exposed in `resolved_source_namespaces`.",
 			Optional:    true,
 			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(validators.SourceNamespaceValidator()),
+			},
 		},
 		"signature_keys": schema.SetAttribute{
 			Description: "Signature keys for verifying the integrity of applications.",
@@ -383,15 +443,190 @@ func projectSpecSchemaAttributesOnly() map[string]schema.Attribute {
 	}
 }
 
+func projectEffectiveSpecSchemaAttribute() schema.SingleNestedAttribute {
+	groupKind := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Description: "The Kubernetes resource Group to match for.",
+				Computed:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "The Kubernetes resource Kind to match for.",
+				Computed:    true,
+			},
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Spec of the project as evaluated by ArgoCD, i.e. `spec` with the fields of the projects listed in `global_projects` appended, in the same order as ArgoCD merges them. Only the fields which are inherited from global projects are exposed.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cluster_resource_blacklist": schema.ListNestedAttribute{
+				Description:  "Blacklisted cluster level resources.",
+				Computed:     true,
+				NestedObject: groupKind,
+			},
+			"cluster_resource_whitelist": schema.ListNestedAttribute{
+				Description:  "Whitelisted cluster level resources.",
+				Computed:     true,
+				NestedObject: groupKind,
+			},
+			"destination": schema.ListNestedAttribute{
+				Description: "Destinations available for deployment.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							Description: "URL of the target cluster and must be set to the Kubernetes control plane API.",
+							Computed:    true,
+						},
+						"namespace": schema.StringAttribute{
+							Description: "Target namespace for applications' resources.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the destination cluster which can be used instead of server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"namespace_resource_blacklist": schema.ListNestedAttribute{
+				Description:  "Blacklisted namespace level resources.",
+				Computed:     true,
+				NestedObject: groupKind,
+			},
+			"namespace_resource_whitelist": schema.ListNestedAttribute{
+				Description:  "Whitelisted namespace level resources.",
+				Computed:     true,
+				NestedObject: groupKind,
+			},
+			"source_repos": schema.ListAttribute{
+				Description: "Repositories from which applications may be created.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sync_window": schema.ListNestedAttribute{
+				Description: "Time windows during which syncs are allowed or denied.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"applications": schema.ListAttribute{
+							Description: "List of applications that the window will apply to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"clusters": schema.ListAttribute{
+							Description: "List of clusters that the window will apply to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the sync window.",
+							Computed:    true,
+						},
+						"duration": schema.StringAttribute{
+							Description: "Amount of time the sync window will be open.",
+							Computed:    true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "Defines if the window allows or blocks syncs, allowed values are `allow` or `deny`.",
+							Computed:    true,
+						},
+						"manual_sync": schema.BoolAttribute{
+							Description: "Enables manual syncs when they would otherwise be blocked.",
+							Computed:    true,
+						},
+						"namespaces": schema.ListAttribute{
+							Description: "List of namespaces that the window will apply to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Time the window will begin, specified in cron format.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Timezone that the schedule will be evaluated in.",
+							Computed:    true,
+						},
+						"use_and_operator": schema.BoolAttribute{
+							Description: "Whether the conditions of the sync window are combined with a logical AND instead of OR.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectEffectiveSpecAttrTypes() map[string]attr.Type {
+	return projectEffectiveSpecSchemaAttribute().GetType().(types.ObjectType).AttrTypes
+}
+
+// newProjectEffectiveSpec merges the spec of the given global projects into
+// spec, the same way ArgoCD does when evaluating the permissions of the
+// project.
+func newProjectEffectiveSpec(ctx context.Context, spec v1alpha1.AppProjectSpec, globalProjects []*v1alpha1.AppProject) (types.Object, diag.Diagnostics) {
+	merged := spec.DeepCopy()
+
+	for _, gp := range globalProjects {
+		merged.ClusterResourceWhitelist = append(merged.ClusterResourceWhitelist, gp.Spec.ClusterResourceWhitelist...)
+		merged.ClusterResourceBlacklist = append(merged.ClusterResourceBlacklist, gp.Spec.ClusterResourceBlacklist...)
+		merged.NamespaceResourceWhitelist = append(merged.NamespaceResourceWhitelist, gp.Spec.NamespaceResourceWhitelist...)
+		merged.NamespaceResourceBlacklist = append(merged.NamespaceResourceBlacklist, gp.Spec.NamespaceResourceBlacklist...)
+		merged.SyncWindows = append(merged.SyncWindows, gp.Spec.SyncWindows...)
+		merged.SourceRepos = append(merged.SourceRepos, gp.Spec.SourceRepos...)
+		merged.Destinations = append(merged.Destinations, gp.Spec.Destinations...)
+	}
+
+	ps := newProjectSpec(merged)
+
+	return types.ObjectValueFrom(ctx, projectEffectiveSpecAttrTypes(), projectEffectiveSpecModel{
+		ClusterResourceBlacklist:   ps.ClusterResourceBlacklist,
+		ClusterResourceWhitelist:   ps.ClusterResourceWhitelist,
+		Destination:                ps.Destination,
+		NamespaceResourceBlacklist: ps.NamespaceResourceBlacklist,
+		NamespaceResourceWhitelist: ps.NamespaceResourceWhitelist,
+		SourceRepos:                ps.SourceRepos,
+		SyncWindow:                 ps.SyncWindow,
+	})
+}
+
 func newProject(project *v1alpha1.AppProject) *projectModel {
 	p := &projectModel{
-		Metadata: []objectMeta{newObjectMeta(project.ObjectMeta)},
+		Metadata: []projectMetadata{newProjectMetadata(project.ObjectMeta)},
 		Spec:     []projectSpecModel{newProjectSpec(&project.Spec)},
+
+		ResolvedSourceNamespaces: types.ListNull(types.StringType),
+		UnmanagedTokens:          types.ListNull(types.StringType),
 	}
 
 	return p
 }
 
+func newProjectMetadata(om metav1.ObjectMeta) projectMetadata {
+	pm := projectMetadata{
+		objectMeta: newObjectMeta(om),
+	}
+
+	// The tokens recorded by argocd_project_token are not managed here
+	if _, ok := pm.Annotations[projectManagedTokensAnnotation]; ok {
+		delete(pm.Annotations, projectManagedTokensAnnotation)
+
+		if len(pm.Annotations) == 0 {
+			pm.Annotations = nil
+		}
+	}
+
+	if len(om.Finalizers) > 0 {
+		pm.Finalizers = pie.Map(om.Finalizers, types.StringValue)
+	}
+
+	return pm
+}
+
 func newProjectSpec(spec *v1alpha1.AppProjectSpec) projectSpecModel {
 	ps := projectSpecModel{
 		Description: types.StringValue(spec.Description),
@@ -403,6 +638,10 @@ func newProjectSpec(spec *v1alpha1.AppProjectSpec) projectSpecModel {
 		ps.Description = types.StringNull()
 	}
 
+	if spec.PermitOnlyProjectScopedClusters {
+		ps.PermitOnlyProjectScopedClusters = types.BoolValue(true)
+	}
+
 	// Convert source repos
 	// Check for non-nil to distinguish between unset (nil) and explicitly empty ([])
 	// This fixes issue #788 where empty lists were incorrectly converted to null
@@ -519,10 +758,11 @@ func newProjectSpec(spec *v1alpha1.AppProjectSpec) projectSpecModel {
 		if len(spec.OrphanedResources.Ignore) > 0 {
 			or.Ignore = make([]orphanedResourcesIgnoreModel, len(spec.OrphanedResources.Ignore))
 			for i, ignore := range spec.OrphanedResources.Ignore {
+				// Omitted fields are sent as empty strings, which ArgoCD treats as match-all
 				or.Ignore[i] = orphanedResourcesIgnoreModel{
-					Group: types.StringValue(ignore.Group),
-					Kind:  types.StringValue(ignore.Kind),
-					Name:  types.StringValue(ignore.Name),
+					Group: utils.OptionalNonEmptyString(ignore.Group),
+					Kind:  utils.OptionalNonEmptyString(ignore.Kind),
+					Name:  utils.OptionalNonEmptyString(ignore.Name),
 				}
 			}
 		}
@@ -588,6 +828,10 @@ func newProjectSpec(spec *v1alpha1.AppProjectSpec) projectSpecModel {
 				swm.Timezone = types.StringValue(sw.TimeZone)
 			}
 
+			if sw.Description != "" {
+				swm.Description = types.StringValue(sw.Description)
+			}
+
 			if sw.Applications != nil {
 				swm.Applications = make([]types.String, len(sw.Applications))
 				for j, app := range sw.Applications {
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"regexp"
+
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,19 +23,38 @@ type projectTokenModel struct {
 	ExpiresIn   types.String `tfsdk:"expires_in"`
 	RenewAfter  types.String `tfsdk:"renew_after"`
 	RenewBefore types.String `tfsdk:"renew_before"`
+	WarnBefore  types.String `tfsdk:"warn_before"`
 	Description types.String `tfsdk:"description"`
 	JWT         types.String `tfsdk:"jwt"`
 	IssuedAt    types.String `tfsdk:"issued_at"`
 	ExpiresAt   types.String `tfsdk:"expires_at"`
+	StoreJWT    types.Bool   `tfsdk:"store_jwt"`
+
+	WaitForPropagation types.Bool `tfsdk:"wait_for_propagation"`
+
+	KubernetesSecret *tokenSecretModel `tfsdk:"kubernetes_secret"`
+}
+
+type tokenSecretModel struct {
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
 }
 
 func projectTokenSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
-			Description: "Token identifier",
-			Computed:    true,
+			Description: "Token identifier. Defaults to a random identifier. " +
+				"Setting it explicitly, e.g. to `ci-<workspace>`, allows several workspaces to manage tokens for the same role without mistaking each other's tokens. " +
+				"Explicit identifiers must not contain colons and are retained when the token is renewed, in which case the old token is revoked before the new one is issued.",
+			Optional: true,
+			Computed: true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+$`), "must not contain colons"),
 			},
 		},
 		"project": schema.StringAttribute{
@@ -63,7 +89,14 @@ func projectTokenSchemaAttributes() map[string]schema.Attribute {
 			},
 		},
 		"renew_before": schema.StringAttribute{
-			Description: "Duration to control token silent regeneration based on remaining token lifetime. If `expires_in` is set, Terraform will regenerate the token if `expires_at - currentDate < renew_before`. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+			Description: "Duration to control token silent regeneration based on remaining token lifetime. If `expires_in` is set, Terraform will regenerate the token if `expires_at - currentDate < renew_before`. Requires `expires_in` and cannot be greater than it. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+			Optional:    true,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		"warn_before": schema.StringAttribute{
+			Description: "Duration to control warnings about the upcoming expiry of the token. If set, Terraform will emit a warning when refreshing the token if `expires_at - currentDate < warn_before`, e.g. to notice expiring tokens which are not regenerated through `renew_before`. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
 			Optional:    true,
 			Validators: []validator.String{
 				validators.DurationValidator(),
@@ -77,10 +110,24 @@ func projectTokenSchemaAttributes() map[string]schema.Attribute {
 			},
 		},
 		"jwt": schema.StringAttribute{
-			Description: "The raw JWT.",
+			Description: "The raw JWT. Null if `store_jwt` is disabled.",
 			Computed:    true,
 			Sensitive:   true,
 		},
+		"store_jwt": schema.BoolAttribute{
+			Description: "Whether the JWT is stored in the state. If disabled, the token can only be consumed through `kubernetes_secret`. Changing this issues a new token, as the previous one may already have been stored. Default: `true`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"kubernetes_secret": tokenSecretSchemaAttribute(),
+		"wait_for_propagation": schema.BoolAttribute{
+			Description: "Whether to wait until ArgoCD accepts the token before it is returned, for up to 2 minutes. Newly issued tokens may take a moment to be accepted by all replicas of the API server in highly available setups, causing requests made right away with them to fail.",
+			Optional:    true,
+		},
 		"issued_at": schema.StringAttribute{
 			Description: "Unix timestamp at which the token was issued.",
 			Computed:    true,
@@ -97,3 +144,40 @@ func projectTokenSchemaAttributes() map[string]schema.Attribute {
 		},
 	}
 }
+
+func tokenSecretSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Kubernetes secret the JWT is written to, e.g. to hand it over to workloads without storing it in the state in combination with `store_jwt = false`. " +
+			"The secret is created if it does not exist, and deleted again once it no longer holds any token. Only the given key is modified within existing secrets. " +
+			"Requires the provider to be configured with `core = true`. Changing this issues a new token.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the secret.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "Namespace of the secret. Defaults to the namespace ArgoCD is managed in, see [`core`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Key the JWT is stored under. Default: `token`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("token"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
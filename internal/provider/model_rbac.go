@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rbacAttributePaths lists the attributes of the managed settings, at least
+// one of which must be configured.
+var rbacAttributePaths = []path.Expression{
+	path.MatchRoot("policy_csv"),
+	path.MatchRoot("policy_default"),
+	path.MatchRoot("scopes"),
+	path.MatchRoot("match_mode"),
+}
+
+type rbacModel struct {
+	ID            types.String   `tfsdk:"id"`
+	PolicyCSV     types.String   `tfsdk:"policy_csv"`
+	PolicyDefault types.String   `tfsdk:"policy_default"`
+	Scopes        []types.String `tfsdk:"scopes"`
+	MatchMode     types.String   `tfsdk:"match_mode"`
+}
+
+func rbacSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "RBAC configuration identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"policy_csv": schema.StringAttribute{
+			MarkdownDescription: "Policies and role assignments in CSV format, stored under the `policy.csv` key. Each line is either a policy of the form `p, <subject>, <resource>, <action>, <object>, <effect>` or a role assignment of the form `g, <subject>, <role>`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				validators.RBACPolicy(),
+			},
+		},
+		"policy_default": schema.StringAttribute{
+			MarkdownDescription: "Role granted to all authenticated users which are not granted any other role, e.g. `role:readonly`. Users are not granted any permissions by default if unset.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"scopes": schema.ListAttribute{
+			MarkdownDescription: "OIDC scopes to examine during RBAC enforcement, in addition to `sub`, e.g. `[\"groups\", \"email\"]`. ArgoCD defaults to `[\"groups\"]` if unset.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"match_mode": schema.StringAttribute{
+			MarkdownDescription: "Matcher used to evaluate the objects of the policies, either `glob` or `regex`. ArgoCD defaults to `glob` if unset.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(rbac.GlobMatchMode, rbac.RegexMatchMode),
+			},
+		},
+	}
+}
+
+func (m *rbacModel) toRBACSettings() *rbacSettings {
+	return &rbacSettings{
+		PolicyCSV:     m.PolicyCSV.ValueString(),
+		PolicyDefault: m.PolicyDefault.ValueString(),
+		Scopes:        pie.Map(m.Scopes, types.String.ValueString),
+		MatchMode:     m.MatchMode.ValueString(),
+	}
+}
+
+// isEmpty reports whether none of the settings are managed, which is only the
+// case right after the resource has been imported.
+func (m *rbacModel) isEmpty() bool {
+	return len(m.toRBACSettings().data()) == 0
+}
+
+// updateFromRBACSettings refreshes the managed settings from the given
+// values, or all of them if all is set. Settings which have been removed
+// out-of-band are set to null.
+func (m *rbacModel) updateFromRBACSettings(s *rbacSettings, all bool) {
+	m.ID = types.StringValue(common.ArgoCDRBACConfigMapName)
+
+	updateString := func(value string, v *types.String) {
+		if !all && v.IsNull() {
+			return
+		}
+
+		*v = types.StringNull()
+
+		if value != "" {
+			*v = types.StringValue(value)
+		}
+	}
+
+	updateString(s.PolicyCSV, &m.PolicyCSV)
+	updateString(s.PolicyDefault, &m.PolicyDefault)
+	updateString(s.MatchMode, &m.MatchMode)
+
+	if all || m.Scopes != nil {
+		m.Scopes = nil
+
+		if len(s.Scopes) > 0 {
+			m.Scopes = pie.Map(s.Scopes, types.StringValue)
+		}
+	}
+}
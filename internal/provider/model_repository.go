@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/utils"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -13,42 +24,70 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type repositoryModel struct {
-	ID                         types.String `tfsdk:"id"`
-	Repo                       types.String `tfsdk:"repo"`
-	Name                       types.String `tfsdk:"name"`
-	Type                       types.String `tfsdk:"type"`
-	Project                    types.String `tfsdk:"project"`
-	UseAzureWorkloadIdentity   types.Bool   `tfsdk:"use_azure_workload_identity"`
-	Username                   types.String `tfsdk:"username"`
-	Password                   types.String `tfsdk:"password"`
-	SSHPrivateKey              types.String `tfsdk:"ssh_private_key"`
-	TLSClientCertData          types.String `tfsdk:"tls_client_cert_data"`
-	TLSClientCertKey           types.String `tfsdk:"tls_client_cert_key"`
-	EnableLFS                  types.Bool   `tfsdk:"enable_lfs"`
-	EnableOCI                  types.Bool   `tfsdk:"enable_oci"`
-	Insecure                   types.Bool   `tfsdk:"insecure"`
-	InheritedCreds             types.Bool   `tfsdk:"inherited_creds"`
-	ConnectionStateStatus      types.String `tfsdk:"connection_state_status"`
-	GitHubAppID                types.String `tfsdk:"githubapp_id"`
-	GitHubAppInstallationID    types.String `tfsdk:"githubapp_installation_id"`
-	GitHubAppEnterpriseBaseURL types.String `tfsdk:"githubapp_enterprise_base_url"`
-	GitHubAppPrivateKey        types.String `tfsdk:"githubapp_private_key"`
-	BearerToken                types.String `tfsdk:"bearer_token"`
-	Proxy                      types.String `tfsdk:"proxy"`
-	NoProxy                    types.String `tfsdk:"no_proxy"`
-	Depth                      types.Int64  `tfsdk:"depth"`
+	ID                            types.String              `tfsdk:"id"`
+	Repo                          types.String              `tfsdk:"repo"`
+	Name                          types.String              `tfsdk:"name"`
+	Type                          types.String              `tfsdk:"type"`
+	Project                       types.String              `tfsdk:"project"`
+	UseAzureWorkloadIdentity      types.Bool                `tfsdk:"use_azure_workload_identity"`
+	Username                      types.String              `tfsdk:"username"`
+	Password                      types.String              `tfsdk:"password"`
+	PasswordWO                    types.String              `tfsdk:"password_wo"`
+	PasswordWOVersion             types.String              `tfsdk:"password_wo_version"`
+	SSHPrivateKey                 types.String              `tfsdk:"ssh_private_key"`
+	SSHPrivateKeyWO               types.String              `tfsdk:"ssh_private_key_wo"`
+	SSHPrivateKeyWOVersion        types.String              `tfsdk:"ssh_private_key_wo_version"`
+	TLSClientCertData             types.String              `tfsdk:"tls_client_cert_data"`
+	TLSClientCertKey              types.String              `tfsdk:"tls_client_cert_key"`
+	TLSClientCertKeyWO            types.String              `tfsdk:"tls_client_cert_key_wo"`
+	TLSClientCertKeyWOVersion     types.String              `tfsdk:"tls_client_cert_key_wo_version"`
+	EnableLFS                     types.Bool                `tfsdk:"enable_lfs"`
+	EnableOCI                     types.Bool                `tfsdk:"enable_oci"`
+	Insecure                      types.Bool                `tfsdk:"insecure"`
+	InsecureIgnoreHostKey         types.Bool                `tfsdk:"insecure_ignore_host_key"`
+	ForceHTTPBasicAuth            types.Bool                `tfsdk:"force_http_basic_auth"`
+	InheritedCreds                types.Bool                `tfsdk:"inherited_creds"`
+	ConnectionStateStatus         types.String              `tfsdk:"connection_state_status"`
+	GitHubAppID                   types.String              `tfsdk:"githubapp_id"`
+	GitHubAppInstallationID       types.String              `tfsdk:"githubapp_installation_id"`
+	GitHubAppEnterpriseBaseURL    types.String              `tfsdk:"githubapp_enterprise_base_url"`
+	GitHubAppPrivateKey           types.String              `tfsdk:"githubapp_private_key"`
+	GitHubAppPrivateKeyWO         types.String              `tfsdk:"githubapp_private_key_wo"`
+	GitHubAppPrivateKeyWOVersion  types.String              `tfsdk:"githubapp_private_key_wo_version"`
+	BearerToken                   types.String              `tfsdk:"bearer_token"`
+	Proxy                         types.String              `tfsdk:"proxy"`
+	NoProxy                       types.String              `tfsdk:"no_proxy"`
+	Depth                         types.Int64               `tfsdk:"depth"`
+	GCPServiceAccountKey          types.String              `tfsdk:"gcp_service_account_key"`
+	GCPServiceAccountKeyWO        types.String              `tfsdk:"gcp_service_account_key_wo"`
+	GCPServiceAccountKeyWOVersion types.String              `tfsdk:"gcp_service_account_key_wo_version"`
+	ValidateConnection            types.Bool                `tfsdk:"validate_connection"`
+	CredentialsVersion            types.String              `tfsdk:"credentials_version"`
+	DirectSecret                  types.Bool                `tfsdk:"direct_secret"`
+	SecretName                    types.String              `tfsdk:"secret_name"`
+	Metadata                      []repositoryMetadataModel `tfsdk:"metadata"`
+}
+
+type repositoryMetadataModel struct {
+	Labels      map[string]types.String `tfsdk:"labels"`
+	Annotations map[string]types.String `tfsdk:"annotations"`
 }
 
 func repositorySchemaAttributes() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
+	attributes := map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			MarkdownDescription: "Repository identifier",
 			Computed:            true,
 		},
+		"secret_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the secret ArgoCD stores the repository in, e.g. to be referenced by the `token_ref` or `app_secret_name` of the SCM provider and pull request generators of an `argocd_application_set`. Secrets which are not created by ArgoCD or the provider, e.g. imported ones, may be named differently.",
+			Computed:            true,
+		},
 		"repo": schema.StringAttribute{
 			MarkdownDescription: "URL of the repository.",
 			Required:            true,
@@ -70,20 +109,20 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			},
 		},
 		"project": schema.StringAttribute{
-			MarkdownDescription: "The project name, in case the repository is project scoped.",
+			MarkdownDescription: "The project name, in case the repository is project scoped. The project must exist, and should permit the repository within its `source_repos`. Both are only checked if the provider is allowed to read the project. Changing the project registers the repository within the new project before removing it from the previous one, so that the repository remains available to applications throughout.",
 			Optional:            true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
-			},
 		},
 		"use_azure_workload_identity": schema.BoolAttribute{
-			MarkdownDescription: "Whether `Azure-Workload-identity` should be enabled for this repository.",
+			MarkdownDescription: "Whether `Azure-Workload-identity` should be enabled for this repository, e.g. to access Azure DevOps repositories over HTTPS without a PAT. Cannot be used together with any other credentials.",
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				validators.AzureWorkloadIdentity("repo", "username", "password", "password_wo", "bearer_token", "ssh_private_key", "ssh_private_key_wo", "githubapp_private_key", "githubapp_private_key_wo", "gcp_service_account_key", "gcp_service_account_key_wo"),
+			},
 		},
 		"username": schema.StringAttribute{
-			MarkdownDescription: "Username used for authenticating at the remote repository.",
+			MarkdownDescription: "Username used for authenticating at the remote repository. Defaults to `x-access-token` when only a `password` is set, which is accepted by servers which ignore the username of PATs, such as Azure DevOps.",
 			Optional:            true,
 		},
 		"password": schema.StringAttribute{
@@ -92,9 +131,22 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			Sensitive:           true,
 		},
 		"bearer_token": schema.StringAttribute{
-			MarkdownDescription: "BearerToken contains the bearer token used for Git BitBucket Data Center auth at the repo server",
+			MarkdownDescription: "Bearer token used for authenticating at the remote repository, e.g. a Bitbucket Data Center HTTP access token. Only used with repositories accessed over HTTP(S), and cannot be used together with a `password`, `ssh_private_key`, GitHub app or Google Cloud credentials.",
 			Optional:            true,
 			Sensitive:           true,
+			Validators: []validator.String{
+				validators.BearerToken(),
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("password"),
+					path.MatchRoot("password_wo"),
+					path.MatchRoot("ssh_private_key"),
+					path.MatchRoot("ssh_private_key_wo"),
+					path.MatchRoot("githubapp_private_key"),
+					path.MatchRoot("githubapp_private_key_wo"),
+					path.MatchRoot("gcp_service_account_key"),
+					path.MatchRoot("gcp_service_account_key_wo"),
+				),
+			},
 		},
 		"ssh_private_key": schema.StringAttribute{
 			MarkdownDescription: "PEM data for authenticating at the repo server. Only used with Git repos.",
@@ -117,7 +169,7 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			Default:             booldefault.StaticBool(false),
 		},
 		"enable_oci": schema.BoolAttribute{
-			MarkdownDescription: "Whether `helm-oci` support should be enabled for this repository.",
+			MarkdownDescription: "Whether `helm-oci` support should be enabled for this repository. Only used with Helm repos, whose `repo` may then be given with or without the `oci://` scheme, e.g. `oci://123456789012.dkr.ecr.eu-west-1.amazonaws.com/charts`.",
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
@@ -127,6 +179,25 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("insecure_ignore_host_key")),
+			},
+		},
+		"insecure_ignore_host_key": schema.BoolAttribute{
+			MarkdownDescription: "Whether the connection to the repository ignores any errors when verifying SSH host keys. Only used with Git repos accessed over SSH.",
+			DeprecationMessage:  "ArgoCD favours `insecure`, which also covers SSH host keys. Use `insecure` instead.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"force_http_basic_auth": schema.BoolAttribute{
+			MarkdownDescription: "Whether HTTP basic authentication is always used when accessing the repository, instead of waiting for the server to request it. Requires `password` or `password_wo` to be set and cannot be used together with `ssh_private_key` or `bearer_token`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				validators.ForceHTTPBasicAuth(),
+			},
 		},
 		"inherited_creds": schema.BoolAttribute{
 			MarkdownDescription: "Whether credentials were inherited from a credential set.",
@@ -137,14 +208,22 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			Computed:            true,
 		},
 		"githubapp_id": schema.StringAttribute{
-			MarkdownDescription: "ID of the GitHub app used to access the repo.",
+			MarkdownDescription: "ID of the GitHub app used to access the repo. Requires `githubapp_installation_id` to be set as well.",
 			Optional:            true,
 			Computed:            true,
+			Validators: []validator.String{
+				validators.PositiveInteger(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_installation_id")),
+			},
 		},
 		"githubapp_installation_id": schema.StringAttribute{
 			MarkdownDescription: "The installation ID of the GitHub App used to access the repo.",
 			Optional:            true,
 			Computed:            true,
+			Validators: []validator.String{
+				validators.PositiveInteger(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")),
+			},
 		},
 		"githubapp_enterprise_base_url": schema.StringAttribute{
 			MarkdownDescription: "GitHub API URL for GitHub app authentication.",
@@ -155,13 +234,42 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			MarkdownDescription: "Private key data (PEM) for authentication via GitHub app.",
 			Optional:            true,
 			Sensitive:           true,
+			Validators: []validator.String{
+				validators.SSHPrivateKey(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")),
+			},
+		},
+		"gcp_service_account_key": schema.StringAttribute{
+			MarkdownDescription: "Google Cloud service account key in JSON format, used to access Google Cloud Source repositories.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				validators.GCPServiceAccountKey(),
+			},
 		},
 		"proxy": schema.StringAttribute{
 			MarkdownDescription: "HTTP/HTTPS proxy to access the repository.",
 			Optional:            true,
 		},
 		"no_proxy": schema.StringAttribute{
-			MarkdownDescription: "Comma-separated list of hostnames that should be excluded from proxying.",
+			MarkdownDescription: "Comma-separated list of hostnames that should be excluded from proxying. Only used when `proxy` is set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("proxy")),
+			},
+		},
+		"validate_connection": schema.BoolAttribute{
+			MarkdownDescription: "Whether the apply fails when ArgoCD cannot connect to the repository after creating or updating it. Disable this when the repository is not reachable yet, e.g. when bootstrapping air-gapped environments. Note that ArgoCD always verifies the connection when creating repositories which are not scoped to a `project`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"credentials_version": schema.StringAttribute{
+			MarkdownDescription: "Arbitrary value which triggers an update of all write-only credentials (`*_wo`) whenever it changes, e.g. the version of the secret in Vault. Unlike the `*_wo_version` attributes, a single value covers every credential of the repository.",
+			Optional:            true,
+		},
+		"direct_secret": schema.BoolAttribute{
+			MarkdownDescription: "Whether the repository is registered by managing its [declarative repository secret](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) directly through the Kubernetes API instead of the ArgoCD API, when set to true. Requires the provider to be configured with [`core = true`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core). This allows registering repositories before the ArgoCD components are running, at the cost of ArgoCD not checking the connection to the repository, hence `validate_connection` is ignored, the `project` is not checked for existence and `connection_state_status` is not populated.",
 			Optional:            true,
 		},
 		"depth": schema.Int64Attribute{
@@ -174,11 +282,19 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 			},
 		},
 	}
+
+	addWriteOnlyVariant(attributes, "password")
+	addWriteOnlyVariant(attributes, "ssh_private_key")
+	addWriteOnlyVariant(attributes, "tls_client_cert_key")
+	addWriteOnlyVariant(attributes, "githubapp_private_key", validators.SSHPrivateKey(), stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")))
+	addWriteOnlyVariant(attributes, "gcp_service_account_key", validators.GCPServiceAccountKey())
+
+	return attributes
 }
 
 func (m *repositoryModel) toAPIModel() (*v1alpha1.Repository, error) {
 	repo := &v1alpha1.Repository{
-		Repo:                       m.Repo.ValueString(),
+		Repo:                       helmOCIRepoURL(m.Repo.ValueString(), m.Type.ValueString(), m.EnableOCI.ValueBool()),
 		Name:                       m.Name.ValueString(),
 		Type:                       m.Type.ValueString(),
 		Project:                    m.Project.ValueString(),
@@ -192,12 +308,15 @@ func (m *repositoryModel) toAPIModel() (*v1alpha1.Repository, error) {
 		EnableLFS:                  m.EnableLFS.ValueBool(),
 		EnableOCI:                  m.EnableOCI.ValueBool(),
 		Insecure:                   m.Insecure.ValueBool(),
+		InsecureIgnoreHostKey:      m.InsecureIgnoreHostKey.ValueBool(),
+		ForceHttpBasicAuth:         m.ForceHTTPBasicAuth.ValueBool(),
 		InheritedCreds:             m.InheritedCreds.ValueBool(),
 		GitHubAppEnterpriseBaseURL: m.GitHubAppEnterpriseBaseURL.ValueString(),
 		GithubAppPrivateKey:        m.GitHubAppPrivateKey.ValueString(),
 		Proxy:                      m.Proxy.ValueString(),
 		NoProxy:                    m.NoProxy.ValueString(),
 		Depth:                      m.Depth.ValueInt64(),
+		GCPServiceAccountKey:       m.GCPServiceAccountKey.ValueString(),
 	}
 
 	// Handle GitHub App ID conversion
@@ -231,12 +350,33 @@ func (m *repositoryModel) updateFromAPI(repo *v1alpha1.Repository) *repositoryMo
 		m.ID = types.StringValue(repo.Repo)
 	}
 
-	m.Repo = types.StringValue(repo.Repo)
+	m.SecretName = types.StringValue(repositorySecretName(repo.Repo, repo.Project))
+
+	// OCI Helm repositories may be configured with the `oci://` scheme that
+	// ArgoCD does not store
+	if m.Repo.IsNull() || m.Repo.IsUnknown() || helmOCIRepoURL(m.Repo.ValueString(), repo.Type, repo.EnableOCI) != repo.Repo {
+		m.Repo = types.StringValue(repo.Repo)
+	}
 	m.Type = types.StringValue(repo.Type)
 	m.UseAzureWorkloadIdentity = types.BoolValue(repo.UseAzureWorkloadIdentity)
 	m.EnableLFS = types.BoolValue(repo.EnableLFS)
 	m.EnableOCI = types.BoolValue(repo.EnableOCI)
-	m.Insecure = types.BoolValue(repo.Insecure)
+
+	// ArgoCD does not return insecure_ignore_host_key, but reports it as
+	// insecure instead
+	if m.InsecureIgnoreHostKey.ValueBool() && repo.Insecure {
+		if m.Insecure.IsNull() || m.Insecure.IsUnknown() {
+			m.Insecure = types.BoolValue(false)
+		}
+	} else {
+		m.Insecure = types.BoolValue(repo.Insecure)
+	}
+
+	if m.InsecureIgnoreHostKey.IsNull() || m.InsecureIgnoreHostKey.IsUnknown() {
+		m.InsecureIgnoreHostKey = types.BoolValue(false)
+	}
+
+	m.ForceHTTPBasicAuth = types.BoolValue(repo.ForceHttpBasicAuth)
 	m.InheritedCreds = types.BoolValue(repo.InheritedCreds)
 
 	if repo.Depth > 0 {
@@ -245,6 +385,10 @@ func (m *repositoryModel) updateFromAPI(repo *v1alpha1.Repository) *repositoryMo
 		m.Depth = types.Int64Value(0)
 	}
 
+	if m.ValidateConnection.IsUnknown() || m.ValidateConnection.IsNull() {
+		m.ValidateConnection = types.BoolValue(true)
+	}
+
 	if repo.Name != "" {
 		m.Name = types.StringValue(repo.Name)
 	}
@@ -302,3 +446,136 @@ func (m *repositoryModel) updateFromAPI(repo *v1alpha1.Repository) *repositoryMo
 
 	return m
 }
+
+// updateFromSecret updates the model from a repository read from its
+// declarative secret, which holds no connection state.
+func (m *repositoryModel) updateFromSecret(repo *v1alpha1.Repository) *repositoryModel {
+	m.updateFromAPI(repo)
+
+	if m.ConnectionStateStatus.IsUnknown() {
+		m.ConnectionStateStatus = types.StringNull()
+	}
+
+	return m
+}
+
+func repositorySchemaBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"metadata": schema.ListNestedBlock{
+			MarkdownDescription: "Metadata of the repository secret. Labels can be used to discover the secret or to track its ownership, e.g. by other controllers or policies. The ArgoCD API does not manage the metadata of repository secrets, hence this requires the provider to be configured with [`core = true`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core). Only the labels and annotations declared here are tracked, the ones added by ArgoCD or other controllers are preserved and are not reported as drift.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"annotations": schema.MapAttribute{
+						MarkdownDescription: "An unstructured key value map stored with the repository secret that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							validators.MetadataAnnotations(),
+							mapvalidator.KeysAre(stringvalidator.NoneOf(common.AnnotationKeyManagedBy)),
+						},
+					},
+					"labels": schema.MapAttribute{
+						MarkdownDescription: "Map of string keys and values that can be used to organize and categorize (scope and select) the repository secret. The `" + common.LabelKeySecretType + "` label is managed by the provider and cannot be set. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							validators.MetadataLabels(),
+							mapvalidator.KeysAre(stringvalidator.NoneOf(common.LabelKeySecretType)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// secretMetadata returns the labels and annotations to manage on the secret of
+// the repository.
+func (m *repositoryModel) secretMetadata() secretMetadata {
+	if len(m.Metadata) == 0 {
+		return secretMetadata{}
+	}
+
+	return secretMetadata{
+		Labels:      utils.MapMap(m.Metadata[0].Labels, types.String.ValueString),
+		Annotations: utils.MapMap(m.Metadata[0].Annotations, types.String.ValueString),
+	}
+}
+
+// updateMetadata updates the tracked labels and annotations of the repository
+// secret.
+func (m *repositoryModel) updateMetadata(metadata secretMetadata) {
+	if len(m.Metadata) == 0 {
+		return
+	}
+
+	m.Metadata[0].Labels = utils.MapMap(metadata.Labels, types.StringValue)
+	m.Metadata[0].Annotations = utils.MapMap(metadata.Annotations, types.StringValue)
+}
+
+// helmOCIRepoURL returns the URL under which ArgoCD registers a repository.
+// OCI Helm repositories are registered without the `oci://` scheme, as the
+// Helm CLI expects them.
+func helmOCIRepoURL(url, repoType string, enableOCI bool) string {
+	if repoType == "helm" && enableOCI {
+		return strings.TrimPrefix(url, "oci://")
+	}
+
+	return url
+}
+
+// addWriteOnlyVariant adds a write-only variant of the sensitive attribute
+// name, which is never stored in the plan or state, along with the version
+// attribute triggering its updates.
+func addWriteOnlyVariant(attributes map[string]schema.Attribute, name string, validators ...validator.String) {
+	attribute := attributes[name].(schema.StringAttribute)
+	attribute.Validators = append(attribute.Validators, stringvalidator.ConflictsWith(path.MatchRoot(name+"_wo")))
+	attributes[name] = attribute
+
+	attributes[name+"_wo"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Write-only variant of `%s` which is never stored in the plan or state. Bump `%s_wo_version` to update it, e.g. when rotating credentials. Requires Terraform 1.11 or later.", name, name),
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators:          validators,
+	}
+
+	attributes[name+"_wo_version"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Arbitrary value which triggers an update of `%s_wo` whenever it changes.", name),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot(name + "_wo")),
+		},
+	}
+}
+
+// setWriteOnlyAttributes sets the fields of the API model to the values of
+// their write-only attributes, which are only ever available in the
+// configuration.
+func setWriteOnlyAttributes(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics, fields map[string]*string) {
+	for name, field := range fields {
+		var value types.String
+
+		diags.Append(config.GetAttribute(ctx, path.Root(name+"_wo"), &value)...)
+
+		if value.ValueString() != "" {
+			*field = value.ValueString()
+		}
+	}
+}
+
+// repositoryWriteOnlyFields returns the fields of the repository which can be
+// set from write-only attributes, keyed by the attribute they are the variant
+// of.
+func repositoryWriteOnlyFields(repo *v1alpha1.Repository) map[string]*string {
+	return map[string]*string{
+		"password":                &repo.Password,
+		"ssh_private_key":         &repo.SSHPrivateKey,
+		"tls_client_cert_key":     &repo.TLSClientCertKey,
+		"githubapp_private_key":   &repo.GithubAppPrivateKey,
+		"gcp_service_account_key": &repo.GCPServiceAccountKey,
+	}
+}
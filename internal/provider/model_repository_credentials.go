@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"cmp"
 	"strconv"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/utils"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,30 +19,49 @@ import (
 )
 
 type repositoryCredentialsModel struct {
-	ID                         types.String `tfsdk:"id"`
-	URL                        types.String `tfsdk:"url"`
-	UseAzureWorkloadIdentity   types.Bool   `tfsdk:"use_azure_workload_identity"`
-	Type                       types.String `tfsdk:"type"`
-	Username                   types.String `tfsdk:"username"`
-	Password                   types.String `tfsdk:"password"`
-	SSHPrivateKey              types.String `tfsdk:"ssh_private_key"`
-	TLSClientCertData          types.String `tfsdk:"tls_client_cert_data"`
-	TLSClientCertKey           types.String `tfsdk:"tls_client_cert_key"`
-	EnableOCI                  types.Bool   `tfsdk:"enable_oci"`
-	GitHubAppID                types.String `tfsdk:"githubapp_id"`
-	GitHubAppInstallationID    types.String `tfsdk:"githubapp_installation_id"`
-	GitHubAppEnterpriseBaseURL types.String `tfsdk:"githubapp_enterprise_base_url"`
-	GitHubAppPrivateKey        types.String `tfsdk:"githubapp_private_key"`
+	ID                            types.String `tfsdk:"id"`
+	URL                           types.String `tfsdk:"url"`
+	UseAzureWorkloadIdentity      types.Bool   `tfsdk:"use_azure_workload_identity"`
+	Type                          types.String `tfsdk:"type"`
+	Username                      types.String `tfsdk:"username"`
+	Password                      types.String `tfsdk:"password"`
+	PasswordWO                    types.String `tfsdk:"password_wo"`
+	PasswordWOVersion             types.String `tfsdk:"password_wo_version"`
+	SSHPrivateKey                 types.String `tfsdk:"ssh_private_key"`
+	SSHPrivateKeyWO               types.String `tfsdk:"ssh_private_key_wo"`
+	SSHPrivateKeyWOVersion        types.String `tfsdk:"ssh_private_key_wo_version"`
+	TLSClientCertData             types.String `tfsdk:"tls_client_cert_data"`
+	TLSClientCertKey              types.String `tfsdk:"tls_client_cert_key"`
+	TLSClientCertKeyWO            types.String `tfsdk:"tls_client_cert_key_wo"`
+	TLSClientCertKeyWOVersion     types.String `tfsdk:"tls_client_cert_key_wo_version"`
+	EnableOCI                     types.Bool   `tfsdk:"enable_oci"`
+	GitHubAppID                   types.String `tfsdk:"githubapp_id"`
+	GitHubAppInstallationID       types.String `tfsdk:"githubapp_installation_id"`
+	GitHubAppEnterpriseBaseURL    types.String `tfsdk:"githubapp_enterprise_base_url"`
+	GitHubAppPrivateKey           types.String `tfsdk:"githubapp_private_key"`
+	GitHubAppPrivateKeyWO         types.String `tfsdk:"githubapp_private_key_wo"`
+	GitHubAppPrivateKeyWOVersion  types.String `tfsdk:"githubapp_private_key_wo_version"`
+	GCPServiceAccountKey          types.String `tfsdk:"gcp_service_account_key"`
+	GCPServiceAccountKeyWO        types.String `tfsdk:"gcp_service_account_key_wo"`
+	GCPServiceAccountKeyWOVersion types.String `tfsdk:"gcp_service_account_key_wo_version"`
+	Proxy                         types.String `tfsdk:"proxy"`
+	NoProxy                       types.String `tfsdk:"no_proxy"`
+	DirectSecret                  types.Bool   `tfsdk:"direct_secret"`
+	SecretName                    types.String `tfsdk:"secret_name"`
 }
 
 func repositoryCredentialsSchemaAttributes() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
+	attributes := map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			MarkdownDescription: "Repository credentials identifier",
 			Computed:            true,
 		},
+		"secret_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the secret ArgoCD stores the credentials in, e.g. to be referenced by the `token_ref` or `app_secret_name` of the SCM provider and pull request generators of an `argocd_application_set`. Secrets which are not created by ArgoCD or the provider, e.g. imported ones, may be named differently.",
+			Computed:            true,
+		},
 		"url": schema.StringAttribute{
-			MarkdownDescription: "URL that these credentials match to",
+			MarkdownDescription: "URL prefix that these credentials match to, e.g. `https://github.com/my-org/`. The credentials are used for every repository whose URL starts with this prefix and which has no credentials of its own.",
 			Required:            true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplace(),
@@ -76,9 +98,12 @@ func repositoryCredentialsSchemaAttributes() map[string]schema.Attribute {
 			Optional:            true,
 		},
 		"tls_client_cert_key": schema.StringAttribute{
-			MarkdownDescription: "TLS client cert key for authenticating at the repo server",
+			MarkdownDescription: "TLS client cert key for authenticating at the repo server. Requires `tls_client_cert_data` to be set as well.",
 			Optional:            true,
 			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("tls_client_cert_data")),
+			},
 		},
 		"enable_oci": schema.BoolAttribute{
 			MarkdownDescription: "Whether `helm-oci` support should be enabled for this repo. Can only be set to `true` when `type` is `helm`.",
@@ -90,16 +115,20 @@ func repositoryCredentialsSchemaAttributes() map[string]schema.Attribute {
 			},
 		},
 		"use_azure_workload_identity": schema.BoolAttribute{
-			MarkdownDescription: "Whether `Azure-Workload-identity` should be enabled for this repository.",
+			MarkdownDescription: "Whether `Azure-Workload-identity` should be enabled for this repository, e.g. to access Azure DevOps repositories over HTTPS without a PAT. Cannot be used together with any other credentials.",
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				validators.AzureWorkloadIdentity("url", "username", "password", "password_wo", "ssh_private_key", "ssh_private_key_wo", "githubapp_private_key", "githubapp_private_key_wo", "gcp_service_account_key", "gcp_service_account_key_wo"),
+			},
 		},
 		"githubapp_id": schema.StringAttribute{
-			MarkdownDescription: "GitHub App ID of the app used to access the repo for GitHub app authentication",
+			MarkdownDescription: "GitHub App ID of the app used to access the repo for GitHub app authentication. Requires `githubapp_installation_id` to be set as well.",
 			Optional:            true,
 			Validators: []validator.String{
 				validators.PositiveInteger(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_installation_id")),
 			},
 		},
 		"githubapp_installation_id": schema.StringAttribute{
@@ -107,11 +136,15 @@ func repositoryCredentialsSchemaAttributes() map[string]schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				validators.PositiveInteger(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")),
 			},
 		},
 		"githubapp_enterprise_base_url": schema.StringAttribute{
-			MarkdownDescription: "GitHub API URL for GitHub app authentication",
+			MarkdownDescription: "GitHub API URL for GitHub app authentication, e.g. `https://ghe.example.com/api/v3`. Only needed for GitHub Enterprise.",
 			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")),
+			},
 		},
 		"githubapp_private_key": schema.StringAttribute{
 			MarkdownDescription: "Private key data (PEM) for authentication via GitHub app",
@@ -119,9 +152,41 @@ func repositoryCredentialsSchemaAttributes() map[string]schema.Attribute {
 			Sensitive:           true,
 			Validators: []validator.String{
 				validators.SSHPrivateKey(),
+				stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")),
+			},
+		},
+		"gcp_service_account_key": schema.StringAttribute{
+			MarkdownDescription: "Google Cloud service account key in JSON format, used to access Google Cloud Source repositories.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				validators.GCPServiceAccountKey(),
+			},
+		},
+		"proxy": schema.StringAttribute{
+			MarkdownDescription: "HTTP/HTTPS proxy used to access the repositories matching these credentials.",
+			Optional:            true,
+		},
+		"no_proxy": schema.StringAttribute{
+			MarkdownDescription: "Comma-separated list of hostnames that should be excluded from proxying. Only used when `proxy` is set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("proxy")),
 			},
 		},
+		"direct_secret": schema.BoolAttribute{
+			MarkdownDescription: "Whether the credentials are registered by managing their [declarative repository credentials secret](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repository-credentials) directly through the Kubernetes API instead of the ArgoCD API, when set to true. Requires the provider to be configured with [`core = true`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core). This allows registering credentials before the ArgoCD components are running.",
+			Optional:            true,
+		},
 	}
+
+	addWriteOnlyVariant(attributes, "password")
+	addWriteOnlyVariant(attributes, "ssh_private_key", validators.SSHPrivateKey())
+	addWriteOnlyVariant(attributes, "tls_client_cert_key", stringvalidator.AlsoRequires(path.MatchRoot("tls_client_cert_data")))
+	addWriteOnlyVariant(attributes, "githubapp_private_key", validators.SSHPrivateKey(), stringvalidator.AlsoRequires(path.MatchRoot("githubapp_id")))
+	addWriteOnlyVariant(attributes, "gcp_service_account_key", validators.GCPServiceAccountKey())
+
+	return attributes
 }
 
 func (m *repositoryCredentialsModel) toAPIModel() (*v1alpha1.RepoCreds, error) {
@@ -137,6 +202,9 @@ func (m *repositoryCredentialsModel) toAPIModel() (*v1alpha1.RepoCreds, error) {
 		EnableOCI:                  m.EnableOCI.ValueBool(),
 		GitHubAppEnterpriseBaseURL: m.GitHubAppEnterpriseBaseURL.ValueString(),
 		GithubAppPrivateKey:        m.GitHubAppPrivateKey.ValueString(),
+		GCPServiceAccountKey:       m.GCPServiceAccountKey.ValueString(),
+		Proxy:                      m.Proxy.ValueString(),
+		NoProxy:                    m.NoProxy.ValueString(),
 	}
 
 	// Handle GitHub App ID conversion
@@ -161,3 +229,43 @@ func (m *repositoryCredentialsModel) toAPIModel() (*v1alpha1.RepoCreds, error) {
 
 	return creds, nil
 }
+
+// updateFromSecret updates all non-sensitive fields of the model from
+// credentials read from their declarative secret, which, unlike the ArgoCD
+// API, holds all of them.
+func (m *repositoryCredentialsModel) updateFromSecret(creds *v1alpha1.RepoCreds) {
+	m.ID = types.StringValue(creds.URL)
+	m.SecretName = types.StringValue(repositoryCredentialsSecretName(creds.URL))
+	m.URL = types.StringValue(creds.URL)
+	m.Type = types.StringValue(cmp.Or(creds.Type, "git"))
+	m.Username = utils.OptionalNonEmptyString(creds.Username)
+	m.TLSClientCertData = utils.OptionalNonEmptyString(creds.TLSClientCertData)
+	m.EnableOCI = types.BoolValue(creds.EnableOCI)
+	m.UseAzureWorkloadIdentity = types.BoolValue(creds.UseAzureWorkloadIdentity)
+	m.GitHubAppID = types.StringNull()
+	m.GitHubAppInstallationID = types.StringNull()
+	m.GitHubAppEnterpriseBaseURL = utils.OptionalNonEmptyString(creds.GitHubAppEnterpriseBaseURL)
+	m.Proxy = utils.OptionalNonEmptyString(creds.Proxy)
+	m.NoProxy = utils.OptionalNonEmptyString(creds.NoProxy)
+
+	if creds.GithubAppId > 0 {
+		m.GitHubAppID = types.StringValue(strconv.FormatInt(creds.GithubAppId, 10))
+	}
+
+	if creds.GithubAppInstallationId > 0 {
+		m.GitHubAppInstallationID = types.StringValue(strconv.FormatInt(creds.GithubAppInstallationId, 10))
+	}
+}
+
+// repositoryCredentialsWriteOnlyFields returns the fields of the repository
+// credentials which can be set from write-only attributes, keyed by the
+// attribute they are the variant of.
+func repositoryCredentialsWriteOnlyFields(creds *v1alpha1.RepoCreds) map[string]*string {
+	return map[string]*string{
+		"password":                &creds.Password,
+		"ssh_private_key":         &creds.SSHPrivateKey,
+		"tls_client_cert_key":     &creds.TLSClientCertKey,
+		"githubapp_private_key":   &creds.GithubAppPrivateKey,
+		"gcp_service_account_key": &creds.GCPServiceAccountKey,
+	}
+}
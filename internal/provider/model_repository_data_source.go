@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type repositoryDataSourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Repo                      types.String `tfsdk:"repo"`
+	Project                   types.String `tfsdk:"project"`
+	Name                      types.String `tfsdk:"name"`
+	Type                      types.String `tfsdk:"type"`
+	Username                  types.String `tfsdk:"username"`
+	Insecure                  types.Bool   `tfsdk:"insecure"`
+	EnableLFS                 types.Bool   `tfsdk:"enable_lfs"`
+	EnableOCI                 types.Bool   `tfsdk:"enable_oci"`
+	InheritedCreds            types.Bool   `tfsdk:"inherited_creds"`
+	ConnectionStateStatus     types.String `tfsdk:"connection_state_status"`
+	ConnectionStateMessage    types.String `tfsdk:"connection_state_message"`
+	ConnectionStateModifiedAt types.String `tfsdk:"connection_state_modified_at"`
+}
+
+// repositoryDataSourceSchemaAttributes returns the attributes of a repository
+// registered in ArgoCD. The repo and project are only configurable when they
+// are used to look up the repository.
+func repositoryDataSourceSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Repository identifier, of the form `<repo>|<project>` for project scoped repositories, or `<repo>` otherwise.",
+			Computed:            true,
+		},
+		"repo": schema.StringAttribute{
+			MarkdownDescription: "URL of the repository.",
+			Required:            lookup,
+			Computed:            !lookup,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Project the repository is scoped to, if any.",
+			Optional:            lookup,
+			Computed:            !lookup,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the repository. Only used with Helm repos.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the repository, either `git`, `helm` or `oci`.",
+			Computed:            true,
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "Username used for authenticating at the remote repository.",
+			Computed:            true,
+		},
+		"insecure": schema.BoolAttribute{
+			MarkdownDescription: "Whether the connection to the repository ignores any server-side host key or TLS certificate errors.",
+			Computed:            true,
+		},
+		"enable_lfs": schema.BoolAttribute{
+			MarkdownDescription: "Whether `git-lfs` support is enabled for the repository.",
+			Computed:            true,
+		},
+		"enable_oci": schema.BoolAttribute{
+			MarkdownDescription: "Whether `helm-oci` support is enabled for the repository.",
+			Computed:            true,
+		},
+		"inherited_creds": schema.BoolAttribute{
+			MarkdownDescription: "Whether credentials are inherited from matching repository credentials.",
+			Computed:            true,
+		},
+		"connection_state_status": schema.StringAttribute{
+			MarkdownDescription: "Status of the connection to the repository last observed by ArgoCD, e.g. `Successful` or `Failed`.",
+			Computed:            true,
+		},
+		"connection_state_message": schema.StringAttribute{
+			MarkdownDescription: "Human readable details of the connection state, e.g. why the connection failed.",
+			Computed:            true,
+		},
+		"connection_state_modified_at": schema.StringAttribute{
+			MarkdownDescription: "Time at which the connection state last changed.",
+			Computed:            true,
+		},
+	}
+}
+
+func newRepositoryDataSourceModel(r v1alpha1.Repository) repositoryDataSourceModel {
+	m := repositoryDataSourceModel{
+		ID:                        types.StringValue(r.Repo),
+		Repo:                      types.StringValue(r.Repo),
+		Project:                   utils.OptionalNonEmptyString(r.Project),
+		Name:                      utils.OptionalNonEmptyString(r.Name),
+		Type:                      types.StringValue(r.Type),
+		Username:                  utils.OptionalNonEmptyString(r.Username),
+		Insecure:                  types.BoolValue(r.Insecure),
+		EnableLFS:                 types.BoolValue(r.EnableLFS),
+		EnableOCI:                 types.BoolValue(r.EnableOCI),
+		InheritedCreds:            types.BoolValue(r.InheritedCreds),
+		ConnectionStateStatus:     utils.OptionalNonEmptyString(r.ConnectionState.Status),
+		ConnectionStateMessage:    utils.OptionalNonEmptyString(r.ConnectionState.Message),
+		ConnectionStateModifiedAt: utils.OptionalTimeString(r.ConnectionState.ModifiedAt),
+	}
+
+	if r.Project != "" {
+		m.ID = types.StringValue(r.Repo + "|" + r.Project)
+	}
+
+	return m
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceActionCustomizationModel struct {
+	ID                  types.String                             `tfsdk:"id"`
+	Group               types.String                             `tfsdk:"group"`
+	Kind                types.String                             `tfsdk:"kind"`
+	DiscoveryLua        types.String                             `tfsdk:"discovery_lua"`
+	Actions             []resourceActionCustomizationActionModel `tfsdk:"actions"`
+	MergeBuiltinActions types.Bool                               `tfsdk:"merge_builtin_actions"`
+}
+
+type resourceActionCustomizationActionModel struct {
+	Name types.String `tfsdk:"name"`
+	Lua  types.String `tfsdk:"lua"`
+}
+
+func resourceActionCustomizationSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource action customization identifier, of the form `<group>/<kind>`, or `<kind>` for resources of the core group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group": schema.StringAttribute{
+			MarkdownDescription: "API group of the resources, e.g. `argoproj.io`. Wildcards are supported, e.g. `*.crossplane.io`. Omit for resources of the core group.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "Kind of the resources, e.g. `Rollout`. Wildcards are supported, e.g. `*`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"discovery_lua": schema.StringAttribute{
+			MarkdownDescription: "Lua script returning the actions available for a resource, as a table keyed by action name. Each action may be marked as `disabled` or have an `iconClass` and a `displayName`, " +
+				"see [custom resource actions](https://argo-cd.readthedocs.io/en/stable/operator-manual/resource_actions/#custom-resource-actions).",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"actions": schema.ListNestedAttribute{
+			MarkdownDescription: "Definitions of the actions which may be returned by `discovery_lua`.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the action, e.g. `restart`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"lua": schema.StringAttribute{
+						MarkdownDescription: "Lua script performing the action, which returns the modified resource.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"merge_builtin_actions": schema.BoolAttribute{
+			MarkdownDescription: "Whether the [built-in actions](https://github.com/argoproj/argo-cd/tree/master/resource_customizations) of the resources are offered along with the custom ones, rather than being replaced by them. Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (m *resourceActionCustomizationModel) toResourceActions() *v1alpha1.ResourceActions {
+	actions := &v1alpha1.ResourceActions{
+		ActionDiscoveryLua:  m.DiscoveryLua.ValueString(),
+		Definitions:         make([]v1alpha1.ResourceActionDefinition, 0, len(m.Actions)),
+		MergeBuiltinActions: m.MergeBuiltinActions.ValueBool(),
+	}
+
+	for _, a := range m.Actions {
+		actions.Definitions = append(actions.Definitions, v1alpha1.ResourceActionDefinition{
+			Name:      a.Name.ValueString(),
+			ActionLua: a.Lua.ValueString(),
+		})
+	}
+
+	return actions
+}
+
+func newResourceActionCustomization(group, kind string, actions *v1alpha1.ResourceActions) *resourceActionCustomizationModel {
+	m := &resourceActionCustomizationModel{
+		ID:                  types.StringValue(resourceCustomizationID(group, kind)),
+		Group:               types.StringNull(),
+		Kind:                types.StringValue(kind),
+		DiscoveryLua:        types.StringValue(actions.ActionDiscoveryLua),
+		Actions:             make([]resourceActionCustomizationActionModel, 0, len(actions.Definitions)),
+		MergeBuiltinActions: types.BoolValue(actions.MergeBuiltinActions),
+	}
+
+	if group != "" {
+		m.Group = types.StringValue(group)
+	}
+
+	for _, a := range actions.Definitions {
+		m.Actions = append(m.Actions, resourceActionCustomizationActionModel{
+			Name: types.StringValue(a.Name),
+			Lua:  types.StringValue(a.ActionLua),
+		})
+	}
+
+	return m
+}
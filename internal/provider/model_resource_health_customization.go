@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceHealthCustomizationModel struct {
+	ID          types.String `tfsdk:"id"`
+	Group       types.String `tfsdk:"group"`
+	Kind        types.String `tfsdk:"kind"`
+	Lua         types.String `tfsdk:"lua"`
+	UseOpenLibs types.Bool   `tfsdk:"use_open_libs"`
+}
+
+// resourceCustomizationGroupKindRegex matches the groups and kinds which can
+// be encoded in the keys of resource customizations, i.e. which neither
+// contain the `_` separating them nor the `/` separating them in IDs.
+var resourceCustomizationGroupKindRegex = regexp.MustCompile(`^[-.*a-zA-Z0-9]+$`)
+
+func resourceHealthCustomizationSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource health customization identifier, of the form `<group>/<kind>`, or `<kind>` for resources of the core group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group": schema.StringAttribute{
+			MarkdownDescription: "API group of the resources, e.g. `cert-manager.io`. Wildcards are supported, e.g. `*.crossplane.io`. Omit for resources of the core group.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "Kind of the resources, e.g. `Certificate`. Wildcards are supported, e.g. `*`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"lua": schema.StringAttribute{
+			MarkdownDescription: "Lua script assessing the health of the resources, stored under the `resource.customizations.health.<group>_<kind>` key. The script must return a table with a `status` of `Healthy`, `Progressing`, `Degraded`, `Suspended` or `Missing` and an optional `message`, " +
+				"see [custom health checks](https://argo-cd.readthedocs.io/en/stable/operator-manual/health/#custom-health-checks).",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"use_open_libs": schema.BoolAttribute{
+			MarkdownDescription: "Whether the script may use the [standard libraries](https://www.lua.org/manual/5.1/manual.html#5) of Lua, stored under the `resource.customizations.useOpenLibs.<group>_<kind>` key. Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (m *resourceHealthCustomizationModel) toResourceHealthCustomization() *resourceHealthCustomization {
+	return &resourceHealthCustomization{
+		Group:       m.Group.ValueString(),
+		Kind:        m.Kind.ValueString(),
+		Lua:         m.Lua.ValueString(),
+		UseOpenLibs: m.UseOpenLibs.ValueBool(),
+	}
+}
+
+func newResourceHealthCustomization(c *resourceHealthCustomization) *resourceHealthCustomizationModel {
+	m := &resourceHealthCustomizationModel{
+		ID:          types.StringValue(resourceCustomizationID(c.Group, c.Kind)),
+		Group:       types.StringNull(),
+		Kind:        types.StringValue(c.Kind),
+		Lua:         types.StringValue(c.Lua),
+		UseOpenLibs: types.BoolValue(c.UseOpenLibs),
+	}
+
+	if c.Group != "" {
+		m.Group = types.StringValue(c.Group)
+	}
+
+	return m
+}
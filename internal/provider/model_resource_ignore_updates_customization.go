@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceIgnoreUpdatesCustomizationModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Group                 types.String   `tfsdk:"group"`
+	Kind                  types.String   `tfsdk:"kind"`
+	JSONPointers          []types.String `tfsdk:"json_pointers"`
+	JQPathExpressions     []types.String `tfsdk:"jq_path_expressions"`
+	ManagedFieldsManagers []types.String `tfsdk:"managed_fields_managers"`
+}
+
+func resourceIgnoreUpdatesCustomizationSchemaAttributes() map[string]schema.Attribute {
+	fields := []path.Expression{
+		path.MatchRoot("json_pointers"),
+		path.MatchRoot("jq_path_expressions"),
+		path.MatchRoot("managed_fields_managers"),
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource ignore updates customization identifier, of the form `<group>/<kind>`, or `<kind>` for resources of the core group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group": schema.StringAttribute{
+			MarkdownDescription: "API group of the resources, e.g. `apps`. Wildcards are supported, e.g. `*.crossplane.io`. Omit for resources of the core group.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "Kind of the resources, e.g. `Deployment`. Wildcards are supported, e.g. `*`. Set to `all` without `group` to ignore the fields of all resources.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(resourceCustomizationGroupKindRegex, "must consist of alphanumeric characters, '-', '.' or '*'"),
+			},
+		},
+		"json_pointers": schema.ListAttribute{
+			MarkdownDescription: "[JSON pointers](https://datatracker.ietf.org/doc/html/rfc6901) of the fields to ignore, e.g. `/status`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.AtLeastOneOf(fields...),
+			},
+		},
+		"jq_path_expressions": schema.ListAttribute{
+			MarkdownDescription: "[JQ path expressions](https://jqlang.github.io/jq/manual/#path-expression) of the fields to ignore, e.g. `.metadata.annotations.\"autoscaling.alpha.kubernetes.io/conditions\"`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.AtLeastOneOf(fields...),
+			},
+		},
+		"managed_fields_managers": schema.ListAttribute{
+			MarkdownDescription: "Field managers whose changes are ignored, e.g. `kube-controller-manager`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.AtLeastOneOf(fields...),
+			},
+		},
+	}
+}
+
+func (m *resourceIgnoreUpdatesCustomizationModel) toOverrideIgnoreDiff() *v1alpha1.OverrideIgnoreDiff {
+	return &v1alpha1.OverrideIgnoreDiff{
+		JSONPointers:          pie.Map(m.JSONPointers, types.String.ValueString),
+		JQPathExpressions:     pie.Map(m.JQPathExpressions, types.String.ValueString),
+		ManagedFieldsManagers: pie.Map(m.ManagedFieldsManagers, types.String.ValueString),
+	}
+}
+
+func newResourceIgnoreUpdatesCustomization(group, kind string, ignore *v1alpha1.OverrideIgnoreDiff) *resourceIgnoreUpdatesCustomizationModel {
+	m := &resourceIgnoreUpdatesCustomizationModel{
+		ID:    types.StringValue(resourceCustomizationID(group, kind)),
+		Group: types.StringNull(),
+		Kind:  types.StringValue(kind),
+	}
+
+	if group != "" {
+		m.Group = types.StringValue(group)
+	}
+
+	if len(ignore.JSONPointers) > 0 {
+		m.JSONPointers = pie.Map(ignore.JSONPointers, types.StringValue)
+	}
+
+	if len(ignore.JQPathExpressions) > 0 {
+		m.JQPathExpressions = pie.Map(ignore.JQPathExpressions, types.StringValue)
+	}
+
+	if len(ignore.ManagedFieldsManagers) > 0 {
+		m.ManagedFieldsManagers = pie.Map(ignore.ManagedFieldsManagers, types.StringValue)
+	}
+
+	return m
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type settingsModel struct {
+	ID                    types.String `tfsdk:"id"`
+	URL                   types.String `tfsdk:"url"`
+	AnonymousUsersEnabled types.Bool   `tfsdk:"anonymous_users_enabled"`
+	StatusBadgeEnabled    types.Bool   `tfsdk:"status_badge_enabled"`
+	ReconciliationTimeout types.String `tfsdk:"reconciliation_timeout"`
+	InstanceLabelKey      types.String `tfsdk:"instance_label_key"`
+	ExecEnabled           types.Bool   `tfsdk:"exec_enabled"`
+	AdminEnabled          types.Bool   `tfsdk:"admin_enabled"`
+}
+
+// settingsAttributePaths lists the attributes of the managed settings, at
+// least one of which must be configured.
+var settingsAttributePaths = []path.Expression{
+	path.MatchRoot("url"),
+	path.MatchRoot("anonymous_users_enabled"),
+	path.MatchRoot("status_badge_enabled"),
+	path.MatchRoot("reconciliation_timeout"),
+	path.MatchRoot("instance_label_key"),
+	path.MatchRoot("exec_enabled"),
+	path.MatchRoot("admin_enabled"),
+}
+
+func settingsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Settings identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "External URL of ArgoCD, e.g. `https://argocd.example.com`, stored under the `url` key. Required for SSO and used in links, e.g. within notifications.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https?://[^\s]+$`), "must be an HTTP(S) URL"),
+			},
+		},
+		"anonymous_users_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether anonymous users may access ArgoCD, with the permissions of the default role of the RBAC configuration, stored under the `users.anonymous.enabled` key.",
+			Optional:            true,
+		},
+		"status_badge_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the [status badges](https://argo-cd.readthedocs.io/en/stable/user-guide/status-badge/) of applications are served, stored under the `statusbadge.enabled` key.",
+			Optional:            true,
+		},
+		"reconciliation_timeout": schema.StringAttribute{
+			MarkdownDescription: "Interval at which applications are compared against their sources, e.g. `3m`, stored under the `timeout.reconciliation` key. `0s` disables periodic reconciliation.",
+			Optional:            true,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		"instance_label_key": schema.StringAttribute{
+			MarkdownDescription: "Label used to track the resources of applications, e.g. `argocd.argoproj.io/instance`, stored under the `application.instanceLabelKey` key.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"exec_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether users may open a terminal within the containers of applications through the UI, stored under the `exec.enabled` key.",
+			Optional:            true,
+		},
+		"admin_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the built-in `admin` account is enabled, stored under the `admin.enabled` key. **Note**: the provider may no longer be able to authenticate if it uses the `admin` account.",
+			Optional:            true,
+		},
+	}
+}
+
+// toGeneralSettings returns the values of the configured settings, keyed by
+// their ConfigMap key.
+func (m *settingsModel) toGeneralSettings() map[string]string {
+	settings := make(map[string]string)
+
+	setString := func(key string, v types.String) {
+		if !v.IsNull() {
+			settings[key] = v.ValueString()
+		}
+	}
+
+	setBool := func(key string, v types.Bool) {
+		if !v.IsNull() {
+			settings[key] = strconv.FormatBool(v.ValueBool())
+		}
+	}
+
+	setString(settingsURLKey, m.URL)
+	setBool(settingsAnonymousUsersEnabledKey, m.AnonymousUsersEnabled)
+	setBool(settingsStatusBadgeEnabledKey, m.StatusBadgeEnabled)
+	setString(settingsReconciliationTimeoutKey, m.ReconciliationTimeout)
+	setString(settingsInstanceLabelKeyKey, m.InstanceLabelKey)
+	setBool(settingsExecEnabledKey, m.ExecEnabled)
+	setBool(settingsAdminEnabledKey, m.AdminEnabled)
+
+	return settings
+}
+
+// isEmpty reports whether none of the settings are managed, which is only the
+// case right after the resource has been imported.
+func (m *settingsModel) isEmpty() bool {
+	return len(m.toGeneralSettings()) == 0
+}
+
+// updateFromGeneralSettings refreshes the managed settings from the given
+// values, or all of them if all is set. Settings which have been removed
+// out-of-band are set to null.
+func (m *settingsModel) updateFromGeneralSettings(settings map[string]string, all bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(common.ArgoCDConfigMapName)
+
+	updateString := func(key string, v *types.String) {
+		if !all && v.IsNull() {
+			return
+		}
+
+		*v = types.StringNull()
+
+		if s, ok := settings[key]; ok {
+			*v = types.StringValue(s)
+		}
+	}
+
+	updateBool := func(key string, v *types.Bool) {
+		if !all && v.IsNull() {
+			return
+		}
+
+		*v = types.BoolNull()
+
+		if s, ok := settings[key]; ok {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				diags.AddError("Invalid Setting", fmt.Sprintf("value %q of key %s is not a boolean", s, key))
+				return
+			}
+
+			*v = types.BoolValue(b)
+		}
+	}
+
+	updateString(settingsURLKey, &m.URL)
+	updateBool(settingsAnonymousUsersEnabledKey, &m.AnonymousUsersEnabled)
+	updateBool(settingsStatusBadgeEnabledKey, &m.StatusBadgeEnabled)
+	updateString(settingsReconciliationTimeoutKey, &m.ReconciliationTimeout)
+	updateString(settingsInstanceLabelKeyKey, &m.InstanceLabelKey)
+	updateBool(settingsExecEnabledKey, &m.ExecEnabled)
+	updateBool(settingsAdminEnabledKey, &m.AdminEnabled)
+
+	return diags
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/v3/util/cert"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type sshKnownHostsModel struct {
+	ID           types.String              `tfsdk:"id"`
+	Entries      []sshKnownHostsEntryModel `tfsdk:"entries"`
+	Fingerprints types.Map                 `tfsdk:"fingerprints"`
+}
+
+type sshKnownHostsEntryModel struct {
+	ServerName  types.String `tfsdk:"server_name"`
+	CertSubType types.String `tfsdk:"cert_subtype"`
+	CertData    types.String `tfsdk:"cert_data"`
+}
+
+func sshKnownHostsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "SSH known hosts identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"entries": schema.SetNestedAttribute{
+			MarkdownDescription: "SSH known host entries. At most one key can be specified per server name and sub type.",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"server_name": schema.StringAttribute{
+						MarkdownDescription: "DNS name of the server the key is intended for.",
+						Required:            true,
+					},
+					"cert_subtype": schema.StringAttribute{
+						MarkdownDescription: "The sub type of the key, i.e. `ssh-rsa`.",
+						Required:            true,
+					},
+					"cert_data": schema.StringAttribute{
+						MarkdownDescription: "The base64 encoded public key of the server, as found in `known_hosts` files.",
+						Required:            true,
+					},
+				},
+			},
+			Validators: []validator.Set{
+				validators.SSHKnownHosts(),
+			},
+		},
+		"fingerprints": schema.MapAttribute{
+			MarkdownDescription: "SHA256 fingerprints of the keys, keyed by `<cert_subtype>/<server_name>`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// key returns the key under which ArgoCD stores the entry. There can only be
+// one entry per key.
+func (m sshKnownHostsEntryModel) key() string {
+	return m.CertSubType.ValueString() + "/" + m.ServerName.ValueString()
+}
+
+func (m sshKnownHostsEntryModel) fingerprint() string {
+	_, key, err := certutil.TokenizedDataToPublicKey(m.ServerName.ValueString(), m.CertSubType.ValueString(), strings.TrimSpace(m.CertData.ValueString()))
+	if err != nil {
+		return ""
+	}
+
+	return "SHA256:" + certutil.SSHFingerprintSHA256(key)
+}
+
+func (m sshKnownHostsEntryModel) toAPIModel() v1alpha1.RepositoryCertificate {
+	return v1alpha1.RepositoryCertificate{
+		CertType:    sshCertType,
+		ServerName:  m.ServerName.ValueString(),
+		CertSubType: m.CertSubType.ValueString(),
+		CertData:    []byte(strings.TrimSpace(m.CertData.ValueString())),
+	}
+}
+
+func (m *sshKnownHostsModel) fingerprints() map[string]string {
+	fingerprints := make(map[string]string, len(m.Entries))
+
+	for _, e := range m.Entries {
+		fingerprints[e.key()] = e.fingerprint()
+	}
+
+	return fingerprints
+}
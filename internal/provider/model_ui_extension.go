@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type uiExtensionModel struct {
+	ID                    types.String              `tfsdk:"id"`
+	Name                  types.String              `tfsdk:"name"`
+	Services              []uiExtensionServiceModel `tfsdk:"services"`
+	ConnectionTimeout     types.String              `tfsdk:"connection_timeout"`
+	KeepAlive             types.String              `tfsdk:"keep_alive"`
+	IdleConnectionTimeout types.String              `tfsdk:"idle_connection_timeout"`
+	MaxIdleConnections    types.Int64               `tfsdk:"max_idle_connections"`
+}
+
+type uiExtensionServiceModel struct {
+	URL     types.String             `tfsdk:"url"`
+	Cluster *uiExtensionClusterModel `tfsdk:"cluster"`
+	Headers []uiExtensionHeaderModel `tfsdk:"headers"`
+}
+
+type uiExtensionClusterModel struct {
+	Name   types.String `tfsdk:"name"`
+	Server types.String `tfsdk:"server"`
+}
+
+type uiExtensionHeaderModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func uiExtensionSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "UI extension identifier, i.e. its name.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the extension, e.g. `metrics`. Requests to `<url>/extensions/<name>/`, where `<url>` is the external URL of ArgoCD, are proxied to the backend service.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9-_]+$`), "must consist of alphanumeric characters, '-' or '_'"),
+			},
+		},
+		"services": schema.ListNestedAttribute{
+			MarkdownDescription: "Backend services of the extension. If more than one service is configured, each must be bound to the cluster whose applications it serves.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL of the backend service, e.g. `http://argo-rollouts-metrics.argo-rollouts:8080`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(oidcURLRegex, "must be an HTTP(S) URL"),
+						},
+					},
+					"cluster": schema.SingleNestedAttribute{
+						MarkdownDescription: "Cluster the service is bound to, i.e. only requests for applications deployed to the cluster are proxied to the service.",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.AtLeastOneOf(
+								path.MatchRelative().AtName("name"),
+								path.MatchRelative().AtName("server"),
+							),
+						},
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Name of the cluster.",
+								Optional:            true,
+							},
+							"server": schema.StringAttribute{
+								MarkdownDescription: "URL of the API server of the cluster.",
+								Optional:            true,
+							},
+						},
+					},
+					"headers": schema.ListNestedAttribute{
+						MarkdownDescription: "Headers added to the requests proxied to the service.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Name of the header, e.g. `Authorization`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Value of the header. Since the value is stored in the `argocd-cm` ConfigMap, sensitive values should reference a key of the `argocd-secret` Secret, e.g. `$extension.metrics.token`.",
+									Required:            true,
+									Sensitive:           true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"connection_timeout": schema.StringAttribute{
+			MarkdownDescription: "Maximum amount of time to wait for a connection to the backend service to be established, e.g. `2s`. ArgoCD defaults to `2s` if unset.",
+			Optional:            true,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		"keep_alive": schema.StringAttribute{
+			MarkdownDescription: "Interval between keep-alive probes of connections to the backend service, e.g. `15s`. ArgoCD defaults to `15s` if unset.",
+			Optional:            true,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		"idle_connection_timeout": schema.StringAttribute{
+			MarkdownDescription: "Maximum amount of time an idle connection to the backend service is kept open, e.g. `60s`. ArgoCD defaults to `60s` if unset.",
+			Optional:            true,
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		"max_idle_connections": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of idle connections to the backend service. ArgoCD defaults to `30` if unset.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func (m *uiExtensionModel) toUIExtensionBackend() *uiExtensionBackend {
+	b := &uiExtensionBackend{
+		ConnectionTimeout:     m.ConnectionTimeout.ValueString(),
+		KeepAlive:             m.KeepAlive.ValueString(),
+		IdleConnectionTimeout: m.IdleConnectionTimeout.ValueString(),
+		MaxIdleConnections:    m.MaxIdleConnections.ValueInt64(),
+		Services:              make([]uiExtensionService, 0, len(m.Services)),
+	}
+
+	for _, s := range m.Services {
+		service := uiExtensionService{
+			URL: s.URL.ValueString(),
+		}
+
+		if s.Cluster != nil {
+			service.Cluster = &uiExtensionCluster{
+				Name:   s.Cluster.Name.ValueString(),
+				Server: s.Cluster.Server.ValueString(),
+			}
+		}
+
+		for _, h := range s.Headers {
+			service.Headers = append(service.Headers, uiExtensionHeader{
+				Name:  h.Name.ValueString(),
+				Value: h.Value.ValueString(),
+			})
+		}
+
+		b.Services = append(b.Services, service)
+	}
+
+	return b
+}
+
+func newUIExtension(name string, b *uiExtensionBackend) *uiExtensionModel {
+	m := &uiExtensionModel{
+		ID:                    types.StringValue(name),
+		Name:                  types.StringValue(name),
+		Services:              make([]uiExtensionServiceModel, 0, len(b.Services)),
+		ConnectionTimeout:     optionalString(b.ConnectionTimeout),
+		KeepAlive:             optionalString(b.KeepAlive),
+		IdleConnectionTimeout: optionalString(b.IdleConnectionTimeout),
+		MaxIdleConnections:    types.Int64Null(),
+	}
+
+	if b.MaxIdleConnections > 0 {
+		m.MaxIdleConnections = types.Int64Value(b.MaxIdleConnections)
+	}
+
+	for _, s := range b.Services {
+		service := uiExtensionServiceModel{
+			URL: types.StringValue(s.URL),
+		}
+
+		if s.Cluster != nil {
+			service.Cluster = &uiExtensionClusterModel{
+				Name:   optionalString(s.Cluster.Name),
+				Server: optionalString(s.Cluster.Server),
+			}
+		}
+
+		for _, h := range s.Headers {
+			service.Headers = append(service.Headers, uiExtensionHeaderModel{
+				Name:  types.StringValue(h.Name),
+				Value: types.StringValue(h.Value),
+			})
+		}
+
+		m.Services = append(m.Services, service)
+	}
+
+	return m
+}
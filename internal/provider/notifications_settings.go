@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage notification triggers within the
+// `argocd-notifications-cm` ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/notifications/triggers/).
+// Each trigger is stored as a YAML list of conditions under its own key and
+// written through merge patches, so that any other settings, e.g. templates
+// and services, are retained.
+
+func notificationsTriggerKey(name string) string {
+	return "trigger." + name
+}
+
+const notificationsTemplateKeyPrefix = "template."
+
+func readNotificationsTrigger(ctx context.Context, kc kubernetes.Interface, namespace, name string) ([]triggers.Condition, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDNotificationsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := cm.Data[notificationsTriggerKey(name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), notificationsTriggerKey(name))
+	}
+
+	var conditions []triggers.Condition
+	if err = yaml.Unmarshal([]byte(value), &conditions); err != nil {
+		return nil, err
+	}
+
+	return conditions, nil
+}
+
+func createNotificationsTrigger(ctx context.Context, kc kubernetes.Interface, namespace, name string, conditions []triggers.Condition) error {
+	_, err := readNotificationsTrigger(ctx, kc, namespace, name)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), notificationsTriggerKey(name))
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateNotificationsTrigger(ctx, kc, namespace, name, conditions)
+}
+
+func updateNotificationsTrigger(ctx context.Context, kc kubernetes.Interface, namespace, name string, conditions []triggers.Condition) error {
+	value, err := yaml.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+
+	patch, err := dataMergePatch(map[string]any{
+		notificationsTriggerKey(name): string(value),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDNotificationsConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func deleteNotificationsTrigger(ctx context.Context, kc kubernetes.Interface, namespace, name string) error {
+	patch, err := dataMergePatch(map[string]any{
+		notificationsTriggerKey(name): nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDNotificationsConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// readNotificationsTemplates returns the sorted names of the templates
+// declared in the ConfigMap, or none if it does not exist.
+func readNotificationsTemplates(ctx context.Context, kc kubernetes.Interface, namespace string) ([]string, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDNotificationsConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var templates []string
+
+	for k := range cm.Data {
+		if name, ok := strings.CutPrefix(k, notificationsTemplateKeyPrefix); ok {
+			templates = append(templates, name)
+		}
+	}
+
+	sort.Strings(templates)
+
+	return templates, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNotificationsTriggerLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDNotificationsConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"template.app-deployed":  "message: Application {{.app.metadata.name}} has been deployed.",
+				"template.app-degraded":  "message: Application {{.app.metadata.name}} has degraded.",
+				"service.slack":          "token: $slack-token",
+				"trigger.on-out-of-sync": "- when: app.status.sync.status == 'OutOfSync'\n  send: [app-sync-status-unknown]\n",
+			},
+		},
+	)
+
+	templates, err := readNotificationsTemplates(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, []string{"app-degraded", "app-deployed"}, templates)
+
+	if _, err = readNotificationsTrigger(ctx, kc, "argocd", "on-deployed"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	conditions := []triggers.Condition{
+		{
+			When:    "app.status.operationState.phase in ['Succeeded'] and app.status.health.status == 'Healthy'",
+			Send:    []string{"app-deployed"},
+			OncePer: "app.status.sync.revision",
+		},
+	}
+
+	if err = createNotificationsTrigger(ctx, kc, "argocd", "on-deployed", conditions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = createNotificationsTrigger(ctx, kc, "argocd", "on-deployed", conditions); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	read, err := readNotificationsTrigger(ctx, kc, "argocd", "on-deployed")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, conditions, read)
+
+	conditions = append(conditions, triggers.Condition{
+		When:        "app.status.health.status == 'Degraded'",
+		Send:        []string{"app-degraded"},
+		Description: "Application has degraded",
+	})
+
+	if err = updateNotificationsTrigger(ctx, kc, "argocd", "on-deployed", conditions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err = readNotificationsTrigger(ctx, kc, "argocd", "on-deployed")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, conditions, read)
+
+	if err = deleteNotificationsTrigger(ctx, kc, "argocd", "on-deployed"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDNotificationsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Any other settings are retained
+	assert.NotContains(t, cm.Data, "trigger.on-deployed")
+	assert.Len(t, cm.Data, 4)
+}
+
+func TestNotificationsTemplatesMissingConfigMap(t *testing.T) {
+	t.Parallel()
+
+	templates, err := readNotificationsTemplates(context.Background(), fake.NewClientset(), "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Empty(t, templates)
+}
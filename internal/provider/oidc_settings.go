@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage the OIDC configuration within the `oidc.config`
+// key of the `argocd-cm` ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#existing-oidc-provider).
+// The configuration is stored as YAML and written through merge patches, so
+// that any other settings are retained.
+
+const oidcConfigKey = "oidc.config"
+
+// oidcConfig mirrors the fields of the OIDC configuration of ArgoCD which can
+// be managed. Any other fields are dropped when the configuration is written.
+type oidcConfig struct {
+	Name                     string                `json:"name,omitempty"`
+	Issuer                   string                `json:"issuer,omitempty"`
+	ClientID                 string                `json:"clientID,omitempty"`
+	ClientSecret             string                `json:"clientSecret,omitempty"`
+	CLIClientID              string                `json:"cliClientID,omitempty"`
+	RequestedScopes          []string              `json:"requestedScopes,omitempty"`
+	RequestedIDTokenClaims   map[string]*oidcClaim `json:"requestedIDTokenClaims,omitempty"`
+	LogoutURL                string                `json:"logoutURL,omitempty"`
+	RootCA                   string                `json:"rootCA,omitempty"`
+	EnablePKCEAuthentication bool                  `json:"enablePKCEAuthentication,omitempty"`
+}
+
+type oidcClaim struct {
+	Essential bool     `json:"essential,omitempty"`
+	Value     string   `json:"value,omitempty"`
+	Values    []string `json:"values,omitempty"`
+}
+
+func readOIDCConfig(ctx context.Context, kc kubernetes.Interface, namespace string) (*oidcConfig, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := cm.Data[oidcConfigKey]
+	if !ok {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), oidcConfigKey)
+	}
+
+	var c oidcConfig
+	if err = yaml.Unmarshal([]byte(value), &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func createOIDCConfig(ctx context.Context, kc kubernetes.Interface, namespace string, c *oidcConfig) error {
+	_, err := readOIDCConfig(ctx, kc, namespace)
+	if err == nil {
+		return apierrors.NewAlreadyExists(corev1.Resource("configmaps"), oidcConfigKey)
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return updateOIDCConfig(ctx, kc, namespace, c)
+}
+
+func updateOIDCConfig(ctx context.Context, kc kubernetes.Interface, namespace string, c *oidcConfig) error {
+	value, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	patch, err := dataMergePatch(map[string]any{
+		oidcConfigKey: string(value),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func deleteOIDCConfig(ctx context.Context, kc kubernetes.Interface, namespace string) error {
+	patch, err := dataMergePatch(map[string]any{
+		oidcConfigKey: nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// oidcSecretReference returns the value ArgoCD substitutes with the given key
+// of a Secret, see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#sensitive-data-and-sso-client-secrets.
+// Keys of the `argocd-secret` Secret are referenced without the name of the
+// Secret.
+func oidcSecretReference(name, key string) string {
+	if name == "" || name == common.ArgoCDSecretName {
+		return "$" + key
+	}
+
+	return "$" + name + ":" + key
+}
+
+// parseOIDCSecretReference splits a reference of the form `$<name>:<key>`, or
+// `$<key>` for keys of the `argocd-secret` Secret, into its components.
+func parseOIDCSecretReference(ref string) (name, key string, err error) {
+	if !strings.HasPrefix(ref, "$") || len(ref) == 1 {
+		return "", "", fmt.Errorf("value is not a Secret reference of the form `$<name>:<key>` or `$<key>`")
+	}
+
+	name, key, found := strings.Cut(ref[1:], ":")
+	if !found {
+		return common.ArgoCDSecretName, name, nil
+	}
+
+	if name == "" || key == "" {
+		return "", "", fmt.Errorf("invalid Secret reference %q, expected format `$<name>:<key>` or `$<key>`", ref)
+	}
+
+	return name, key, nil
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestOIDCConfigLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"url": "https://argocd.example.com",
+			},
+		},
+	)
+
+	if _, err := readOIDCConfig(ctx, kc, "argocd"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	c := &oidcConfig{
+		Name:            "Okta",
+		Issuer:          "https://example.okta.com",
+		ClientID:        "argocd",
+		ClientSecret:    "$oidc.okta.clientSecret",
+		RequestedScopes: []string{"openid", "profile", "email", "groups"},
+		RequestedIDTokenClaims: map[string]*oidcClaim{
+			"groups": {Essential: true},
+		},
+	}
+
+	if err := createOIDCConfig(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := createOIDCConfig(ctx, kc, "argocd", c); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, `clientID: argocd
+clientSecret: $oidc.okta.clientSecret
+issuer: https://example.okta.com
+name: Okta
+requestedIDTokenClaims:
+  groups:
+    essential: true
+requestedScopes:
+- openid
+- profile
+- email
+- groups
+`, cm.Data["oidc.config"])
+
+	c.EnablePKCEAuthentication = true
+	c.ClientSecret = ""
+
+	if err = updateOIDCConfig(ctx, kc, "argocd", c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err := readOIDCConfig(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, c, read)
+
+	if err = deleteOIDCConfig(ctx, kc, "argocd"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Any other settings are retained
+	assert.Equal(t, map[string]string{"url": "https://argocd.example.com"}, cm.Data)
+}
+
+func TestParseOIDCSecretReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref      string
+		wantName string
+		wantKey  string
+		wantErr  bool
+	}{
+		{ref: "$oidc.clientSecret", wantName: common.ArgoCDSecretName, wantKey: "oidc.clientSecret"},
+		{ref: "$okta-oidc:clientSecret", wantName: "okta-oidc", wantKey: "clientSecret"},
+		{ref: "plain-text-secret", wantErr: true},
+		{ref: "$", wantErr: true},
+		{ref: "$okta-oidc:", wantErr: true},
+		{ref: "$:clientSecret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			t.Parallel()
+
+			name, key, err := parseOIDCSecretReference(tt.ref)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.ref, oidcSecretReference(name, key))
+		})
+	}
+}
+
+func TestOIDCConfigModel(t *testing.T) {
+	t.Parallel()
+
+	m := newOIDCConfig(&oidcConfig{
+		Name:         "Okta",
+		Issuer:       "https://example.okta.com",
+		ClientID:     "argocd",
+		ClientSecret: "$okta-oidc:clientSecret",
+		RequestedIDTokenClaims: map[string]*oidcClaim{
+			"groups": nil,
+			"acr":    {Values: []string{"phr", "phrh"}},
+		},
+	})
+
+	assert.Equal(t, &oidcClientSecretModel{Name: types.StringValue("okta-oidc"), Key: types.StringValue("clientSecret")}, m.ClientSecret)
+	assert.Equal(t, types.StringNull(), m.CLIClientID)
+	assert.Nil(t, m.RequestedScopes)
+	assert.Equal(t, oidcClaimModel{Essential: types.BoolValue(false), Value: types.StringNull()}, m.RequestedIDTokenClaims["groups"])
+	assert.Equal(t, []types.String{types.StringValue("phr"), types.StringValue("phrh")}, m.RequestedIDTokenClaims["acr"].Values)
+
+	c := m.toOIDCConfig()
+
+	assert.Equal(t, "$okta-oidc:clientSecret", c.ClientSecret)
+	assert.Equal(t, &oidcClaim{}, c.RequestedIDTokenClaims["groups"])
+
+	// Client secrets stored in plain text are not tracked
+	m = newOIDCConfig(&oidcConfig{ClientSecret: "plain-text-secret"})
+
+	assert.Nil(t, m.ClientSecret)
+}
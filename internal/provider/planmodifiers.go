@@ -90,3 +90,44 @@ func (m useUnknownOnUpdateInt64Modifier) PlanModifyInt64(_ context.Context, req
 	// No change to the resource, preserve the state value
 	resp.PlanValue = req.StateValue
 }
+
+// UseUnknownOnUpdateList returns a plan modifier for computed List attributes
+// that sets the value to unknown whenever the resource is being updated, e.g.
+// for lists which are resolved from other objects on the server. Attributes
+// using it must be modelled as types.List, since unknown values cannot be
+// read into slices.
+func UseUnknownOnUpdateList() planmodifier.List {
+	return useUnknownOnUpdateListModifier{}
+}
+
+type useUnknownOnUpdateListModifier struct{}
+
+func (m useUnknownOnUpdateListModifier) Description(_ context.Context) string {
+	return "Sets the list to unknown during updates since its elements are derived from server-side state, e.g. other objects referencing the resource, which may change along with the resource."
+}
+
+func (m useUnknownOnUpdateListModifier) MarkdownDescription(_ context.Context) string {
+	return "Sets the list to unknown during updates since its elements are derived from server-side state, e.g. other objects referencing the resource, which may change along with the resource."
+}
+
+func (m useUnknownOnUpdateListModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// If there's no state (create), leave as unknown (default behavior)
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// If the plan is being destroyed, no need to modify
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// This is an update - check if any values in the resource are changing
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		// Resource is being modified, mark as unknown so any value is accepted
+		resp.PlanValue = types.ListUnknown(req.PlanValue.ElementType(ctx))
+		return
+	}
+
+	// No change to the resource, preserve the state value
+	resp.PlanValue = req.StateValue
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +18,8 @@ import (
 
 // Ensure ArgoCDProvider satisfies various provider interfaces.
 var _ provider.Provider = (*ArgoCDProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*ArgoCDProvider)(nil)
+var _ provider.ProviderWithFunctions = (*ArgoCDProvider)(nil)
 
 type ArgoCDProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -55,7 +59,9 @@ func (p *ArgoCDProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description: "Configure direct access using Kubernetes API server.\n\n  " +
 					"**Warning**: this feature works by starting a local ArgoCD API server that talks directly to the Kubernetes API using the **current context " +
 					"in the default kubeconfig** (`~/.kube/config`). This behavior cannot be overridden using either environment variables or the `kubernetes` block " +
-					"in the provider configuration at present).\n\n  If the server fails to start (e.g. your kubeconfig is misconfigured) then the provider will " +
+					"in the provider configuration at present).\n\n  Resources which manage Kubernetes objects directly, e.g. the ConfigMaps holding the settings of ArgoCD or the secrets " +
+					"managed through `direct_secret`, manage them in the namespace of this context, which hence has to be the namespace ArgoCD is installed in.\n\n  " +
+					"If the server fails to start (e.g. your kubeconfig is misconfigured) then the provider will " +
 					"fail as a result of the `argocd` module forcing it to exit and no logs will be available to help you debug this. The error message will be " +
 					"similar to\n  > `The plugin encountered an error, and failed to respond to the plugin.(*GRPCProvider).ReadResource call. The plugin logs may " +
 					"contain more details.`\n\n  To debug this, you will need to login via the ArgoCD CLI using `argocd login --core` and then running an operation. " +
@@ -249,6 +255,7 @@ func (p *ArgoCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	server := NewServerInterface(config)
 
 	resp.DataSourceData = server
+	resp.EphemeralResourceData = server
 	resp.ResourceData = server
 }
 
@@ -257,14 +264,54 @@ func (p *ArgoCDProvider) Resources(context.Context) []func() resource.Resource {
 		NewGPGKeyResource,
 		NewRepositoryResource,
 		NewRepositoryCertificateResource,
+		NewSSHKnownHostsResource,
 		NewRepositoryCredentialsResource,
 		NewProjectResource,
 		NewProjectTokenResource,
+		NewAccountResource,
+		NewAccountPasswordResource,
+		NewRBACResource,
+		NewNotificationsTriggerResource,
+		NewResourceHealthCustomizationResource,
+		NewResourceActionCustomizationResource,
+		NewResourceIgnoreUpdatesCustomizationResource,
+		NewSettingsResource,
+		NewOIDCConfigResource,
+		NewDexConnectorResource,
+		NewUIExtensionResource,
+		NewCompareOptionsResource,
+	}
+}
+
+func (p *ArgoCDProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewProjectTokenEphemeralResource,
+		NewAccountTokenEphemeralResource,
+		NewSessionTokenEphemeralResource,
+	}
+}
+
+func (p *ArgoCDProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewRBACPolicyFunction,
+		NewRBACValidateFunction,
 	}
 }
 
 func (p *ArgoCDProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewArgoCDApplicationDataSource,
+		NewClusterDataSource,
+		NewClustersDataSource,
+		NewRepositoryDataSource,
+		NewRepositoriesDataSource,
+		NewGPGKeyDataSource,
+		NewGPGKeysDataSource,
+		NewAccountDataSource,
+		NewAccountsDataSource,
+		NewRBACCanIDataSource,
+		NewProjectTokensDataSource,
+		NewCommunityHealthChecksDataSource,
+		NewCertificatesDataSource,
 	}
 }
@@ -2,18 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/argoproj-labs/terraform-provider-argocd/argocd"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/testhelpers"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"k8s.io/client-go/kubernetes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -89,6 +94,56 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccCoreProviderConfig configures the provider to manage the settings of
+// ArgoCD through the Kubernetes API, using the current context of KUBECONFIG
+const testAccCoreProviderConfig = `
+provider "argocd" {
+  core = true
+}
+`
+
+func testAccPreCheckCore(t *testing.T) {
+	testAccPreCheck(t)
+
+	if _, _, err := getKubernetesClient(); err != nil {
+		t.Fatalf("a kubeconfig of the cluster ArgoCD is installed in must be available for acceptance tests with `core = true`: %s", err)
+	}
+}
+
+// build Kubernetes client of the cluster ArgoCD is installed in
+func getKubernetesClient() (kubernetes.Interface, string, error) {
+	si := NewServerInterface(ArgoCDProviderConfig{
+		Core: types.BoolValue(true),
+	})
+
+	return si.KubernetesClient()
+}
+
+// build & init ArgoCD server interface
+func getServerInterface() (*ServerInterface, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	insecure, err := strconv.ParseBool(os.Getenv("ARGOCD_INSECURE"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse 'ARGOCD_INSECURE' env var to bool: %s", err.Error())
+	}
+
+	si := NewServerInterface(ArgoCDProviderConfig{
+		ServerAddr: types.StringValue(os.Getenv("ARGOCD_SERVER")),
+		Insecure:   types.BoolValue(insecure),
+		Username:   types.StringValue(os.Getenv("ARGOCD_AUTH_USERNAME")),
+		Password:   types.StringValue(os.Getenv("ARGOCD_AUTH_PASSWORD")),
+	})
+
+	diag := si.InitClients(ctx)
+	if diag.HasError() {
+		return nil, fmt.Errorf("failed to init clients: %v", diag.Errors())
+	}
+
+	return si, nil
+}
+
 // Skip test if feature is not supported
 func testAccPreCheckFeatureSupported(t *testing.T, feature features.Feature) {
 	v := os.Getenv("ARGOCD_VERSION")
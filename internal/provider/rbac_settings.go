@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// The functions below manage the global RBAC configuration within the
+// `argocd-rbac-cm` ConfigMap (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/). Only the
+// keys below are written, through merge patches, so that any other settings,
+// e.g. policies added by other tools under `policy.<name>.csv`, are retained.
+// Keys are owned individually, so that the ones which are not configured may
+// be managed by other tools, e.g. the Helm chart of ArgoCD.
+
+// rbacSettings holds the managed keys of the ConfigMap. Empty values denote
+// keys which are not managed.
+type rbacSettings struct {
+	PolicyCSV     string
+	PolicyDefault string
+	Scopes        []string
+	MatchMode     string
+}
+
+func readRBACSettings(ctx context.Context, kc kubernetes.Interface, namespace string) (*rbacSettings, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &rbacSettings{
+		PolicyCSV:     cm.Data[rbac.ConfigMapPolicyCSVKey],
+		PolicyDefault: cm.Data[rbac.ConfigMapPolicyDefaultKey],
+		MatchMode:     cm.Data[rbac.ConfigMapMatchModeKey],
+	}
+
+	// Scopes are stored as a YAML list, e.g. `[groups, email]`
+	if scopes := strings.TrimSpace(cm.Data[rbac.ConfigMapScopesKey]); scopes != "" {
+		if err = yaml.Unmarshal([]byte(scopes), &s.Scopes); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// updateRBACSettings writes the given settings and removes the previously
+// managed ones which are no longer part of them, so that ArgoCD falls back to
+// its defaults.
+func updateRBACSettings(ctx context.Context, kc kubernetes.Interface, namespace string, s, previous *rbacSettings) error {
+	settings, prev := s.data(), previous.data()
+	data := make(map[string]any, len(settings)+len(prev))
+
+	for k := range prev {
+		data[k] = nil
+	}
+
+	for k, v := range settings {
+		data[k] = v
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	patch, err := dataMergePatch(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = kc.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDRBACConfigMapName, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// data returns the non-empty settings keyed by their key within the
+// ConfigMap.
+func (s *rbacSettings) data() map[string]string {
+	data := make(map[string]string)
+
+	if s == nil {
+		return data
+	}
+
+	set := func(key, value string) {
+		if value != "" {
+			data[key] = value
+		}
+	}
+
+	set(rbac.ConfigMapPolicyCSVKey, s.PolicyCSV)
+	set(rbac.ConfigMapPolicyDefaultKey, s.PolicyDefault)
+	set(rbac.ConfigMapMatchModeKey, s.MatchMode)
+
+	if len(s.Scopes) > 0 {
+		set(rbac.ConfigMapScopesKey, "["+strings.Join(s.Scopes, ", ")+"]")
+	}
+
+	return data
+}
+
+// rbacPolicy holds the settings of the ConfigMap which ArgoCD evaluates when
+// enforcing RBAC.
+type rbacPolicy struct {
+	PolicyCSV     string
+	PolicyDefault string
+	MatchMode     string
+}
+
+// readRBACPolicy reads the effective policy from the ConfigMap. Unlike
+// readRBACSettings, policies stored under `policy.<name>.csv` are included.
+func readRBACPolicy(ctx context.Context, kc kubernetes.Interface, namespace string) (*rbacPolicy, error) {
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rbacPolicy{
+		PolicyCSV:     rbac.PolicyCSV(cm.Data),
+		PolicyDefault: cm.Data[rbac.ConfigMapPolicyDefaultKey],
+		MatchMode:     cm.Data[rbac.ConfigMapMatchModeKey],
+	}, nil
+}
+
+// enforce returns whether subject may perform action on the object of the
+// given resource, the same way `argocd admin settings rbac can` does, i.e.
+// the built-in policy of ArgoCD is evaluated along with the given one.
+func (p *rbacPolicy) enforce(subject, resource, action, object string) (bool, error) {
+	enf := rbac.NewEnforcer(nil, "", common.ArgoCDRBACConfigMapName, nil)
+	enf.SetDefaultRole(p.PolicyDefault)
+	enf.SetMatchMode(p.MatchMode)
+
+	if err := enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV); err != nil {
+		return false, err
+	}
+
+	if err := enf.SetUserPolicy(p.PolicyCSV); err != nil {
+		return false, err
+	}
+
+	// Objects of project scoped resources are of the form `<project>/<name>`
+	if rbac.ProjectScoped[resource] && (object == "" || object == "*") {
+		object = "*/*"
+	}
+
+	return enf.Enforce(subject, resource, action, object), nil
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRBACSettingsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRBACConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"policy.default":     "role:readonly",
+				"policy.overlay.csv": "g, my-org:platform, role:admin",
+			},
+		},
+	)
+
+	read, err := readRBACSettings(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, &rbacSettings{PolicyDefault: "role:readonly"}, read)
+
+	s := &rbacSettings{
+		PolicyCSV: "p, role:org-admin, applications, *, */*, allow\ng, my-org:team-alpha, role:org-admin\n",
+		Scopes:    []string{"groups", "email"},
+		MatchMode: "glob",
+	}
+
+	if err = updateRBACSettings(ctx, kc, "argocd", s, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err := kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Keys which are not configured are retained
+	assert.Equal(t, map[string]string{
+		"policy.csv":         s.PolicyCSV,
+		"policy.default":     "role:readonly",
+		"scopes":             "[groups, email]",
+		"policy.matchMode":   "glob",
+		"policy.overlay.csv": "g, my-org:platform, role:admin",
+	}, cm.Data)
+
+	read, err = readRBACSettings(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, &rbacSettings{PolicyCSV: s.PolicyCSV, PolicyDefault: "role:readonly", Scopes: s.Scopes, MatchMode: "glob"}, read)
+
+	// Keys which were previously managed are removed
+	updated := &rbacSettings{
+		PolicyCSV: s.PolicyCSV,
+	}
+
+	if err = updateRBACSettings(ctx, kc, "argocd", updated, s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"policy.csv":         s.PolicyCSV,
+		"policy.default":     "role:readonly",
+		"policy.overlay.csv": "g, my-org:platform, role:admin",
+	}, cm.Data)
+
+	if err = updateRBACSettings(ctx, kc, "argocd", nil, updated); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cm, err = kc.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"policy.default":     "role:readonly",
+		"policy.overlay.csv": "g, my-org:platform, role:admin",
+	}, cm.Data)
+}
+
+func TestRBACModelUpdateFromRBACSettings(t *testing.T) {
+	t.Parallel()
+
+	s := &rbacSettings{
+		PolicyCSV:     "g, my-org:team-alpha, role:admin",
+		PolicyDefault: "role:readonly",
+		Scopes:        []string{"groups"},
+	}
+
+	// Only the managed settings are refreshed
+	m := rbacModel{
+		PolicyDefault: types.StringValue("role:admin"),
+		MatchMode:     types.StringValue("regex"),
+	}
+
+	m.updateFromRBACSettings(s, false)
+	assert.Equal(t, types.StringValue(common.ArgoCDRBACConfigMapName), m.ID)
+	assert.Equal(t, types.StringValue("role:readonly"), m.PolicyDefault)
+	assert.Equal(t, types.StringNull(), m.MatchMode)
+	assert.Equal(t, types.StringNull(), m.PolicyCSV)
+	assert.Nil(t, m.Scopes)
+
+	// All settings present are taken over when importing
+	m = rbacModel{}
+
+	assert.True(t, m.isEmpty())
+	m.updateFromRBACSettings(s, true)
+	assert.Equal(t, types.StringValue(s.PolicyCSV), m.PolicyCSV)
+	assert.Equal(t, []types.String{types.StringValue("groups")}, m.Scopes)
+	assert.Equal(t, s, m.toRBACSettings())
+}
+
+func TestRBACPolicyEnforce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRBACConfigMapName, Namespace: "argocd"},
+			Data: map[string]string{
+				"policy.csv":         "p, role:deployer, applications, sync, team-alpha/*, allow\ng, ci, role:deployer",
+				"policy.default":     "role:readonly",
+				"policy.overlay.csv": "g, my-org:platform, role:admin",
+			},
+		},
+	)
+
+	p, err := readRBACPolicy(ctx, kc, "argocd")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		subject, resource, action, object string
+		allowed                           bool
+	}{
+		{"ci", "applications", "sync", "team-alpha/my-app", true},
+		{"ci", "applications", "sync", "team-beta/my-app", false},
+		{"ci", "applications", "get", "", true},
+		{"ci", "clusters", "delete", "", false},
+		{"admin", "clusters", "delete", "", true},
+		{"my-org:platform", "accounts", "update", "*", true},
+		{"role:deployer", "applications", "sync", "*", false},
+	}
+
+	for _, tt := range tests {
+		allowed, err := p.enforce(tt.subject, tt.resource, tt.action, tt.object)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		assert.Equal(t, tt.allowed, allowed, "%s %s %s %s", tt.subject, tt.action, tt.resource, tt.object)
+	}
+}
@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The functions below manage repositories and repository credentials through
+// their declarative secrets (see
+// https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories),
+// the same way ArgoCD stores the ones registered through its API. Secrets are
+// looked up by their type and URL rather than by name, as ArgoCD does not
+// guarantee the naming of the secrets it creates.
+
+type secretData map[string][]byte
+
+func (d secretData) setString(key, value string) {
+	if value != "" {
+		d[key] = []byte(value)
+	}
+}
+
+func (d secretData) setBool(key string, value bool) {
+	if value {
+		d[key] = []byte(strconv.FormatBool(value))
+	}
+}
+
+func (d secretData) setInt(key string, value int64) {
+	if value != 0 {
+		d[key] = []byte(strconv.FormatInt(value, 10))
+	}
+}
+
+func (d secretData) bool(key string) (bool, error) {
+	if v, ok := d[key]; ok {
+		return strconv.ParseBool(string(v))
+	}
+
+	return false, nil
+}
+
+func (d secretData) int(key string) (int64, error) {
+	if v, ok := d[key]; ok {
+		return strconv.ParseInt(string(v), 10, 64)
+	}
+
+	return 0, nil
+}
+
+// setSecretMetadata labels the secret with its type, so that it is picked up
+// by ArgoCD, and marks it as managed by ArgoCD, matching the secrets ArgoCD
+// writes itself. Any other labels and annotations are retained.
+func setSecretMetadata(secret *corev1.Secret, secretType string) {
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+
+	secret.Labels[common.LabelKeySecretType] = secretType
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+
+	secret.Annotations[common.AnnotationKeyManagedBy] = common.AnnotationValueManagedByArgoCD
+}
+
+// secretMetadata holds the labels and annotations managed on a secret, on top
+// of the ones required by ArgoCD.
+type secretMetadata struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// apply sets the labels and annotations on the secret, and removes the
+// previously managed ones which are no longer present. Labels and annotations
+// added out-of-band are retained.
+func (m secretMetadata) apply(secret *corev1.Secret, previous secretMetadata) {
+	secret.Labels = mergeSecretMetadata(secret.Labels, m.Labels, previous.Labels)
+	secret.Annotations = mergeSecretMetadata(secret.Annotations, m.Annotations, previous.Annotations)
+}
+
+// tracked returns the labels and annotations of the secret with the keys
+// managed by m.
+func (m secretMetadata) tracked(secret *corev1.Secret) secretMetadata {
+	return secretMetadata{
+		Labels:      trackedSecretMetadata(secret.Labels, m.Labels),
+		Annotations: trackedSecretMetadata(secret.Annotations, m.Annotations),
+	}
+}
+
+func (m secretMetadata) isEmpty() bool {
+	return len(m.Labels) == 0 && len(m.Annotations) == 0
+}
+
+func mergeSecretMetadata(current, desired, previous map[string]string) map[string]string {
+	if current == nil {
+		current = map[string]string{}
+	}
+
+	for k := range previous {
+		if _, ok := desired[k]; !ok {
+			delete(current, k)
+		}
+	}
+
+	maps.Copy(current, desired)
+
+	return current
+}
+
+func trackedSecretMetadata(current, managed map[string]string) map[string]string {
+	if managed == nil {
+		return nil
+	}
+
+	tracked := make(map[string]string, len(managed))
+
+	for k := range managed {
+		if v, ok := current[k]; ok {
+			tracked[k] = v
+		}
+	}
+
+	return tracked
+}
+
+func repositoryToSecret(r *v1alpha1.Repository, secret *corev1.Secret) {
+	data := secretData{}
+
+	data.setString("url", r.Repo)
+	data.setString("name", r.Name)
+	data.setString("project", r.Project)
+	data.setString("type", r.Type)
+	data.setString("username", r.Username)
+	data.setString("password", r.Password)
+	data.setString("bearerToken", r.BearerToken)
+	data.setString("sshPrivateKey", r.SSHPrivateKey)
+	data.setString("tlsClientCertData", r.TLSClientCertData)
+	data.setString("tlsClientCertKey", r.TLSClientCertKey)
+	data.setString("githubAppPrivateKey", r.GithubAppPrivateKey)
+	data.setInt("githubAppID", r.GithubAppId)
+	data.setInt("githubAppInstallationID", r.GithubAppInstallationId)
+	data.setString("githubAppEnterpriseBaseUrl", r.GitHubAppEnterpriseBaseURL)
+	data.setString("gcpServiceAccountKey", r.GCPServiceAccountKey)
+	data.setString("proxy", r.Proxy)
+	data.setString("noProxy", r.NoProxy)
+	data.setBool("enableLfs", r.EnableLFS)
+	data.setBool("enableOCI", r.EnableOCI)
+	data.setBool("insecure", r.Insecure)
+	data.setBool("insecureIgnoreHostKey", r.InsecureIgnoreHostKey)
+	data.setBool("forceHttpBasicAuth", r.ForceHttpBasicAuth)
+	data.setBool("useAzureWorkloadIdentity", r.UseAzureWorkloadIdentity)
+	data.setInt("depth", r.Depth)
+
+	secret.Data = data
+	setSecretMetadata(secret, common.LabelValueSecretTypeRepository)
+}
+
+func secretToRepository(secret *corev1.Secret) (*v1alpha1.Repository, error) {
+	data := secretData(secret.Data)
+
+	r := &v1alpha1.Repository{
+		Repo:                       string(data["url"]),
+		Name:                       string(data["name"]),
+		Project:                    string(data["project"]),
+		Type:                       string(data["type"]),
+		Username:                   string(data["username"]),
+		Password:                   string(data["password"]),
+		BearerToken:                string(data["bearerToken"]),
+		SSHPrivateKey:              string(data["sshPrivateKey"]),
+		TLSClientCertData:          string(data["tlsClientCertData"]),
+		TLSClientCertKey:           string(data["tlsClientCertKey"]),
+		GithubAppPrivateKey:        string(data["githubAppPrivateKey"]),
+		GitHubAppEnterpriseBaseURL: string(data["githubAppEnterpriseBaseUrl"]),
+		GCPServiceAccountKey:       string(data["gcpServiceAccountKey"]),
+		Proxy:                      string(data["proxy"]),
+		NoProxy:                    string(data["noProxy"]),
+	}
+
+	var err error
+
+	for key, value := range map[string]*bool{
+		"enableLfs":                &r.EnableLFS,
+		"enableOCI":                &r.EnableOCI,
+		"insecure":                 &r.Insecure,
+		"insecureIgnoreHostKey":    &r.InsecureIgnoreHostKey,
+		"forceHttpBasicAuth":       &r.ForceHttpBasicAuth,
+		"useAzureWorkloadIdentity": &r.UseAzureWorkloadIdentity,
+	} {
+		if *value, err = data.bool(key); err != nil {
+			return nil, fmt.Errorf("invalid value of %s in secret %s: %w", key, secret.Name, err)
+		}
+	}
+
+	for key, value := range map[string]*int64{
+		"githubAppID":             &r.GithubAppId,
+		"githubAppInstallationID": &r.GithubAppInstallationId,
+		"depth":                   &r.Depth,
+	} {
+		if *value, err = data.int(key); err != nil {
+			return nil, fmt.Errorf("invalid value of %s in secret %s: %w", key, secret.Name, err)
+		}
+	}
+
+	return r, nil
+}
+
+func repoCredsToSecret(c *v1alpha1.RepoCreds, secret *corev1.Secret) {
+	data := secretData{}
+
+	data.setString("url", c.URL)
+	data.setString("type", c.Type)
+	data.setString("username", c.Username)
+	data.setString("password", c.Password)
+	data.setString("bearerToken", c.BearerToken)
+	data.setString("sshPrivateKey", c.SSHPrivateKey)
+	data.setString("tlsClientCertData", c.TLSClientCertData)
+	data.setString("tlsClientCertKey", c.TLSClientCertKey)
+	data.setString("githubAppPrivateKey", c.GithubAppPrivateKey)
+	data.setInt("githubAppID", c.GithubAppId)
+	data.setInt("githubAppInstallationID", c.GithubAppInstallationId)
+	data.setString("githubAppEnterpriseBaseUrl", c.GitHubAppEnterpriseBaseURL)
+	data.setString("gcpServiceAccountKey", c.GCPServiceAccountKey)
+	data.setString("proxy", c.Proxy)
+	data.setString("noProxy", c.NoProxy)
+	data.setBool("enableOCI", c.EnableOCI)
+	data.setBool("forceHttpBasicAuth", c.ForceHttpBasicAuth)
+	data.setBool("useAzureWorkloadIdentity", c.UseAzureWorkloadIdentity)
+
+	secret.Data = data
+	setSecretMetadata(secret, common.LabelValueSecretTypeRepoCreds)
+}
+
+func secretToRepoCreds(secret *corev1.Secret) (*v1alpha1.RepoCreds, error) {
+	data := secretData(secret.Data)
+
+	c := &v1alpha1.RepoCreds{
+		URL:                        string(data["url"]),
+		Type:                       string(data["type"]),
+		Username:                   string(data["username"]),
+		Password:                   string(data["password"]),
+		BearerToken:                string(data["bearerToken"]),
+		SSHPrivateKey:              string(data["sshPrivateKey"]),
+		TLSClientCertData:          string(data["tlsClientCertData"]),
+		TLSClientCertKey:           string(data["tlsClientCertKey"]),
+		GithubAppPrivateKey:        string(data["githubAppPrivateKey"]),
+		GitHubAppEnterpriseBaseURL: string(data["githubAppEnterpriseBaseUrl"]),
+		GCPServiceAccountKey:       string(data["gcpServiceAccountKey"]),
+		Proxy:                      string(data["proxy"]),
+		NoProxy:                    string(data["noProxy"]),
+	}
+
+	var err error
+
+	for key, value := range map[string]*bool{
+		"enableOCI":                &c.EnableOCI,
+		"forceHttpBasicAuth":       &c.ForceHttpBasicAuth,
+		"useAzureWorkloadIdentity": &c.UseAzureWorkloadIdentity,
+	} {
+		if *value, err = data.bool(key); err != nil {
+			return nil, fmt.Errorf("invalid value of %s in secret %s: %w", key, secret.Name, err)
+		}
+	}
+
+	for key, value := range map[string]*int64{
+		"githubAppID":             &c.GithubAppId,
+		"githubAppInstallationID": &c.GithubAppInstallationId,
+	} {
+		if *value, err = data.int(key); err != nil {
+			return nil, fmt.Errorf("invalid value of %s in secret %s: %w", key, secret.Name, err)
+		}
+	}
+
+	return c, nil
+}
+
+// findSecret returns the first secret of the given type matching the filter,
+// or a NotFound error if there is none.
+func findSecret(ctx context.Context, kc kubernetes.Interface, namespace, secretType, name string, match func(secretData) bool) (*corev1.Secret, error) {
+	secrets, err := kc.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeySecretType + "=" + secretType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range secrets.Items {
+		if match(secrets.Items[i].Data) {
+			return &secrets.Items[i], nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+}
+
+// repositorySecretName returns the name of the secret ArgoCD stores the
+// repository of the given project in.
+func repositorySecretName(repoURL, project string) string {
+	return db.RepoURLToSecretName("repo", repoURL, project)
+}
+
+// repositoryCredentialsSecretName returns the name of the secret ArgoCD stores
+// the credentials of the given URL prefix in.
+func repositoryCredentialsSecretName(url string) string {
+	return db.RepoURLToSecretName("creds", url, "")
+}
+
+func getRepositorySecret(ctx context.Context, kc kubernetes.Interface, namespace, repoURL, project string) (*corev1.Secret, error) {
+	return findSecret(ctx, kc, namespace, common.LabelValueSecretTypeRepository, repoURL, func(data secretData) bool {
+		return git.SameURL(string(data["url"]), repoURL) && string(data["project"]) == project
+	})
+}
+
+func createRepositorySecret(ctx context.Context, kc kubernetes.Interface, namespace string, r *v1alpha1.Repository) (*v1alpha1.Repository, error) {
+	_, err := getRepositorySecret(ctx, kc, namespace, r.Repo, r.Project)
+	if err == nil {
+		return nil, apierrors.NewAlreadyExists(corev1.Resource("secrets"), r.Repo)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      repositorySecretName(r.Repo, r.Project),
+			Namespace: namespace,
+		},
+	}
+
+	repositoryToSecret(r, secret)
+
+	secret, err = kc.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepository(secret)
+}
+
+func readRepositorySecret(ctx context.Context, kc kubernetes.Interface, namespace, repoURL, project string) (*v1alpha1.Repository, error) {
+	secret, err := getRepositorySecret(ctx, kc, namespace, repoURL, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepository(secret)
+}
+
+// updateRepositorySecret updates the secret of the repository registered
+// within the given project. The project of the repository is updated in place
+// when it differs, unless the repository is already registered within the new
+// project.
+func updateRepositorySecret(ctx context.Context, kc kubernetes.Interface, namespace, project string, r *v1alpha1.Repository) (*v1alpha1.Repository, error) {
+	if r.Project != project {
+		_, err := getRepositorySecret(ctx, kc, namespace, r.Repo, r.Project)
+		if err == nil {
+			return nil, apierrors.NewAlreadyExists(corev1.Resource("secrets"), r.Repo)
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	secret, err := getRepositorySecret(ctx, kc, namespace, r.Repo, project)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryToSecret(r, secret)
+
+	secret, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepository(secret)
+}
+
+func deleteRepositorySecret(ctx context.Context, kc kubernetes.Interface, namespace, repoURL, project string) error {
+	secret, err := getRepositorySecret(ctx, kc, namespace, repoURL, project)
+	if err != nil {
+		return err
+	}
+
+	return kc.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+}
+
+// readRepositorySecretMetadata returns the labels and annotations of the
+// secret of the repository with the keys managed by metadata.
+func readRepositorySecretMetadata(ctx context.Context, kc kubernetes.Interface, namespace, repoURL, project string, metadata secretMetadata) (secretMetadata, error) {
+	secret, err := getRepositorySecret(ctx, kc, namespace, repoURL, project)
+	if err != nil {
+		return secretMetadata{}, err
+	}
+
+	return metadata.tracked(secret), nil
+}
+
+// updateRepositorySecretMetadata updates the labels and annotations of the
+// secret of the repository, regardless of whether the secret has been created
+// through the ArgoCD API or directly.
+func updateRepositorySecretMetadata(ctx context.Context, kc kubernetes.Interface, namespace, repoURL, project string, metadata, previous secretMetadata) error {
+	secret, err := getRepositorySecret(ctx, kc, namespace, repoURL, project)
+	if err != nil {
+		return err
+	}
+
+	metadata.apply(secret, previous)
+
+	_, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+
+	return err
+}
+
+func getRepoCredsSecret(ctx context.Context, kc kubernetes.Interface, namespace, url string) (*corev1.Secret, error) {
+	return findSecret(ctx, kc, namespace, common.LabelValueSecretTypeRepoCreds, url, func(data secretData) bool {
+		return string(data["url"]) == url
+	})
+}
+
+func createRepoCredsSecret(ctx context.Context, kc kubernetes.Interface, namespace string, c *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error) {
+	_, err := getRepoCredsSecret(ctx, kc, namespace, c.URL)
+	if err == nil {
+		return nil, apierrors.NewAlreadyExists(corev1.Resource("secrets"), c.URL)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      repositoryCredentialsSecretName(c.URL),
+			Namespace: namespace,
+		},
+	}
+
+	repoCredsToSecret(c, secret)
+
+	secret, err = kc.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepoCreds(secret)
+}
+
+func readRepoCredsSecret(ctx context.Context, kc kubernetes.Interface, namespace, url string) (*v1alpha1.RepoCreds, error) {
+	secret, err := getRepoCredsSecret(ctx, kc, namespace, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepoCreds(secret)
+}
+
+func updateRepoCredsSecret(ctx context.Context, kc kubernetes.Interface, namespace string, c *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error) {
+	secret, err := getRepoCredsSecret(ctx, kc, namespace, c.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	repoCredsToSecret(c, secret)
+
+	secret, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretToRepoCreds(secret)
+}
+
+func deleteRepoCredsSecret(ctx context.Context, kc kubernetes.Interface, namespace, url string) error {
+	secret, err := getRepoCredsSecret(ctx, kc, namespace, url)
+	if err != nil {
+		return err
+	}
+
+	return kc.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+}
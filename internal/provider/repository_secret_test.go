@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRepositorySecretLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	repo := &v1alpha1.Repository{
+		Repo:      "https://github.com/argoproj/argocd-example-apps.git",
+		Type:      "git",
+		Project:   "my-project",
+		Username:  "git",
+		Password:  "token",
+		EnableLFS: true,
+		Depth:     1,
+	}
+
+	created, err := createRepositorySecret(ctx, kc, "argocd", repo)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if created.Repo != repo.Repo || created.Project != "my-project" || created.Password != "token" || !created.EnableLFS || created.Depth != 1 {
+		t.Errorf("unexpected repository %+v", created)
+	}
+
+	if _, err = createRepositorySecret(ctx, kc, "argocd", repo); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	// The same repository may be registered globally as well
+	if _, err = createRepositorySecret(ctx, kc, "argocd", &v1alpha1.Repository{Repo: repo.Repo}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secret, err := getRepositorySecret(ctx, kc, "argocd", repo.Repo, repo.Project)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := secret.Labels[common.LabelKeySecretType]; got != common.LabelValueSecretTypeRepository {
+		t.Errorf("expected secret type label to be %q, got %q", common.LabelValueSecretTypeRepository, got)
+	}
+
+	// Labels added out-of-band are retained on update
+	secret.Labels["team"] = "platform"
+
+	if _, err = kc.CoreV1().Secrets("argocd").Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	repo.EnableLFS = false
+	repo.Depth = 0
+
+	if _, err = updateRepositorySecret(ctx, kc, "argocd", repo.Project, repo); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err := readRepositorySecret(ctx, kc, "argocd", repo.Repo, repo.Project)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if read.EnableLFS || read.Depth != 0 || read.Username != "git" {
+		t.Errorf("unexpected repository %+v", read)
+	}
+
+	secret, err = getRepositorySecret(ctx, kc, "argocd", repo.Repo, repo.Project)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := secret.Labels["team"]; got != "platform" {
+		t.Errorf("expected team label to be retained, got %q", got)
+	}
+
+	if err = deleteRepositorySecret(ctx, kc, "argocd", repo.Repo, repo.Project); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err = readRepositorySecret(ctx, kc, "argocd", repo.Repo, repo.Project); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if _, err = readRepositorySecret(ctx, kc, "argocd", repo.Repo, ""); err != nil {
+		t.Errorf("expected the global repository to be retained, got %v", err)
+	}
+}
+
+func TestRepositorySecretMoveProject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Project: "project-a"}
+
+	if _, err := createRepositorySecret(ctx, kc, "argocd", repo); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := createRepositorySecret(ctx, kc, "argocd", &v1alpha1.Repository{Repo: repo.Repo, Project: "project-c"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	repo.Project = "project-b"
+
+	moved, err := updateRepositorySecret(ctx, kc, "argocd", "project-a", repo)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "project-b", moved.Project)
+
+	if _, err = readRepositorySecret(ctx, kc, "argocd", repo.Repo, "project-a"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	// Repositories cannot be moved to a project they are already registered in
+	repo.Project = "project-c"
+
+	if _, err = updateRepositorySecret(ctx, kc, "argocd", "project-b", repo); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+}
+
+func TestRepositorySecretMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}
+
+	if _, err := createRepositorySecret(ctx, kc, "argocd", repo); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	metadata := secretMetadata{
+		Labels:      map[string]string{"team": "platform", "scm": "github"},
+		Annotations: map[string]string{"owner": "terraform"},
+	}
+
+	if err := updateRepositorySecretMetadata(ctx, kc, "argocd", repo.Repo, "", metadata, secretMetadata{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Labels added out-of-band are retained, but not tracked
+	secret, err := getRepositorySecret(ctx, kc, "argocd", repo.Repo, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secret.Labels["other"] = "value"
+
+	if _, err = kc.CoreV1().Secrets("argocd").Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	updated := secretMetadata{
+		Labels: map[string]string{"team": "apps"},
+	}
+
+	if err = updateRepositorySecretMetadata(ctx, kc, "argocd", repo.Repo, "", updated, metadata); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secret, err = getRepositorySecret(ctx, kc, "argocd", repo.Repo, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{
+		common.LabelKeySecretType: common.LabelValueSecretTypeRepository,
+		"team":                    "apps",
+		"other":                   "value",
+	}, secret.Labels)
+	assert.Equal(t, map[string]string{
+		common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD,
+	}, secret.Annotations)
+
+	read, err := readRepositorySecretMetadata(ctx, kc, "argocd", repo.Repo, "", secretMetadata{
+		Labels:      map[string]string{"team": "", "removed": ""},
+		Annotations: map[string]string{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, secretMetadata{
+		Labels:      map[string]string{"team": "apps"},
+		Annotations: map[string]string{},
+	}, read)
+}
+
+func TestRepoCredsSecretLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	creds := &v1alpha1.RepoCreds{
+		URL:                     "https://github.com/argoproj",
+		GithubAppPrivateKey:     "key",
+		GithubAppId:             123456,
+		GithubAppInstallationId: 654321,
+	}
+
+	created, err := createRepoCredsSecret(ctx, kc, "argocd", creds)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if created.URL != creds.URL || created.GithubAppId != 123456 || created.GithubAppInstallationId != 654321 {
+		t.Errorf("unexpected repository credentials %+v", created)
+	}
+
+	if _, err = createRepoCredsSecret(ctx, kc, "argocd", creds); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	secret, err := getRepoCredsSecret(ctx, kc, "argocd", creds.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := secret.Labels[common.LabelKeySecretType]; got != common.LabelValueSecretTypeRepoCreds {
+		t.Errorf("expected secret type label to be %q, got %q", common.LabelValueSecretTypeRepoCreds, got)
+	}
+
+	creds.GithubAppInstallationId = 0
+
+	if _, err = updateRepoCredsSecret(ctx, kc, "argocd", creds); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read, err := readRepoCredsSecret(ctx, kc, "argocd", creds.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if read.GithubAppInstallationId != 0 || read.GithubAppPrivateKey != "key" {
+		t.Errorf("unexpected repository credentials %+v", read)
+	}
+
+	if err = deleteRepoCredsSecret(ctx, kc, "argocd", creds.URL); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err = readRepoCredsSecret(ctx, kc, "argocd", creds.URL); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestRepositoryCredentialsModelUpdateFromSecret(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	_, err := createRepoCredsSecret(ctx, kc, "argocd", &v1alpha1.RepoCreds{
+		URL:                        "https://ghe.example.com/my-org/",
+		GithubAppPrivateKey:        "key",
+		GithubAppId:                123456,
+		GitHubAppEnterpriseBaseURL: "https://ghe.example.com/api/v3",
+		Proxy:                      "http://proxy.example.com:8080",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	creds, err := readRepoCredsSecret(ctx, kc, "argocd", "https://ghe.example.com/my-org/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Only the ID is known when importing credentials
+	m := repositoryCredentialsModel{ID: types.StringValue("https://ghe.example.com/my-org/")}
+	m.updateFromSecret(creds)
+
+	assert.Equal(t, types.StringValue("https://ghe.example.com/my-org/"), m.URL)
+	assert.Equal(t, types.StringValue("git"), m.Type)
+	assert.Equal(t, types.StringNull(), m.Username)
+	assert.Equal(t, types.StringValue("123456"), m.GitHubAppID)
+	assert.Equal(t, types.StringNull(), m.GitHubAppInstallationID)
+	assert.Equal(t, types.StringValue("https://ghe.example.com/api/v3"), m.GitHubAppEnterpriseBaseURL)
+	assert.Equal(t, types.StringValue("http://proxy.example.com:8080"), m.Proxy)
+	assert.Equal(t, types.BoolValue(false), m.EnableOCI)
+
+	// Sensitive fields are never read back
+	assert.Equal(t, types.StringNull(), m.GitHubAppPrivateKey)
+
+	// The secret may be referenced by the generators of ApplicationSets
+	secret, err := getRepoCredsSecret(ctx, kc, "argocd", "https://ghe.example.com/my-org/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, types.StringValue(secret.Name), m.SecretName)
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accountResource{}
+var _ resource.ResourceWithImportState = &accountResource{}
+
+func NewAccountResource() resource.Resource {
+	return &accountResource{}
+}
+
+// accountResource defines the resource implementation.
+type accountResource struct {
+	si *ServerInterface
+}
+
+func (r *accountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (r *accountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages [local accounts](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts) of ArgoCD within the `argocd-cm` ConfigMap, e.g. service accounts for CI pipelines or `argocd-image-updater`.\n\n" +
+			configMapResourceDescription +
+			"Any other settings within the ConfigMap are left untouched. The password of the account is set with `argocd_account_password`, destroying the account also removes its password and tokens.",
+		Attributes: accountSchemaAttributes(),
+	}
+}
+
+func (r *accountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data accountModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	a := data.toLocalAccount()
+
+	sync.AccountMutex.Lock()
+	err = createLocalAccount(ctx, kc, namespace, a)
+	sync.AccountMutex.Unlock()
+
+	if apierrors.IsAlreadyExists(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("account %s already exists", a.Name),
+			fmt.Sprintf("account %s is already configured within the argocd-cm ConfigMap in namespace %s, import the account to bring it under management.", a.Name, namespace),
+		)
+
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create account %s", a.Name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created account %s", a.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAccount(a))...)
+}
+
+func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data accountModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.AccountMutex.RLock()
+	a, err := readLocalAccount(ctx, kc, namespace, data.ID.ValueString())
+	sync.AccountMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Account has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read account %s", data.ID.ValueString()), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAccount(a))...)
+}
+
+func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data accountModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	a := data.toLocalAccount()
+
+	sync.AccountMutex.Lock()
+	err = updateLocalAccount(ctx, kc, namespace, a)
+	sync.AccountMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update account %s", a.Name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated account %s", a.Name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAccount(a))...)
+}
+
+func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data accountModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.AccountMutex.Lock()
+	err = deleteLocalAccount(ctx, kc, namespace, data.ID.ValueString())
+	sync.AccountMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete account %s", data.ID.ValueString()), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted account %s", data.ID.ValueString()))
+}
+
+func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accountPasswordResource{}
+var _ resource.ResourceWithModifyPlan = &accountPasswordResource{}
+
+func NewAccountPasswordResource() resource.Resource {
+	return &accountPasswordResource{}
+}
+
+// accountPasswordResource defines the resource implementation.
+type accountPasswordResource struct {
+	si *ServerInterface
+}
+
+func (r *accountPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_password"
+}
+
+func (r *accountPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the password of a [local account](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts) within ArgoCD, e.g. to provision users which log in through the UI.\n\n" +
+			"The provider needs to be permitted to update the account, which the `admin` account is by default.\n\n" +
+			"**Note**: the ArgoCD API does not return passwords nor when they were last changed, so the provider is unable to detect passwords which have been changed outside of Terraform. " +
+			"Destroying the resource only removes it from the state, the account keeps its current password since ArgoCD does not allow removing passwords.\n\n" +
+			"**Note**: changing the password of the account the provider authenticates as invalidates the session of the provider, hence subsequent operations within the same run may fail and the provider has to be configured with the new password afterwards. A warning is emitted when planning such a change.",
+		Attributes: accountPasswordSchemaAttributes(),
+	}
+}
+
+func (r *accountPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *accountPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data accountPasswordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePassword(ctx, &data, req.Config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Account
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("set password of account %s", data.Account.ValueString()))
+}
+
+func (r *accountPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data accountPasswordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.AccountMutex.RLock()
+	_, err := r.si.AccountClient.GetAccount(ctx, &account.GetAccountRequest{
+		Name: data.Account.ValueString(),
+	})
+	sync.AccountMutex.RUnlock()
+
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			// Account has been deleted out-of-band
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "account", data.Account.ValueString(), err)...)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *accountPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data accountPasswordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePassword(ctx, &data, req.Config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Account
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("updated password of account %s", data.Account.ValueString()))
+}
+
+func (r *accountPasswordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is changed when the resource is destroyed, or if the plan is
+	// unchanged
+	if r.si == nil || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var accountName types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("account"), &accountName)...)
+
+	if resp.Diagnostics.HasError() || accountName.IsUnknown() {
+		return
+	}
+
+	if username := r.authenticatedUsername(); username != "" && username == accountName.ValueString() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("account"),
+			"Password Of Provider Account Changed",
+			fmt.Sprintf("the provider authenticates as account %s, whose sessions are invalidated by changing its password. Subsequent operations within this run may fail, and the provider has to be configured with the new password afterwards.", username),
+		)
+	}
+}
+
+func (r *accountPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data accountPasswordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ArgoCD does not allow removing the password of an account, hence the
+	// resource is only removed from the state
+	resp.Diagnostics.AddWarning(
+		"Account Password Not Removed",
+		fmt.Sprintf("ArgoCD does not allow removing the password of an account, the password of account %s was only removed from the state and is left unchanged", data.Account.ValueString()),
+	)
+}
+
+// authenticatedUsername returns the name of the local account the provider
+// authenticates as, or an empty string if it authenticates through a token or
+// the local ArgoCD configuration.
+func (r *accountPasswordResource) authenticatedUsername() string {
+	c := r.si.config
+
+	if getDefaultString(c.AuthToken, "ARGOCD_AUTH_TOKEN") != "" || c.UseLocalConfig.ValueBool() || c.Core.ValueBool() {
+		return ""
+	}
+
+	return getDefaultString(c.Username, "ARGOCD_AUTH_USERNAME")
+}
+
+func (r *accountPasswordResource) updatePassword(ctx context.Context, data *accountPasswordModel, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newPassword := data.Password.ValueString()
+	setWriteOnlyAttributes(ctx, config, &diags, map[string]*string{"password": &newPassword})
+
+	var currentPassword types.String
+
+	diags.Append(config.GetAttribute(ctx, path.Root("current_password_wo"), &currentPassword)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	current := currentPassword.ValueString()
+	if current == "" {
+		current = getDefaultString(r.si.config.Password, "ARGOCD_AUTH_PASSWORD")
+	}
+
+	sync.AccountMutex.Lock()
+	_, err := r.si.AccountClient.UpdatePassword(ctx, &account.UpdatePasswordRequest{
+		Name:            data.Account.ValueString(),
+		NewPassword:     newPassword,
+		CurrentPassword: current,
+	})
+	sync.AccountMutex.Unlock()
+
+	if err != nil {
+		diags.Append(diagnostics.ArgoCDAPIError("update", "password of account", data.Account.ValueString(), err)...)
+	}
+
+	return diags
+}
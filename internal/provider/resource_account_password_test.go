@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccArgoCDAccountPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccountPassword("Passw0rd-one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_account_password.test", "id", "test"),
+					resource.TestCheckResourceAttr("argocd_account_password.test", "password", "Passw0rd-one"),
+				),
+			},
+			{
+				Config: testAccArgoCDAccountPassword("Passw0rd-two"),
+				Check:  resource.TestCheckResourceAttr("argocd_account_password.test", "password", "Passw0rd-two"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDAccountPassword_WriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccountPasswordWriteOnly("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("argocd_account_password.test", "password"),
+					resource.TestCheckNoResourceAttr("argocd_account_password.test", "password_wo"),
+					resource.TestCheckResourceAttr("argocd_account_password.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccArgoCDAccountPasswordWriteOnly("2"),
+				Check:  resource.TestCheckResourceAttr("argocd_account_password.test", "password_wo_version", "2"),
+			},
+		},
+	})
+}
+
+func testAccArgoCDAccountPassword(password string) string {
+	return fmt.Sprintf(`
+resource "argocd_account_password" "test" {
+  account  = "test"
+  password = "%s"
+}
+`, password)
+}
+
+func testAccArgoCDAccountPasswordWriteOnly(version string) string {
+	return fmt.Sprintf(`
+resource "argocd_account_password" "test" {
+  account             = "test"
+  password_wo         = "Passw0rd-%[1]s"
+  password_wo_version = "%[1]s"
+}
+`, version)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDAccount(t *testing.T) {
+	name := acctest.RandomWithPrefix("account")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccount(name, `["apiKey"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_account.test", "id", name),
+					resource.TestCheckResourceAttr("argocd_account.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("argocd_account.test", "capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr("argocd_account.test", "capabilities.*", "apiKey"),
+				),
+			},
+			{
+				ResourceName:      "argocd_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDAccount(name, `["apiKey", "login"]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_account.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("argocd_account.test", "capabilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr("argocd_account.test", "capabilities.*", "login"),
+				),
+			},
+			{
+				Config:   testAccArgoCDAccount(name, `["apiKey", "login"]`, false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDAccount(name, capabilities string, enabled bool) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_account" "test" {
+  name         = "%s"
+  capabilities = %s
+  enabled      = %t
+}
+`, name, capabilities, enabled)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &compareOptionsResource{}
+var _ resource.ResourceWithImportState = &compareOptionsResource{}
+
+func NewCompareOptionsResource() resource.Resource {
+	return &compareOptionsResource{}
+}
+
+// compareOptionsResource defines the resource implementation.
+type compareOptionsResource struct {
+	si *ServerInterface
+}
+
+func (r *compareOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compare_options"
+}
+
+func (r *compareOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the [system-level options](https://argo-cd.readthedocs.io/en/stable/user-guide/diffing/#system-level-configuration) ArgoCD diffs resources with, " +
+			"i.e. the `resource.compareoptions` and `resource.ignoreResourceUpdatesEnabled` keys of the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the keys above are written, any other settings within the ConfigMap are left untouched. " +
+			"Creating the resource fails if compare options are already present, so that options managed elsewhere are not overwritten. " +
+			"Deleting the resource removes both keys, i.e. restores the defaults of ArgoCD.",
+		Attributes: compareOptionsSchemaAttributes(),
+	}
+}
+
+func (r *compareOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *compareOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data compareOptionsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	o := data.toCompareOptions()
+
+	sync.SettingsMutex.Lock()
+	err = createCompareOptions(ctx, kc, namespace, o)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to create compare options", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created compare options in namespace %s", namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newCompareOptions(o))...)
+}
+
+func (r *compareOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data compareOptionsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.RLock()
+	o, err := readCompareOptions(ctx, kc, namespace)
+	sync.SettingsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Compare options have been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read compare options", err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newCompareOptions(o))...)
+}
+
+func (r *compareOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data compareOptionsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	o := data.toCompareOptions()
+
+	sync.SettingsMutex.Lock()
+	err = updateCompareOptions(ctx, kc, namespace, o)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to update compare options", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated compare options in namespace %s", namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newCompareOptions(o))...)
+}
+
+func (r *compareOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data compareOptionsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = deleteCompareOptions(ctx, kc, namespace)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error("failed to delete compare options", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted compare options in namespace %s", namespace))
+}
+
+func (r *compareOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
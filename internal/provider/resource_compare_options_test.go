@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDCompareOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDCompareOptions(true, "crd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "id", "argocd-cm"),
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_aggregated_roles", "true"),
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_resource_status_field", "crd"),
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_differences_on_resource_updates", "true"),
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_resource_updates_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "argocd_compare_options.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDCompareOptions(false, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_aggregated_roles", "false"),
+					resource.TestCheckResourceAttr("argocd_compare_options.test", "ignore_resource_status_field", "all"),
+				),
+			},
+			{
+				Config:   testAccArgoCDCompareOptions(false, "all"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDCompareOptions(ignoreAggregatedRoles bool, ignoreResourceStatusField string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_compare_options" "test" {
+  ignore_aggregated_roles      = %t
+  ignore_resource_status_field = "%s"
+}
+`, ignoreAggregatedRoles, ignoreResourceStatusField)
+}
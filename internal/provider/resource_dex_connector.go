@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dexConnectorResource{}
+var _ resource.ResourceWithConfigValidators = &dexConnectorResource{}
+var _ resource.ResourceWithImportState = &dexConnectorResource{}
+
+func NewDexConnectorResource() resource.Resource {
+	return &dexConnectorResource{}
+}
+
+// dexConnectorResource defines the resource implementation.
+type dexConnectorResource struct {
+	si *ServerInterface
+}
+
+func (r *dexConnectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dex_connector"
+}
+
+func (r *dexConnectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [Dex connector](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#dex) ArgoCD delegates authentication to, i.e. an entry of the `connectors` of the `dex.config` key of the `argocd-cm` ConfigMap. " +
+			"Exactly one of `github`, `ldap`, `saml` or `microsoft` must be configured.\n\n" +
+			configMapResourceDescription +
+			"Connectors are managed individually, so that multiple resources, e.g. of different modules, may each manage their own connector. " +
+			"Any other connectors and settings of Dex are left untouched, and changes made to them concurrently are not overwritten. " +
+			"Creating a connector with the identifier of an existing one fails, so that connectors managed elsewhere are not overwritten.\n\n" +
+			"**Note**: fields of the connector configuration which are not supported by this resource are removed when it is written.",
+		Attributes: dexConnectorSchemaAttributes(),
+	}
+}
+
+func (r *dexConnectorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("github"),
+			path.MatchRoot("ldap"),
+			path.MatchRoot("saml"),
+			path.MatchRoot("microsoft"),
+		),
+	}
+}
+
+func (r *dexConnectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *dexConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dexConnectorModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c, err := data.toDexConnector()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to build Dex connector", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = createDexConnector(ctx, kc, namespace, c)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create Dex connector %s", c.ID), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created Dex connector %s in namespace %s", c.ID, namespace))
+
+	data.ID = types.StringValue(c.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dexConnectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dexConnectorModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	sync.SettingsMutex.RLock()
+	c, err := readDexConnector(ctx, kc, namespace, id)
+	sync.SettingsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Connector has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read Dex connector %s", id), err)...)
+		return
+	}
+
+	m, err := newDexConnector(c)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read Dex connector %s", id), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, m)...)
+}
+
+func (r *dexConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dexConnectorModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c, err := data.toDexConnector()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to build Dex connector", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = updateDexConnector(ctx, kc, namespace, c)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update Dex connector %s", c.ID), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated Dex connector %s in namespace %s", c.ID, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dexConnectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dexConnectorModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	sync.SettingsMutex.Lock()
+	err = deleteDexConnector(ctx, kc, namespace, id)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete Dex connector %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted Dex connector %s in namespace %s", id, namespace))
+}
+
+func (r *dexConnectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
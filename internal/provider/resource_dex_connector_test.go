@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDDexConnector(t *testing.T) {
+	connectorID := acctest.RandomWithPrefix("github")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDDexConnector(connectorID, `["platform", "developers"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_dex_connector.test", "id", connectorID),
+					resource.TestCheckResourceAttr("argocd_dex_connector.test", "github.client_secret", "$dex.github.clientSecret"),
+					resource.TestCheckResourceAttr("argocd_dex_connector.test", "github.orgs.0.teams.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "argocd_dex_connector.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDDexConnector(connectorID, `["platform"]`),
+				Check:  resource.TestCheckResourceAttr("argocd_dex_connector.test", "github.orgs.0.teams.#", "1"),
+			},
+			{
+				Config:   testAccArgoCDDexConnector(connectorID, `["platform"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDDexConnector(connectorID, teams string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_dex_connector" "test" {
+  connector_id = "%s"
+  name         = "GitHub"
+
+  github = {
+    client_id     = "aabbccddeeff00112233"
+    client_secret = "$dex.github.clientSecret"
+
+    orgs = [
+      {
+        name  = "my-org"
+        teams = %s
+      },
+    ]
+  }
+}
+`, connectorID, teams)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &notificationsTriggerResource{}
+var _ resource.ResourceWithImportState = &notificationsTriggerResource{}
+var _ resource.ResourceWithModifyPlan = &notificationsTriggerResource{}
+
+func NewNotificationsTriggerResource() resource.Resource {
+	return &notificationsTriggerResource{}
+}
+
+// notificationsTriggerResource defines the resource implementation.
+type notificationsTriggerResource struct {
+	si *ServerInterface
+}
+
+func (r *notificationsTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications_trigger"
+}
+
+func (r *notificationsTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [notification trigger](https://argo-cd.readthedocs.io/en/stable/operator-manual/notifications/triggers/) of ArgoCD, i.e. a `trigger.<name>` key of the `argocd-notifications-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the key of the trigger is written, any other settings within the ConfigMap are left untouched.\n\n" +
+			"The templates referenced by the conditions are validated against the templates declared in the ConfigMap when planning, hence they must be declared before the trigger is planned.",
+		Attributes: notificationsTriggerSchemaAttributes(),
+	}
+}
+
+func (r *notificationsTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *notificationsTriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		return
+	}
+
+	// Templates can only be validated once they are known. The conditions
+	// are checked before reading them into the model, which cannot hold
+	// unknown values.
+	var v attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("conditions"), &v)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tfv, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("conditions"), "Invalid Plan Value", err.Error())
+		return
+	}
+
+	if !tfv.IsFullyKnown() {
+		return
+	}
+
+	var data notificationsTriggerModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("conditions"), &data.Conditions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.NotificationsMutex.RLock()
+	templates, err := readNotificationsTemplates(ctx, kc, namespace)
+	sync.NotificationsMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read notification templates", err)...)
+		return
+	}
+
+	for i, c := range data.Conditions {
+		for j, t := range c.Send {
+			if slices.Contains(templates, t.ValueString()) {
+				continue
+			}
+
+			declared := "no templates are declared"
+			if len(templates) > 0 {
+				declared = "declared templates are " + strings.Join(templates, ", ")
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				path.Root("conditions").AtListIndex(i).AtName("send").AtListIndex(j),
+				"Unknown Notification Template",
+				fmt.Sprintf("template %s is not declared in the %s ConfigMap, %s", t.ValueString(), common.ArgoCDNotificationsConfigMapName, declared),
+			)
+		}
+	}
+}
+
+func (r *notificationsTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data notificationsTriggerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+	conditions := data.toConditions()
+
+	sync.NotificationsMutex.Lock()
+	err = createNotificationsTrigger(ctx, kc, namespace, name, conditions)
+	sync.NotificationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create notification trigger %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created notification trigger %s in namespace %s", name, namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newNotificationsTrigger(name, conditions))...)
+}
+
+func (r *notificationsTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data notificationsTriggerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.ID.ValueString()
+
+	sync.NotificationsMutex.RLock()
+	conditions, err := readNotificationsTrigger(ctx, kc, namespace, name)
+	sync.NotificationsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Trigger has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read notification trigger %s", name), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newNotificationsTrigger(name, conditions))...)
+}
+
+func (r *notificationsTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data notificationsTriggerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+	conditions := data.toConditions()
+
+	sync.NotificationsMutex.Lock()
+	err = updateNotificationsTrigger(ctx, kc, namespace, name, conditions)
+	sync.NotificationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update notification trigger %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated notification trigger %s in namespace %s", name, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newNotificationsTrigger(name, conditions))...)
+}
+
+func (r *notificationsTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data notificationsTriggerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	sync.NotificationsMutex.Lock()
+	err = deleteNotificationsTrigger(ctx, kc, namespace, name)
+	sync.NotificationsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete notification trigger %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted notification trigger %s in namespace %s", name, namespace))
+}
+
+func (r *notificationsTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
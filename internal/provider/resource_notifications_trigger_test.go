@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDNotificationsTrigger(t *testing.T) {
+	name := acctest.RandomWithPrefix("on-deployed")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDNotificationsTrigger(name, "app-unknown"),
+				ExpectError: regexp.MustCompile("Unknown Notification Template"),
+			},
+			{
+				Config: testAccArgoCDNotificationsTrigger(name, "app-deployed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_notifications_trigger.test", "id", name),
+					resource.TestCheckResourceAttr("argocd_notifications_trigger.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("argocd_notifications_trigger.test", "conditions.0.send.0", "app-deployed"),
+					resource.TestCheckResourceAttr("argocd_notifications_trigger.test", "conditions.0.once_per", "app.status.operationState?.syncResult?.revision"),
+				),
+			},
+			{
+				ResourceName:      "argocd_notifications_trigger.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDNotificationsTrigger(name, "app-degraded"),
+				Check:  resource.TestCheckResourceAttr("argocd_notifications_trigger.test", "conditions.0.send.0", "app-degraded"),
+			},
+			{
+				Config:   testAccArgoCDNotificationsTrigger(name, "app-degraded"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDNotificationsTrigger(name, template string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_notifications_trigger" "test" {
+  name = "%s"
+
+  conditions = [
+    {
+      description = "Application is synced and healthy. Triggered once per commit."
+      when        = "app.status.operationState != nil and app.status.operationState.phase in ['Succeeded'] and app.status.health.status == 'Healthy'"
+      send        = ["%s"]
+      once_per    = "app.status.operationState?.syncResult?.revision"
+    },
+  ]
+}
+`, name, template)
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &oidcConfigResource{}
+var _ resource.ResourceWithImportState = &oidcConfigResource{}
+
+func NewOIDCConfigResource() resource.Resource {
+	return &oidcConfigResource{}
+}
+
+// oidcConfigResource defines the resource implementation.
+type oidcConfigResource struct {
+	si *ServerInterface
+}
+
+func (r *oidcConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_config"
+}
+
+func (r *oidcConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the configuration of an [existing OIDC provider](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#existing-oidc-provider) ArgoCD delegates authentication to, i.e. the `oidc.config` key of the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the key above is written, any other settings within the ConfigMap, e.g. `url`, which is required for SSO, are left untouched. " +
+			"Creating the resource fails if an OIDC configuration is already present, so that a configuration managed elsewhere is not overwritten.\n\n" +
+			"**Note**: fields of the OIDC configuration which are not supported by this resource, e.g. `azure`, are removed when it is written.",
+		Attributes: oidcConfigSchemaAttributes(),
+	}
+}
+
+func (r *oidcConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *oidcConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data oidcConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c := data.toOIDCConfig()
+
+	sync.SettingsMutex.Lock()
+	err = createOIDCConfig(ctx, kc, namespace, c)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to create OIDC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created OIDC configuration in namespace %s", namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newOIDCConfig(c))...)
+}
+
+func (r *oidcConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data oidcConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.RLock()
+	c, err := readOIDCConfig(ctx, kc, namespace)
+	sync.SettingsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// OIDC configuration has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read OIDC configuration", err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newOIDCConfig(c))...)
+}
+
+func (r *oidcConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data oidcConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c := data.toOIDCConfig()
+
+	sync.SettingsMutex.Lock()
+	err = updateOIDCConfig(ctx, kc, namespace, c)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to update OIDC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated OIDC configuration in namespace %s", namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newOIDCConfig(c))...)
+}
+
+func (r *oidcConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data oidcConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = deleteOIDCConfig(ctx, kc, namespace)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error("failed to delete OIDC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted OIDC configuration in namespace %s", namespace))
+}
+
+func (r *oidcConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDOIDCConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDOIDCConfig("https://example.okta.com", `["openid", "profile", "email", "groups"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "id", "argocd-cm"),
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "issuer", "https://example.okta.com"),
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "client_secret.name", "argocd-secret"),
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "requested_scopes.#", "4"),
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "requested_id_token_claims.groups.essential", "true"),
+				),
+			},
+			{
+				ResourceName:      "argocd_oidc_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDOIDCConfig("https://example.okta.org", `["openid", "groups"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "issuer", "https://example.okta.org"),
+					resource.TestCheckResourceAttr("argocd_oidc_config.test", "requested_scopes.#", "2"),
+				),
+			},
+			{
+				Config:   testAccArgoCDOIDCConfig("https://example.okta.org", `["openid", "groups"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDOIDCConfig(issuer, requestedScopes string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_oidc_config" "test" {
+  name      = "Okta"
+  issuer    = "%s"
+  client_id = "argocd"
+
+  client_secret = {
+    key = "oidc.okta.clientSecret"
+  }
+
+  requested_scopes = %s
+
+  requested_id_token_claims = {
+    groups = {
+      essential = true
+    }
+  }
+}
+`, issuer, requestedScopes)
+}
@@ -1,26 +1,51 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	projectDeletionPolicyOrphan  = "orphan"
+	projectDeletionPolicyFail    = "fail"
+	projectDeletionPolicyCascade = "cascade"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &projectResource{}
+var _ resource.ResourceWithConfigValidators = &projectResource{}
+var _ resource.ResourceWithModifyPlan = &projectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &projectResource{}
@@ -43,11 +68,161 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "Project identifier",
 				Computed:    true,
 			},
+			"global_projects": schema.ListAttribute{
+				MarkdownDescription: "Names of the [global projects](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#configuring-global-projects-v19) configured in `argocd-cm` that match this project. ArgoCD merges the spec of these projects into `effective_spec` when evaluating permissions. Inherited fields are never written to the project itself, hence they are not reflected in `spec` and do not cause drift.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"effective_spec": projectEffectiveSpecSchemaAttribute(),
+			"resolved_source_namespaces": schema.ListAttribute{
+				Description: "Namespaces of the applications belonging to this project that are matched by `spec.source_namespaces`, sorted alphabetically. Namespaces without applications are not listed since ArgoCD does not expose them.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					UseUnknownOnUpdateList(),
+				},
+			},
+			"deletion_policy": schema.StringAttribute{
+				MarkdownDescription: "Controls what happens to applications referencing the project when it is destroyed. `orphan` deletes the project without inspecting its applications, `fail` refuses to delete the project while applications reference it and lists them, `cascade` deletes (with cascade) all applications referencing the project before deleting it. Note that ArgoCD itself refuses to delete projects which are referenced by applications in its control plane namespace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(projectDeletionPolicyOrphan),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectDeletionPolicyOrphan, projectDeletionPolicyFail, projectDeletionPolicyCascade),
+				},
+			},
+			"preserve_unmanaged_roles": schema.BoolAttribute{
+				MarkdownDescription: "Whether roles of the project which are not managed by Terraform (e.g. created through `argocd proj role create`) are preserved on update. Such roles are excluded from the state, hence they are neither reported as drift nor removed. Roles which were previously managed by Terraform are still deleted when they are removed from the configuration.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"prune_unmanaged_tokens": schema.BoolAttribute{
+				MarkdownDescription: "Whether JWT tokens of the roles managed by this resource which have not been issued by `argocd_project_token` (e.g. created through `argocd proj role create-token`, or leaked and forgotten) are revoked when the project is created or updated. " +
+					"Such tokens are listed in `unmanaged_tokens`, so that they cause the project to be updated. `argocd_project_token` records the IDs of the tokens it issues or imports in the `" + projectManagedTokensAnnotation + "` annotation of the project, ephemeral `argocd_project_token` tokens are only recorded while they are open if `revoke_on_close` is enabled. " +
+					"Tokens issued by earlier versions of the provider are recorded when their `argocd_project_token` is refreshed, which happens before the project is updated.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"unmanaged_tokens": schema.ListAttribute{
+				MarkdownDescription: "JWT tokens of the roles managed by this resource which have not been issued by `argocd_project_token`, of the form `<role>/<id>`, sorted alphabetically. Only populated if `prune_unmanaged_tokens` is enabled, in which case the list is always planned to be empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether a project which already exists in ArgoCD, such as the built-in `default` project, is brought under management on create instead of failing. The metadata and spec of the existing project are overwritten with the configured ones, JWT tokens and finalizers of the existing project are preserved. Note that ArgoCD never deletes the `default` project, hence destroying it only removes it from the state.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"report_events": schema.BoolAttribute{
+				MarkdownDescription: "Whether the events recorded for the project while it is created or updated are fetched once the change has been applied. Events of type `Warning` are reported as warnings of the apply, other events are only logged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"yaml": schema.StringAttribute{
+				MarkdownDescription: "The `AppProject` manifest corresponding to this resource, rendered as YAML. Only the fields managed by Terraform are included, i.e. server-managed metadata, status and JWT tokens are omitted. This can be used to bootstrap a Git repository when moving the management of projects to GitOps, e.g. with `local_file`.",
+				Computed:            true,
+			},
+			"verify_signature_keys": schema.BoolAttribute{
+				MarkdownDescription: "Whether the key IDs in `spec.signature_keys` are looked up in the GnuPG keyring of ArgoCD (as exposed by the `argocd_gpg_keys` data source) at plan time. The plan fails for key IDs which do not exist, since applications of the project would never pass signature verification. Key IDs which are unknown at plan time, e.g. `argocd_gpg_key.example.id` for a key created in the same run, are not checked.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 		Blocks: projectSchemaBlocks(),
 	}
 }
 
+func (r *projectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ProjectRolePolicies(),
+		validators.ProjectSourceNamespaces(),
+		validators.ProjectResourceLists(),
+	}
+}
+
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the project is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	manifest, diags := planProjectYAML(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("yaml"), manifest)...)
+
+	// Unmanaged tokens are revoked on apply when pruning is enabled
+	var pruneUnmanagedTokens types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("prune_unmanaged_tokens"), &pruneUnmanagedTokens)...)
+
+	switch {
+	case pruneUnmanagedTokens.IsUnknown():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_tokens"), types.ListUnknown(types.StringType))...)
+	case pruneUnmanagedTokens.ValueBool():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_tokens"), []types.String{})...)
+	default:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_tokens"), types.ListNull(types.StringType))...)
+	}
+
+	// Signature keys cannot be verified if the provider has not been
+	// configured yet
+	if resp.Diagnostics.HasError() || r.si == nil {
+		return
+	}
+
+	var verifySignatureKeys types.Bool
+
+	signatureKeysPath := path.Root("spec").AtListIndex(0).AtName("signature_keys")
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("verify_signature_keys"), &verifySignatureKeys)...)
+
+	if resp.Diagnostics.HasError() || !verifySignatureKeys.ValueBool() {
+		return
+	}
+
+	var signatureKeys types.Set
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, signatureKeysPath, &signatureKeys)...)
+
+	if resp.Diagnostics.HasError() || signatureKeys.IsNull() || signatureKeys.IsUnknown() || len(signatureKeys.Elements()) == 0 {
+		return
+	}
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyIDs, diags := gpgKeyIDs(ctx, r.si)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, e := range signatureKeys.Elements() {
+		keyID, ok := e.(types.String)
+		if !ok || keyID.IsNull() || keyID.IsUnknown() {
+			continue
+		}
+
+		if !slices.ContainsFunc(keyIDs, func(id string) bool { return strings.EqualFold(id, keyID.ValueString()) }) {
+			resp.Diagnostics.AddAttributeError(
+				signatureKeysPath.AtSetValue(keyID),
+				"Unknown Signature Key",
+				fmt.Sprintf("GPG key %s does not exist in the GnuPG keyring of ArgoCD, hence applications of the project would never pass signature verification. Add the key using the argocd_gpg_key resource or disable verify_signature_keys.", keyID.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -114,6 +289,16 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if !r.si.IsFeatureSupported(features.ProjectPermitOnlyScopedClusters) && model.PermitOnlyProjectScopedClusters.ValueBool() {
+		resp.Diagnostics.Append(diagnostics.FeatureNotSupported(features.ProjectPermitOnlyScopedClusters)...)
+		return
+	}
+
+	if !r.si.IsFeatureSupported(features.ProjectSyncWindowDescription) && slices.ContainsFunc(model.SyncWindow, func(sw syncWindowModel) bool { return sw.Description.ValueString() != "" }) {
+		resp.Diagnostics.Append(diagnostics.FeatureNotSupported(features.ProjectSyncWindowDescription)...)
+		return
+	}
+
 	// Get or create project mutex safely
 	projectMutex := argocdSync.GetProjectMutex(projectName)
 	projectMutex.Lock()
@@ -137,16 +322,59 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	// Create project
-	p, err = r.si.ProjectClient.Create(ctx, &project.ProjectCreateRequest{
-		Project: &v1alpha1.AppProject{
-			ObjectMeta: objectMeta,
-			Spec:       spec,
-		},
-		Upsert: false,
-	})
+	// Events only have a resolution of one second
+	mutatedAt := time.Now().Truncate(time.Second)
 
-	if err != nil {
+	if p != nil && p.DeletionTimestamp == nil && data.AdoptExisting.ValueBool() {
+		// Adopt the existing project while preserving the JWTs of its roles
+		// and finalizers added by others
+		for j, role := range spec.Roles {
+			if pr, _, err := p.GetRoleByName(role.Name); err == nil {
+				spec.Roles[j].JWTTokens = pr.JWTTokens
+			}
+		}
+
+		for _, f := range p.Finalizers {
+			if !slices.Contains(objectMeta.Finalizers, f) {
+				objectMeta.Finalizers = append(objectMeta.Finalizers, f)
+			}
+		}
+
+		preserveManagedTokensAnnotation(&objectMeta, p)
+
+		objectMeta.ResourceVersion = p.ResourceVersion
+
+		p, err = r.si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{
+			Project: &v1alpha1.AppProject{
+				ObjectMeta: objectMeta,
+				Spec:       spec,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("adopt", "project", projectName, err)...)
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("adopted existing project %s", projectName))
+	} else {
+		// Create project
+		p, err = r.si.ProjectClient.Create(ctx, &project.ProjectCreateRequest{
+			Project: &v1alpha1.AppProject{
+				ObjectMeta: objectMeta,
+				Spec:       spec,
+			},
+			Upsert: false,
+		})
+	}
+
+	if err != nil && strings.Contains(err.Error(), "existing project spec is different") {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to create project %s", projectName),
+			fmt.Sprintf("project %s already exists in ArgoCD with a different spec. Import it, or set adopt_existing to true to bring it under management.", projectName),
+		)
+
+		return
+	} else if err != nil {
 		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "project", projectName, err)...)
 		return
 	} else if p == nil {
@@ -160,13 +388,54 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	tflog.Trace(ctx, fmt.Sprintf("created project %s", projectName))
 
+	if data.PruneUnmanagedTokens.ValueBool() {
+		resp.Diagnostics.Append(r.pruneUnmanagedTokens(ctx, p, projectRoleNames(spec.Roles))...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.ReportEvents.ValueBool() {
+		resp.Diagnostics.Append(r.reportProjectEvents(ctx, projectName, mutatedAt)...)
+	}
+
 	// Parse response and store state
 	projectData := newProject(p)
 	projectData.ID = types.StringValue(projectName)
+	projectData.DeletionPolicy = data.DeletionPolicy
+	projectData.PreserveUnmanagedRoles = data.PreserveUnmanagedRoles
+	projectData.VerifySignatureKeys = data.VerifySignatureKeys
+	projectData.ReportEvents = data.ReportEvents
+	projectData.AdoptExisting = data.AdoptExisting
+	projectData.PruneUnmanagedTokens = data.PruneUnmanagedTokens
+
+	if data.PruneUnmanagedTokens.ValueBool() {
+		projectData.UnmanagedTokens = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	projectData.GlobalProjects, projectData.EffectiveSpec, diags = r.globalProjects(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	projectData.ResolvedSourceNamespaces, diags = r.resolvedSourceNamespaces(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Preserve empty lists from plan that ArgoCD might have normalized to null (issue #788)
 	preserveEmptyLists(&data.Spec[0], &projectData.Spec[0])
 
+	projectData.Metadata[0].Finalizers = managedFinalizers(data.Metadata[0].Finalizers, projectData.Metadata[0].Finalizers)
+
+	projectData.YAML, diags = renderProjectYAML(ctx, projectData)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, projectData)...)
 }
 
@@ -184,6 +453,12 @@ func preserveEmptyLists(sourceModel, apiModel *projectSpecModel) {
 		apiModel.SourceNamespaces = make([]types.String, 0)
 	}
 
+	// Preserve an explicitly disabled permit_only_project_scoped_clusters,
+	// since ArgoCD omits false values
+	if sourceModel.PermitOnlyProjectScopedClusters.Equal(types.BoolValue(false)) && apiModel.PermitOnlyProjectScopedClusters.IsNull() {
+		apiModel.PermitOnlyProjectScopedClusters = sourceModel.PermitOnlyProjectScopedClusters
+	}
+
 	// Preserve empty groups lists in roles
 	for i := range apiModel.Role {
 		apiRole := &apiModel.Role[i]
@@ -193,11 +468,37 @@ func preserveEmptyLists(sourceModel, apiModel *projectSpecModel) {
 				if sourceRole.Groups != nil && len(sourceRole.Groups) == 0 && apiRole.Groups == nil {
 					apiRole.Groups = make([]types.String, 0)
 				}
+
+				// Keep policies as configured when ArgoCD only rewrote their
+				// formatting
+				if len(sourceRole.Policies) == len(apiRole.Policies) {
+					for k := range apiRole.Policies {
+						if normalizeProjectPolicy(sourceRole.Policies[k].ValueString()) == normalizeProjectPolicy(apiRole.Policies[k].ValueString()) {
+							apiRole.Policies[k] = sourceRole.Policies[k]
+						}
+					}
+				}
 				break
 			}
 		}
 	}
 
+	// Preserve explicitly configured empty strings in orphaned resources ignore
+	// rules, since ArgoCD does not distinguish them from omitted fields
+	if len(sourceModel.OrphanedResources) > 0 && len(apiModel.OrphanedResources) > 0 {
+		for i := range apiModel.OrphanedResources[0].Ignore {
+			apiIgnore := &apiModel.OrphanedResources[0].Ignore[i]
+			for _, sourceIgnore := range sourceModel.OrphanedResources[0].Ignore {
+				if apiIgnore.Group.ValueString() == sourceIgnore.Group.ValueString() &&
+					apiIgnore.Kind.ValueString() == sourceIgnore.Kind.ValueString() &&
+					apiIgnore.Name.ValueString() == sourceIgnore.Name.ValueString() {
+					*apiIgnore = sourceIgnore
+					break
+				}
+			}
+		}
+	}
+
 	// Preserve empty lists and null values in sync windows (match by identifying fields since sync_window is a Set)
 	for i := range apiModel.SyncWindow {
 		apiSync := &apiModel.SyncWindow[i]
@@ -225,12 +526,29 @@ func preserveEmptyLists(sourceModel, apiModel *projectSpecModel) {
 				if sourceSync.UseAndOperator.IsNull() && apiSync.UseAndOperator.Equal(types.BoolValue(false)) {
 					apiSync.UseAndOperator = types.BoolNull()
 				}
+
+				// Timezone aliases are saved using their canonical zone
+				if !sourceSync.Timezone.IsNull() && validators.CanonicalTimezone(sourceSync.Timezone.ValueString()) == apiSync.Timezone.ValueString() {
+					apiSync.Timezone = sourceSync.Timezone
+				}
+
 				break
 			}
 		}
 	}
 }
 
+// normalizeProjectPolicy returns the canonical form of a casbin policy rule,
+// i.e. components trimmed and separated by ", " as saved by ArgoCD.
+func normalizeProjectPolicy(policy string) string {
+	components := strings.Split(policy, ",")
+	for i, c := range components {
+		components[i] = strings.TrimSpace(c)
+	}
+
+	return strings.Join(components, ", ")
+}
+
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data projectModel
 
@@ -289,6 +607,75 @@ func (r *projectResource) readUnsafe(ctx context.Context, data projectModel, pla
 	apiData := newProject(p)
 	apiData.ID = types.StringValue(projectName)
 
+	globalProjects, effectiveSpec, diags := r.globalProjects(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	resolvedSourceNamespaces, diags := r.resolvedSourceNamespaces(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData.GlobalProjects = globalProjects
+	apiData.EffectiveSpec = effectiveSpec
+	apiData.ResolvedSourceNamespaces = resolvedSourceNamespaces
+
+	apiData.DeletionPolicy = data.DeletionPolicy
+	if plan != nil {
+		apiData.DeletionPolicy = plan.DeletionPolicy
+	}
+
+	apiData.PreserveUnmanagedRoles = data.PreserveUnmanagedRoles
+	if plan != nil {
+		apiData.PreserveUnmanagedRoles = plan.PreserveUnmanagedRoles
+	}
+
+	apiData.VerifySignatureKeys = data.VerifySignatureKeys
+	if plan != nil {
+		apiData.VerifySignatureKeys = plan.VerifySignatureKeys
+	}
+
+	apiData.ReportEvents = data.ReportEvents
+	if plan != nil {
+		apiData.ReportEvents = plan.ReportEvents
+	}
+
+	apiData.AdoptExisting = data.AdoptExisting
+	if plan != nil {
+		apiData.AdoptExisting = plan.AdoptExisting
+	}
+
+	apiData.PruneUnmanagedTokens = data.PruneUnmanagedTokens
+	if plan != nil {
+		apiData.PruneUnmanagedTokens = plan.PruneUnmanagedTokens
+	}
+
+	// State written by earlier provider versions does not contain these settings
+	if apiData.DeletionPolicy.IsNull() {
+		apiData.DeletionPolicy = types.StringValue(projectDeletionPolicyOrphan)
+	}
+
+	if apiData.PreserveUnmanagedRoles.IsNull() {
+		apiData.PreserveUnmanagedRoles = types.BoolValue(false)
+	}
+
+	if apiData.VerifySignatureKeys.IsNull() {
+		apiData.VerifySignatureKeys = types.BoolValue(false)
+	}
+
+	if apiData.ReportEvents.IsNull() {
+		apiData.ReportEvents = types.BoolValue(false)
+	}
+
+	if apiData.AdoptExisting.IsNull() {
+		apiData.AdoptExisting = types.BoolValue(false)
+	}
+
+	if apiData.PruneUnmanagedTokens.IsNull() {
+		apiData.PruneUnmanagedTokens = types.BoolValue(false)
+	}
+
 	// Preserve empty lists from prior state/plan that ArgoCD might have normalized to null (issue #788)
 	// Use plan if provided (during Update), otherwise use prior state (during Read)
 	if len(data.Spec) > 0 {
@@ -297,6 +684,43 @@ func (r *projectResource) readUnsafe(ctx context.Context, data projectModel, pla
 			sourceModel = &plan.Spec[0]
 		}
 		preserveEmptyLists(sourceModel, &apiData.Spec[0])
+
+		if apiData.PreserveUnmanagedRoles.ValueBool() {
+			apiData.Spec[0].Role = managedProjectRoles(sourceModel.Role, apiData.Spec[0].Role)
+		}
+	}
+
+	if apiData.PruneUnmanagedTokens.ValueBool() && len(apiData.Spec) > 0 {
+		roles := make([]string, 0, len(apiData.Spec[0].Role))
+		for _, role := range apiData.Spec[0].Role {
+			roles = append(roles, role.Name.ValueString())
+		}
+
+		unmanagedTokens, err := unmanagedProjectTokenIDs(p, roles)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to determine unmanaged tokens of project %s", projectName), err)...)
+			return
+		}
+
+		apiData.UnmanagedTokens, diags = types.ListValueFrom(ctx, types.StringType, unmanagedTokens)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Only track the finalizers declared in the configuration
+	if len(data.Metadata) > 0 {
+		sourceMetadata := &data.Metadata[0]
+		if plan != nil && len(plan.Metadata) > 0 {
+			sourceMetadata = &plan.Metadata[0]
+		}
+
+		apiData.Metadata[0].Finalizers = managedFinalizers(sourceMetadata.Finalizers, apiData.Metadata[0].Finalizers)
+	}
+
+	apiData.YAML, diags = renderProjectYAML(ctx, apiData)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, apiData)...)
@@ -347,6 +771,16 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !r.si.IsFeatureSupported(features.ProjectPermitOnlyScopedClusters) && data.Spec[0].PermitOnlyProjectScopedClusters.ValueBool() {
+		resp.Diagnostics.Append(diagnostics.FeatureNotSupported(features.ProjectPermitOnlyScopedClusters)...)
+		return
+	}
+
+	if !r.si.IsFeatureSupported(features.ProjectSyncWindowDescription) && slices.ContainsFunc(data.Spec[0].SyncWindow, func(sw syncWindowModel) bool { return sw.Description.ValueString() != "" }) {
+		resp.Diagnostics.Append(diagnostics.FeatureNotSupported(features.ProjectSyncWindowDescription)...)
+		return
+	}
+
 	// Get or create project mutex safely
 	projectMutex := argocdSync.GetProjectMutex(projectName)
 	projectMutex.Lock()
@@ -361,14 +795,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Preserve preexisting JWTs for managed roles
-	roles := expandProjectRoles(ctx, data.Spec[0].Role)
-	for _, r := range roles {
-		var pr *v1alpha1.ProjectRole
-
-		var i int
-
-		pr, i, err = p.GetRoleByName(r.Name)
+	// Preserve preexisting JWTs for managed roles. Tokens are bookkept by
+	// ArgoCD (and argocd_project_token resources), not by this resource.
+	for j, r := range spec.Roles {
+		pr, i, err := p.GetRoleByName(r.Name)
 		if err != nil {
 			// i == -1 means the role does not exist and was recently added
 			if i != -1 {
@@ -379,12 +809,67 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 				return
 			}
-		} else {
-			// Only preserve preexisting JWTs for managed roles if we found an existing matching project
-			spec.Roles[i].JWTTokens = pr.JWTTokens
+
+			continue
 		}
+
+		// The index returned by GetRoleByName refers to the roles of the
+		// existing project, which may be ordered differently than the planned ones
+		spec.Roles[j].JWTTokens = pr.JWTTokens
 	}
 
+	if data.PreserveUnmanagedRoles.ValueBool() {
+		var state projectModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Roles are considered managed when they are either configured or were
+		// previously tracked in state, so that removing them from the
+		// configuration still deletes them.
+		managed := make(map[string]bool)
+
+		for _, r := range data.Spec[0].Role {
+			managed[r.Name.ValueString()] = true
+		}
+
+		if len(state.Spec) > 0 {
+			for _, r := range state.Spec[0].Role {
+				managed[r.Name.ValueString()] = true
+			}
+		}
+
+		for _, r := range p.Spec.Roles {
+			if !managed[r.Name] {
+				spec.Roles = append(spec.Roles, r)
+			}
+		}
+	}
+
+	// Preserve finalizers added outside of Terraform, e.g. by other
+	// controllers, while removing the ones which are no longer declared
+	var trackedFinalizers []types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("metadata").AtListIndex(0).AtName("finalizers"), &trackedFinalizers)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, f := range p.Finalizers {
+		declared := slices.Contains(objectMeta.Finalizers, f)
+		tracked := slices.ContainsFunc(trackedFinalizers, func(t types.String) bool { return t.ValueString() == f })
+
+		if !declared && !tracked {
+			objectMeta.Finalizers = append(objectMeta.Finalizers, f)
+		}
+	}
+
+	preserveManagedTokensAnnotation(&objectMeta, p)
+
 	// Update project
 	projectRequest := &project.ProjectUpdateRequest{
 		Project: &v1alpha1.AppProject{
@@ -396,7 +881,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Kubernetes API requires providing the up-to-date correct ResourceVersion for updates
 	projectRequest.Project.ResourceVersion = p.ResourceVersion
 
-	_, err = r.si.ProjectClient.Update(ctx, projectRequest)
+	// Events only have a resolution of one second
+	mutatedAt := time.Now().Truncate(time.Second)
+
+	p, err = r.si.ProjectClient.Update(ctx, projectRequest)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "project", projectName, err)...)
 		return
@@ -404,6 +892,18 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	tflog.Trace(ctx, fmt.Sprintf("updated project %s", projectName))
 
+	if data.PruneUnmanagedTokens.ValueBool() {
+		resp.Diagnostics.Append(r.pruneUnmanagedTokens(ctx, p, projectRoleNames(spec.Roles))...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.ReportEvents.ValueBool() {
+		resp.Diagnostics.Append(r.reportProjectEvents(ctx, projectName, mutatedAt)...)
+	}
+
 	// Read updated resource with plan context for proper empty list preservation
 	readReq := resource.ReadRequest{State: req.State}
 	readResp := resource.ReadResponse{State: resp.State, Diagnostics: resp.Diagnostics}
@@ -446,12 +946,47 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	projectName := data.Metadata[0].Name.ValueString()
 
-	// Get or create project mutex safely
+	// ArgoCD refuses to delete the default project
+	if projectName == v1alpha1.DefaultAppProjectName {
+		resp.Diagnostics.AddWarning(
+			"Default Project Not Deleted",
+			fmt.Sprintf("the %s project cannot be deleted from ArgoCD, it was only removed from the state and keeps its current configuration", projectName),
+		)
+
+		return
+	}
+
+	switch data.DeletionPolicy.ValueString() {
+	case projectDeletionPolicyFail:
+		apps, err := r.projectApplications(ctx, projectName)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("list", "applications of project", projectName, err)...)
+			return
+		}
+
+		if len(apps) > 0 {
+			resp.Diagnostics.AddError(
+				"Project Still In Use",
+				fmt.Sprintf("project %s cannot be deleted since it is referenced by the following applications: %s. Delete these applications first, or set deletion_policy to \"cascade\".", projectName, strings.Join(apps, ", ")),
+			)
+
+			return
+		}
+	case projectDeletionPolicyCascade:
+		resp.Diagnostics.Append(r.deleteProjectApplications(ctx, projectName)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The mutex is only acquired once the applications are gone, since waiting
+	// for their deletion may take up to 5 minutes and would block all other
+	// operations on the project, e.g. the creation of its tokens
 	projectMutex := argocdSync.GetProjectMutex(projectName)
 	projectMutex.Lock()
-	defer projectMutex.Unlock()
-
 	_, err := r.si.ProjectClient.Delete(ctx, &project.ProjectQuery{Name: projectName})
+	projectMutex.Unlock()
 
 	if err != nil && !strings.Contains(err.Error(), "NotFound") {
 		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("delete", "project", projectName, err)...)
@@ -469,31 +1004,529 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
+	// Projects can be imported using either `{name}` or `{name}:{namespace}`
+	projectName, namespace, _ := strings.Cut(req.ID, ":")
+	if projectName == "" || strings.Contains(namespace, ":") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("unexpected format of ID (%s), expected `{name}` or `{name}:{namespace}`", req.ID),
+		)
+
+		return
+	}
+
 	// Try to get the project from ArgoCD to verify it exists
 	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
-		Name: req.ID,
+		Name: projectName,
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
 			resp.Diagnostics.AddError(
 				"Cannot import non-existent remote object",
-				fmt.Sprintf("Project %s does not exist in ArgoCD", req.ID),
+				fmt.Sprintf("Project %s does not exist in ArgoCD", projectName),
 			)
 
 			return
 		}
 
-		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("get", "project", req.ID, err)...)
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("get", "project", projectName, err)...)
+
+		return
+	}
+
+	// ArgoCD only serves projects from its own control plane namespace, hence
+	// projects of other control planes have to be imported using a provider
+	// configured against that instance.
+	if namespace != "" && namespace != p.Namespace {
+		resp.Diagnostics.AddError(
+			"Cannot import project from another namespace",
+			fmt.Sprintf("Project %s was requested in namespace %s, but the configured ArgoCD instance serves projects from namespace %s. Configure the provider to connect to the ArgoCD instance running in namespace %s.", projectName, namespace, p.Namespace, namespace),
+		)
 
 		return
 	}
 
 	// If project exists, populate the state with the full project data
 	projectData := newProject(p)
-	projectData.ID = types.StringValue(req.ID)
+	projectData.ID = types.StringValue(projectName)
+
+	// It is unknown which finalizers are declared in the configuration, hence
+	// none are tracked and the existing ones are preserved on update
+	projectData.Metadata[0].Finalizers = nil
+
+	globalProjects, effectiveSpec, diags := r.globalProjects(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	resolvedSourceNamespaces, diags := r.resolvedSourceNamespaces(ctx, p)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectData.GlobalProjects = globalProjects
+	projectData.EffectiveSpec = effectiveSpec
+	projectData.ResolvedSourceNamespaces = resolvedSourceNamespaces
+	projectData.DeletionPolicy = types.StringValue(projectDeletionPolicyOrphan)
+	projectData.PreserveUnmanagedRoles = types.BoolValue(false)
+	projectData.VerifySignatureKeys = types.BoolValue(false)
+	projectData.ReportEvents = types.BoolValue(false)
+	projectData.AdoptExisting = types.BoolValue(false)
+	projectData.PruneUnmanagedTokens = types.BoolValue(false)
+
+	projectData.YAML, diags = renderProjectYAML(ctx, projectData)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, projectData)...)
 }
 
+// managedFinalizers filters finalizers down to the ones present in managed,
+// i.e. the finalizers configured in Terraform, in the configured order.
+func managedFinalizers(managed, finalizers []types.String) []types.String {
+	if managed == nil {
+		return nil
+	}
+
+	filtered := make([]types.String, 0, len(managed))
+
+	for _, m := range managed {
+		if slices.ContainsFunc(finalizers, func(f types.String) bool { return f.Equal(m) }) {
+			filtered = append(filtered, m)
+		}
+	}
+
+	return filtered
+}
+
+// managedProjectRoles filters roles down to the ones present in managed, i.e.
+// the roles configured in Terraform.
+func managedProjectRoles(managed, roles []projectRoleModel) []projectRoleModel {
+	var result []projectRoleModel
+
+	for _, r := range roles {
+		if slices.ContainsFunc(managed, func(m projectRoleModel) bool { return m.Name.Equal(r.Name) }) {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+// projectRoleNames returns the names of the given roles.
+func projectRoleNames(roles []v1alpha1.ProjectRole) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+
+	return names
+}
+
+// projectRoleTokens returns the JWT tokens of the given role, sorted by issue
+// date. Tokens are stored both in the spec and the status of projects,
+// depending on the version of ArgoCD which issued them.
+func projectRoleTokens(p *v1alpha1.AppProject, role string) []v1alpha1.JWTToken {
+	var tokens []v1alpha1.JWTToken
+
+	if pr, _, err := p.GetRoleByName(role); err == nil {
+		tokens = append(tokens, pr.JWTTokens...)
+	}
+
+	tokens = append(tokens, p.Status.JWTTokensByRole[role].Items...)
+
+	seen := make(map[string]bool)
+	unique := make([]v1alpha1.JWTToken, 0, len(tokens))
+
+	for _, t := range tokens {
+		if id := projectTokenID(t); !seen[id] {
+			seen[id] = true
+			unique = append(unique, t)
+		}
+	}
+
+	slices.SortStableFunc(unique, func(a, b v1alpha1.JWTToken) int {
+		return cmp.Compare(a.IssuedAt, b.IssuedAt)
+	})
+
+	return unique
+}
+
+// projectTokenID returns the ID of the token. Tokens issued by older versions
+// of ArgoCD do not have an ID, they are identified by their issue date.
+func projectTokenID(t v1alpha1.JWTToken) string {
+	if t.ID == "" {
+		return strconv.FormatInt(t.IssuedAt, 10)
+	}
+
+	return t.ID
+}
+
+// preserveManagedTokensAnnotation copies the tokens recorded by
+// argocd_project_token from the existing project p to objectMeta.
+func preserveManagedTokensAnnotation(objectMeta *metav1.ObjectMeta, p *v1alpha1.AppProject) {
+	v, ok := p.Annotations[projectManagedTokensAnnotation]
+	if !ok {
+		return
+	}
+
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = make(map[string]string)
+	}
+
+	objectMeta.Annotations[projectManagedTokensAnnotation] = v
+}
+
+// unmanagedProjectTokens returns the JWT tokens of the given roles which are
+// not recorded as managed by argocd_project_token, keyed by role.
+func unmanagedProjectTokens(p *v1alpha1.AppProject, roles []string) (map[string][]v1alpha1.JWTToken, error) {
+	managed, err := projectManagedTokenIDs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	unmanaged := make(map[string][]v1alpha1.JWTToken)
+
+	for _, role := range roles {
+		for _, t := range projectRoleTokens(p, role) {
+			if !slices.Contains(managed[role], projectTokenID(t)) {
+				unmanaged[role] = append(unmanaged[role], t)
+			}
+		}
+	}
+
+	return unmanaged, nil
+}
+
+// unmanagedProjectTokenIDs returns the unmanaged tokens of the given roles, of
+// the form `<role>/<id>`, sorted alphabetically.
+func unmanagedProjectTokenIDs(p *v1alpha1.AppProject, roles []string) ([]string, error) {
+	unmanaged, err := unmanagedProjectTokens(p, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+
+	for role, tokens := range unmanaged {
+		for _, t := range tokens {
+			ids = append(ids, role+"/"+projectTokenID(t))
+		}
+	}
+
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// pruneUnmanagedTokens revokes the unmanaged tokens of the given roles.
+// Callers must hold the project mutex.
+func (r *projectResource) pruneUnmanagedTokens(ctx context.Context, p *v1alpha1.AppProject, roles []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unmanaged, err := unmanagedProjectTokens(p, roles)
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to determine unmanaged tokens of project %s", p.Name), err)...)
+		return diags
+	}
+
+	for role, tokens := range unmanaged {
+		for _, t := range tokens {
+			_, err := r.si.ProjectClient.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{
+				Project: p.Name,
+				Role:    role,
+				Iat:     t.IssuedAt,
+				Id:      t.ID,
+			})
+			if err != nil && !strings.Contains(err.Error(), "NotFound") {
+				diags.Append(diagnostics.ArgoCDAPIError("revoke", "unmanaged token of project role", p.Name+"/"+role, err)...)
+				continue
+			}
+
+			tflog.Trace(ctx, fmt.Sprintf("revoked unmanaged token %s of role %s in project %s", t.ID, role, p.Name))
+		}
+	}
+
+	return diags
+}
+
+// projectApplications returns the qualified names (`namespace/name`) of the
+// applications referencing the given project, across all namespaces ArgoCD
+// is allowed to manage applications in.
+func (r *projectResource) projectApplications(ctx context.Context, projectName string) ([]string, error) {
+	apps, err := r.si.ApplicationClient.List(ctx, &application.ApplicationQuery{
+		Projects: []string{projectName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(apps.Items))
+	for _, app := range apps.Items {
+		names = append(names, fmt.Sprintf("%s/%s", app.Namespace, app.Name))
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// deleteProjectApplications deletes all applications referencing the given
+// project and waits for them to be gone.
+func (r *projectResource) deleteProjectApplications(ctx context.Context, projectName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apps, err := r.si.ApplicationClient.List(ctx, &application.ApplicationQuery{
+		Projects: []string{projectName},
+	})
+	if err != nil {
+		diags.Append(diagnostics.ArgoCDAPIError("list", "applications of project", projectName, err)...)
+		return diags
+	}
+
+	cascade := true
+
+	for _, app := range apps.Items {
+		_, err := r.si.ApplicationClient.Delete(ctx, &application.ApplicationDeleteRequest{
+			Name:         &app.Name,
+			AppNamespace: &app.Namespace,
+			Cascade:      &cascade,
+		})
+		if err != nil && !strings.Contains(err.Error(), "NotFound") {
+			diags.Append(diagnostics.ArgoCDAPIError("delete", "application", fmt.Sprintf("%s/%s", app.Namespace, app.Name), err)...)
+			return diags
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("deleted application %s/%s of project %s", app.Namespace, app.Name, projectName))
+	}
+
+	if len(apps.Items) == 0 {
+		return diags
+	}
+
+	// Applications are only removed once their finalizers have run
+	timeout := 5 * time.Minute
+
+	retryErr := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		remaining, err := r.projectApplications(ctx, projectName)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(remaining) > 0 {
+			return retry.RetryableError(fmt.Errorf("applications %s are still being deleted", strings.Join(remaining, ", ")))
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		diags.AddError(
+			"Application Deletion Failed",
+			fmt.Sprintf("applications of project %s could not be deleted: %s", projectName, retryErr),
+		)
+	}
+
+	return diags
+}
+
+// reportProjectEvents returns a warning for each event of type Warning which
+// was recorded for the given project since the given time. Other events are
+// only logged.
+func (r *projectResource) reportProjectEvents(ctx context.Context, projectName string, since time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	events, err := r.si.ProjectClient.ListEvents(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		// Events are informational only, hence failing to fetch them must not
+		// fail the apply
+		diags.AddWarning(
+			"Project Events Unavailable",
+			fmt.Sprintf("events of project %s could not be listed: %s", projectName, err.Error()),
+		)
+
+		return diags
+	}
+
+	for _, e := range events.Items {
+		at := e.LastTimestamp.Time
+		if at.IsZero() {
+			at = e.EventTime.Time
+		}
+
+		if at.Before(since) {
+			continue
+		}
+
+		if e.Type == "Warning" {
+			diags.AddWarning(
+				fmt.Sprintf("Project Event: %s", e.Reason),
+				fmt.Sprintf("project %s: %s", projectName, e.Message),
+			)
+
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("project %s event %s: %s", projectName, e.Reason, e.Message))
+	}
+
+	return diags
+}
+
+// globalProjects returns the names of the global projects that apply to the
+// given project, along with its effective spec.
+func (r *projectResource) globalProjects(ctx context.Context, p *v1alpha1.AppProject) (types.List, types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	gp, err := r.si.ProjectClient.GetGlobalProjects(ctx, &project.ProjectQuery{
+		Name: p.Name,
+	})
+	if err != nil {
+		diags.Append(diagnostics.ArgoCDAPIError("get", "global projects for project", p.Name, err)...)
+		return types.ListNull(types.StringType), types.ObjectNull(projectEffectiveSpecAttrTypes()), diags
+	}
+
+	names := make([]string, 0, len(gp.Items))
+	for _, g := range gp.Items {
+		names = append(names, g.Name)
+	}
+
+	globalProjects, d := types.ListValueFrom(ctx, types.StringType, names)
+	diags.Append(d...)
+
+	effectiveSpec, d := newProjectEffectiveSpec(ctx, p.Spec, gp.Items)
+	diags.Append(d...)
+
+	return globalProjects, effectiveSpec, diags
+}
+
+// planProjectYAML renders the manifest of the planned project, or returns an
+// unknown value if the manifest depends on values which are not yet known.
+func planProjectYAML(ctx context.Context, plan tfsdk.Plan) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadataPath := path.Root("metadata").AtListIndex(0)
+
+	for _, p := range []path.Path{
+		metadataPath.AtName("name"),
+		metadataPath.AtName("namespace"),
+		metadataPath.AtName("labels"),
+		metadataPath.AtName("annotations"),
+		metadataPath.AtName("finalizers"),
+		path.Root("spec"),
+	} {
+		var v attr.Value
+
+		diags.Append(plan.GetAttribute(ctx, p, &v)...)
+
+		if diags.HasError() {
+			return types.StringUnknown(), diags
+		}
+
+		tfv, err := v.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddAttributeError(p, "Invalid Plan Value", err.Error())
+			return types.StringUnknown(), diags
+		}
+
+		if !tfv.IsFullyKnown() {
+			return types.StringUnknown(), diags
+		}
+	}
+
+	var data projectModel
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("metadata"), &data.Metadata)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("spec"), &data.Spec)...)
+
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+
+	return renderProjectYAML(ctx, &data)
+}
+
+// renderProjectYAML renders the AppProject manifest corresponding to the given
+// Terraform model.
+func renderProjectYAML(ctx context.Context, data *projectModel) (types.String, diag.Diagnostics) {
+	objectMeta, spec, diags := expandProject(ctx, data)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	b, err := json.Marshal(&v1alpha1.AppProject{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "argoproj.io/v1alpha1",
+			Kind:       "AppProject",
+		},
+		ObjectMeta: objectMeta,
+		Spec:       spec,
+	})
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to render manifest of project %s", objectMeta.Name), err)...)
+		return types.StringNull(), diags
+	}
+
+	// Drop the fields which are always serialized despite being empty
+	var manifest map[string]any
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to render manifest of project %s", objectMeta.Name), err)...)
+		return types.StringNull(), diags
+	}
+
+	delete(manifest, "status")
+
+	if m, ok := manifest["metadata"].(map[string]any); ok {
+		delete(m, "creationTimestamp")
+	}
+
+	y, err := yaml.Marshal(manifest)
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to render manifest of project %s", objectMeta.Name), err)...)
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(string(y)), diags
+}
+
+// resolvedSourceNamespaces returns the distinct namespaces of the
+// applications belonging to the given project that are matched by its source
+// namespaces.
+func (r *projectResource) resolvedSourceNamespaces(ctx context.Context, p *v1alpha1.AppProject) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	namespaces := make([]string, 0)
+
+	// Avoid listing applications, which requires additional permissions, when
+	// there is nothing to match against
+	if len(p.Spec.SourceNamespaces) > 0 {
+		apps, err := r.si.ApplicationClient.List(ctx, &application.ApplicationQuery{
+			Projects: []string{p.Name},
+		})
+		if err != nil {
+			diags.Append(diagnostics.ArgoCDAPIError("list", "applications of project", p.Name, err)...)
+			return types.ListNull(types.StringType), diags
+		}
+
+		for _, app := range apps.Items {
+			if glob.MatchStringInList(p.Spec.SourceNamespaces, app.Namespace, glob.REGEXP) && !slices.Contains(namespaces, app.Namespace) {
+				namespaces = append(namespaces, app.Namespace)
+			}
+		}
+
+		slices.Sort(namespaces)
+	}
+
+	resolved, d := types.ListValueFrom(ctx, types.StringType, namespaces)
+	diags.Append(d...)
+
+	return resolved, diags
+}
+
 // expandProject converts the Terraform model to ArgoCD API types
 func expandProject(ctx context.Context, data *projectModel) (metav1.ObjectMeta, v1alpha1.AppProjectSpec, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -541,12 +1574,18 @@ func expandProject(ctx context.Context, data *projectModel) (metav1.ObjectMeta,
 		objectMeta.Annotations = annotations
 	}
 
+	for _, f := range data.Metadata[0].Finalizers {
+		objectMeta.Finalizers = append(objectMeta.Finalizers, f.ValueString())
+	}
+
 	spec := v1alpha1.AppProjectSpec{}
 
 	if !data.Spec[0].Description.IsNull() {
 		spec.Description = data.Spec[0].Description.ValueString()
 	}
 
+	spec.PermitOnlyProjectScopedClusters = data.Spec[0].PermitOnlyProjectScopedClusters.ValueBool()
+
 	// Convert source repos
 	// Initialize to empty slice if set (even if empty) to maintain empty list vs null distinction
 	// This fixes issue #788 where empty lists were incorrectly converted to null
@@ -668,6 +1707,10 @@ func expandProject(ctx context.Context, data *projectModel) (metav1.ObjectMeta,
 			window.UseAndOperator = sw.UseAndOperator.ValueBool()
 		}
 
+		if !sw.Description.IsNull() {
+			window.Description = sw.Description.ValueString()
+		}
+
 		if !sw.Duration.IsNull() {
 			window.Duration = sw.Duration.ValueString()
 		}
@@ -685,7 +1728,7 @@ func expandProject(ctx context.Context, data *projectModel) (metav1.ObjectMeta,
 		}
 
 		if !sw.Timezone.IsNull() {
-			window.TimeZone = sw.Timezone.ValueString()
+			window.TimeZone = validators.CanonicalTimezone(sw.Timezone.ValueString())
 		}
 
 		// Initialize to empty slice if set (even if empty) to maintain empty list vs null distinction
@@ -1,14 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccArgoCDProject(t *testing.T) {
@@ -56,9 +63,39 @@ func TestAccArgoCDProject(t *testing.T) {
 			},
 			{
 				Config: testAccArgoCDProjectSimple(name),
-				Check: resource.TestCheckResourceAttrSet(
-					"argocd_project.simple",
-					"metadata.0.uid",
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.simple",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"global_projects.#",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"effective_spec.source_repos.0",
+						"*",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"effective_spec.destination.#",
+						"2",
+					),
+					resource.TestCheckResourceAttrWith(
+						"argocd_project.simple",
+						"yaml",
+						func(value string) error {
+							for _, expected := range []string{"apiVersion: argoproj.io/v1alpha1", "kind: AppProject", "name: " + name, "namespace: argocd"} {
+								if !strings.Contains(value, expected) {
+									return fmt.Errorf("expected manifest to contain %q, got:\n%s", expected, value)
+								}
+							}
+
+							return nil
+						},
+					),
 				),
 			},
 			{
@@ -167,6 +204,18 @@ func TestAccArgoCDProjectUpdateAddRole(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "argocd_project.simple",
+				ImportState:       true,
+				ImportStateId:     name + ":argocd",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "argocd_project.simple",
+				ImportState:   true,
+				ImportStateId: name + ":another-argocd",
+				ExpectError:   regexp.MustCompile("serves projects from namespace argocd"),
+			},
 			{
 				Config: testAccArgoCDProjectSimpleWithRole(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -237,6 +286,10 @@ func TestAccArgoCDProjectWithSourceNamespaces(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ProjectSourceNamespaces) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectInvalidSourceNamespace(name),
+				ExpectError: regexp.MustCompile("cannot compile pattern"),
+			},
 			{
 				Config: testAccArgoCDProjectWithSourceNamespaces(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -244,6 +297,11 @@ func TestAccArgoCDProjectWithSourceNamespaces(t *testing.T) {
 						"argocd_project.simple",
 						"metadata.0.uid",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"resolved_source_namespaces.#",
+						"0",
+					),
 				),
 			},
 			{
@@ -495,6 +553,86 @@ func testAccArgoCDProjectSimpleWithEmptyOrphaned(name string) string {
 	`, name)
 }
 
+// TestAccArgoCDProject_tokensWithMultipleRoles tests that tokens issued for
+// roles neither cause drift nor get lost when other roles are changed.
+func TestAccArgoCDProject_tokensWithMultipleRoles(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-tokens")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectTokensWithMultipleRoles(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.tokens", "spec.0.role.#", "2"),
+					resource.TestCheckNoResourceAttr("argocd_project.tokens", "spec.0.role.0.jwt_tokens"),
+					resource.TestCheckNoResourceAttr("argocd_project.tokens", "spec.0.role.1.jwt_tokens"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectTokensWithMultipleRoles(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Removing the first role shifts the index of the role holding the tokens
+				Config: testAccArgoCDProjectTokensWithMultipleRoles(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.tokens", "spec.0.role.#", "1"),
+					resource.TestCheckResourceAttrSet("argocd_project_token.tokens.2", "issued_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectTokensWithMultipleRoles(name string, withFirstRole bool) string {
+	firstRole := fmt.Sprintf(`
+    role {
+      name     = "first"
+      policies = ["p, proj:%[1]s:first, applications, get, %[1]s/*, allow"]
+    }
+`, name)
+
+	if !withFirstRole {
+		firstRole = ""
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_project" "tokens" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "project with token heavy roles"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+%[2]s
+    role {
+      name     = "second"
+      policies = ["p, proj:%[1]s:second, applications, sync, %[1]s/*, allow"]
+    }
+  }
+}
+
+resource "argocd_project_token" "tokens" {
+  count   = 3
+  project = argocd_project.tokens.metadata.0.name
+  role    = "second"
+}
+`, name, firstRole)
+}
+
 func testAccArgoCDProjectCoexistenceWithTokenResource(name string, count int) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "coexistence" {
@@ -850,6 +988,27 @@ func testAccArgoCDProjectWithExecLogsRolePolicy(name string) string {
 	`, name)
 }
 
+func testAccArgoCDProjectInvalidSourceNamespace(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "simple" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos      = ["*"]
+    source_namespaces = ["team-[a"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+	`, name)
+}
+
 func testAccArgoCDProjectWithSourceNamespaces(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "simple" {
@@ -1183,6 +1342,7 @@ resource "argocd_project" "sync_windows_consistency" {
     }
     sync_window {
       use_and_operator = true
+      description = "CHANGE-1234: freeze deployments"
       kind = "deny"
       applications = ["foo", "bar"]
       clusters = ["in-cluster"]
@@ -1197,7 +1357,10 @@ resource "argocd_project" "sync_windows_consistency" {
 `, name)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ProjectSyncWindowDescription)
+		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -1228,6 +1391,11 @@ resource "argocd_project" "sync_windows_consistency" {
 						"spec.0.sync_window.1.timezone",
 						"Europe/London",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.sync_windows_consistency",
+						"spec.0.sync_window.1.description",
+						"CHANGE-1234: freeze deployments",
+					),
 				),
 			},
 			{
@@ -1259,6 +1427,11 @@ resource "argocd_project" "sync_windows_consistency" {
 						"spec.0.sync_window.1.timezone",
 						"Europe/London",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.sync_windows_consistency",
+						"spec.0.sync_window.1.description",
+						"CHANGE-1234: freeze deployments",
+					),
 				),
 			},
 		},
@@ -1350,6 +1523,79 @@ resource "argocd_project" "orphaned_resources_consistency" {
 	})
 }
 
+// TestAccArgoCDProject_OrphanedResourcesGlobs tests that glob patterns and
+// omitted or empty fields in orphaned resources ignore rules do not cause drift
+func TestAccArgoCDProject_OrphanedResourcesGlobs(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-orphaned-globs")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectOrphanedResourcesGlobs(name, "[invalid"),
+				ExpectError: regexp.MustCompile("cannot compile pattern"),
+			},
+			{
+				Config: testAccArgoCDProjectOrphanedResourcesGlobs(name, "prefix-*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.orphaned_globs", "spec.0.orphaned_resources.0.ignore.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("argocd_project.orphaned_globs", "spec.0.orphaned_resources.0.ignore.*", map[string]string{
+						"group": "",
+						"kind":  "ConfigMap",
+						"name":  "prefix-*",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("argocd_project.orphaned_globs", "spec.0.orphaned_resources.0.ignore.*", map[string]string{
+						"group": "*.example.com",
+					}),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectOrphanedResourcesGlobs(name, "prefix-*"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectOrphanedResourcesGlobs(name, namePattern string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "orphaned_globs" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    orphaned_resources {
+      warn = true
+
+      ignore {
+        group = ""
+        kind  = "ConfigMap"
+        name  = "%s"
+      }
+
+      ignore {
+        group = "*.example.com"
+      }
+    }
+  }
+}
+`, name, namePattern)
+}
+
 func testAccArgoCDProjectWithFineGrainedPolicy(name string) string {
 	return fmt.Sprintf(`
   resource "argocd_project" "fine_grained_policy" {
@@ -1620,48 +1866,45 @@ resource "argocd_project" "tech" {
 	`, name)
 }
 
-// TestAccArgoCDProject_EmptySourceRepos tests the issue #788 where an empty source_repos list
-// causes "Provider produced inconsistent result after apply" error.
-// The provider should maintain an empty list as empty list, not convert it to null.
-func TestAccArgoCDProject_EmptySourceRepos(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc-empty-repos")
-	config := testAccArgoCDProjectWithEmptySourceRepos(name)
+// TestAccArgoCDProject_DeletionPolicy tests that a project using the `fail`
+// deletion policy is not deleted while applications still reference it.
+func TestAccArgoCDProject_DeletionPolicy(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-deletion")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
+				Config: testAccArgoCDProjectDeletionPolicy(name, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("argocd_project.empty_repos", "metadata.0.name", name),
-					resource.TestCheckResourceAttr("argocd_project.empty_repos", "spec.0.source_repos.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.deletion", "deletion_policy", "fail"),
+					resource.TestCheckResourceAttr("argocd_application.deletion", "spec.0.project", name),
 				),
 			},
 			{
-				// Apply the same configuration again to verify no drift
-				Config: config,
-				ConfigPlanChecks: resource.ConfigPlanChecks{
-					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectEmptyPlan(),
-					},
-				},
+				Config:      testAccArgoCDProjectDeletionPolicy(name, false),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("referenced by the following applications: argocd/%s", name)),
+			},
+			{
+				Config: testAccArgoCDProjectDeletionPolicy(name, true),
 			},
 		},
 	})
 }
 
-func testAccArgoCDProjectWithEmptySourceRepos(name string) string {
-	return fmt.Sprintf(`
-resource "argocd_project" "empty_repos" {
+func testAccArgoCDProjectDeletionPolicy(name string, withProject bool) string {
+	project := fmt.Sprintf(`
+resource "argocd_project" "deletion" {
   metadata {
     name      = "%s"
     namespace = "argocd"
   }
 
+  deletion_policy = "fail"
+
   spec {
-    description  = "project with empty source_repos"
-    source_repos = []
+    source_repos = ["*"]
 
     destination {
       server    = "https://kubernetes.default.svc"
@@ -1669,49 +1912,112 @@ resource "argocd_project" "empty_repos" {
     }
   }
 }
-	`, name)
+`, name)
+	dependsOn := "depends_on = [argocd_project.deletion]"
+
+	if !withProject {
+		project = ""
+		dependsOn = ""
+	}
+
+	return project + fmt.Sprintf(`
+resource "argocd_application" "deletion" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = "%[1]s"
+
+    source {
+      repo_url        = "https://github.com/kubernetes-sigs/kustomize"
+      path            = "examples/helloWorld"
+      target_revision = "release-kustomize-v3.7"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  %[2]s
+}
+`, name, dependsOn)
 }
 
-// TestAccArgoCDProject_EmptyRoleGroups tests that empty groups list in roles
-// doesn't cause "Provider produced inconsistent result after apply" error.
-func TestAccArgoCDProject_EmptyRoleGroups(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc-empty-groups")
-	config := testAccArgoCDProjectWithEmptyRoleGroups(name)
+// TestAccArgoCDProject_PreserveUnmanagedRoles tests that roles created outside
+// of Terraform are neither reported as drift nor removed on update.
+func TestAccArgoCDProject_PreserveUnmanagedRoles(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-unmanaged")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
+				Config: testAccArgoCDProjectPreserveUnmanagedRoles(name, "initial"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("argocd_project.empty_groups", "metadata.0.name", name),
-					resource.TestCheckResourceAttr("argocd_project.empty_groups", "spec.0.role.0.groups.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "preserve_unmanaged_roles", "true"),
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.role.#", "1"),
 				),
 			},
 			{
-				// Apply the same configuration again to verify no drift
-				Config: config,
+				PreConfig: func() {
+					si, err := getServerInterface()
+					if err != nil {
+						t.Fatalf("failed to get server interface: %s", err)
+					}
+
+					ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+					defer cancel()
+
+					p, err := si.ProjectClient.Get(ctx, &project.ProjectQuery{Name: name})
+					if err != nil {
+						t.Fatalf("failed to get project %s: %s", name, err)
+					}
+
+					p.Spec.Roles = append(p.Spec.Roles, v1alpha1.ProjectRole{
+						Name:     "external",
+						Policies: []string{fmt.Sprintf("p, proj:%s:external, applications, get, %s/*, allow", name, name)},
+					})
+
+					if _, err = si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{Project: p}); err != nil {
+						t.Fatalf("failed to add role to project %s: %s", name, err)
+					}
+				},
+				Config: testAccArgoCDProjectPreserveUnmanagedRoles(name, "initial"),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectEmptyPlan(),
 					},
 				},
 			},
+			{
+				Config: testAccArgoCDProjectPreserveUnmanagedRoles(name, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.description", "updated"),
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.role.#", "1"),
+					testCheckArgoCDProjectHasRole(name, "external"),
+				),
+			},
 		},
 	})
 }
 
-func testAccArgoCDProjectWithEmptyRoleGroups(name string) string {
+func testAccArgoCDProjectPreserveUnmanagedRoles(name, description string) string {
 	return fmt.Sprintf(`
-resource "argocd_project" "empty_groups" {
+resource "argocd_project" "unmanaged" {
   metadata {
-    name      = "%s"
+    name      = "%[1]s"
     namespace = "argocd"
   }
 
+  preserve_unmanaged_roles = true
+
   spec {
-    description  = "project with role having empty groups"
+    description  = "%[2]s"
     source_repos = ["*"]
 
     destination {
@@ -1720,54 +2026,420 @@ resource "argocd_project" "empty_groups" {
     }
 
     role {
-      name     = "test-role"
-      groups   = []
-      policies = ["p, proj:%s:test-role, applications, get, %s/*, allow"]
+      name     = "managed"
+      policies = ["p, proj:%[1]s:managed, applications, get, %[1]s/*, allow"]
     }
   }
 }
-	`, name, name, name)
+`, name, description)
 }
 
-// TestAccArgoCDProject_EmptyListsComprehensive tests multiple empty list fields
-// in a single project to ensure they all work correctly together (issue #788)
-func TestAccArgoCDProject_EmptyListsComprehensive(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc-comprehensive")
-	config := testAccArgoCDProjectWithMultipleEmptyLists(name)
+func TestAccArgoCDProject_PruneUnmanagedTokens(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-prune")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "metadata.0.name", name),
-					// Verify empty source_repos
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.source_repos.#", "0"),
-					// Verify empty signature_keys
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.signature_keys.#", "0"),
-					// Verify empty groups in role
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.role.0.groups.#", "0"),
-					// Verify sync window with mixed empty/non-empty lists
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.applications.#", "1"),
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.clusters.#", "0"),
-					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.namespaces.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.prune", "prune_unmanaged_tokens", "true"),
+					resource.TestCheckResourceAttr("argocd_project.prune", "unmanaged_tokens.#", "0"),
+					resource.TestCheckNoResourceAttr("argocd_project.prune", "metadata.0.annotations.%"),
+					testCheckArgoCDProjectTokenCount(name, "ci", 1),
 				),
 			},
 			{
-				// Apply the same configuration again to verify no drift
-				Config: config,
+				PreConfig: func() {
+					si, err := getServerInterface()
+					if err != nil {
+						t.Fatalf("failed to get server interface: %s", err)
+					}
+
+					ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+					defer cancel()
+
+					if _, err = si.ProjectClient.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: name, Role: "ci"}); err != nil {
+						t.Fatalf("failed to create token for project %s: %s", name, err)
+					}
+
+					// Tokens are recognized by the IDs recorded on the
+					// project, not by the form of their ID
+					if _, err = si.ProjectClient.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: name, Role: "ci", Id: "terraform-cli"}); err != nil {
+						t.Fatalf("failed to create token for project %s: %s", name, err)
+					}
+				},
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, true),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectEmptyPlan(),
+						plancheck.ExpectResourceAction("argocd_project.prune", plancheck.ResourceActionUpdate),
 					},
 				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.prune", "unmanaged_tokens.#", "0"),
+					testCheckArgoCDProjectTokenCount(name, "ci", 1),
+				),
 			},
-		},
-	})
-}
-
+			{
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProject_PruneUnmanagedTokensNotRecorded(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-prune")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, false),
+				Check:  testCheckArgoCDProjectTokenCount(name, "ci", 1),
+			},
+			{
+				// Tokens issued by earlier versions of the provider have not
+				// been recorded on the project
+				PreConfig: func() {
+					si, err := getServerInterface()
+					if err != nil {
+						t.Fatalf("failed to get server interface: %s", err)
+					}
+
+					ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+					defer cancel()
+
+					p, err := si.ProjectClient.Get(ctx, &project.ProjectQuery{Name: name})
+					if err != nil {
+						t.Fatalf("failed to get project %s: %s", name, err)
+					}
+
+					delete(p.Annotations, projectManagedTokensAnnotation)
+
+					if _, err = si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{Project: p}); err != nil {
+						t.Fatalf("failed to update project %s: %s", name, err)
+					}
+				},
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.prune", "unmanaged_tokens.#", "0"),
+					testCheckArgoCDProjectTokenCount(name, "ci", 1),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectPruneUnmanagedTokens(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectPruneUnmanagedTokens(name string, prune bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "prune" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  prune_unmanaged_tokens = %[2]t
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "ci"
+      policies = ["p, proj:%[1]s:ci, applications, get, %[1]s/*, allow"]
+    }
+  }
+}
+
+resource "argocd_project_token" "managed" {
+  project = argocd_project.prune.metadata.0.name
+  role    = "ci"
+}
+`, name, prune)
+}
+
+func testCheckArgoCDProjectTokenCount(projectName, roleName string, count int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		si, err := getServerInterface()
+		if err != nil {
+			return err
+		}
+
+		p, err := si.ProjectClient.Get(context.Background(), &project.ProjectQuery{Name: projectName})
+		if err != nil {
+			return fmt.Errorf("failed to get project %s: %w", projectName, err)
+		}
+
+		if got := len(p.Status.JWTTokensByRole[roleName].Items); got != count {
+			return fmt.Errorf("expected %d tokens for role %s of project %s, got %d", count, roleName, projectName, got)
+		}
+
+		return nil
+	}
+}
+
+func testCheckArgoCDProjectHasRole(projectName, roleName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		si, err := getServerInterface()
+		if err != nil {
+			return err
+		}
+
+		p, err := si.ProjectClient.Get(context.Background(), &project.ProjectQuery{Name: projectName})
+		if err != nil {
+			return fmt.Errorf("failed to get project %s: %w", projectName, err)
+		}
+
+		if _, _, err = p.GetRoleByName(roleName); err != nil {
+			return fmt.Errorf("role %s of project %s was not preserved: %w", roleName, projectName, err)
+		}
+
+		return nil
+	}
+}
+
+// TestAccArgoCDProject_EmptySourceRepos tests the issue #788 where an empty source_repos list
+// causes "Provider produced inconsistent result after apply" error.
+// The provider should maintain an empty list as empty list, not convert it to null.
+func TestAccArgoCDProject_EmptySourceRepos(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-empty-repos")
+	config := testAccArgoCDProjectWithEmptySourceRepos(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.empty_repos", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("argocd_project.empty_repos", "spec.0.source_repos.#", "0"),
+				),
+			},
+			{
+				// Apply the same configuration again to verify no drift
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectWithEmptySourceRepos(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "empty_repos" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "project with empty source_repos"
+    source_repos = []
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+	`, name)
+}
+
+// TestAccArgoCDProject_ReorderedCollections tests that reordering source
+// repositories, destinations and destination service accounts does not cause
+// a diff.
+func TestAccArgoCDProject_ReorderedCollections(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-reordered")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ProjectDestinationServiceAccounts)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectReorderedCollections(name, false),
+				Check:  resource.TestCheckResourceAttr("argocd_project.reordered", "spec.0.source_repos.#", "2"),
+			},
+			{
+				Config: testAccArgoCDProjectReorderedCollections(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectReorderedCollections(name string, reversed bool) string {
+	repos := []string{`"https://github.com/argoproj/argo-cd.git"`, `"https://github.com/argoproj/argocd-example-apps.git"`}
+	destinations := []string{"default", "kube-system"}
+
+	if reversed {
+		slices.Reverse(repos)
+		slices.Reverse(destinations)
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_project" "reordered" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "project with reordered collections"
+    source_repos = [%[2]s]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[3]s"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[4]s"
+    }
+
+    destination_service_account {
+      default_service_account = "%[3]s-sa"
+      namespace               = "%[3]s"
+      server                  = "https://kubernetes.default.svc"
+    }
+
+    destination_service_account {
+      default_service_account = "%[4]s-sa"
+      namespace               = "%[4]s"
+      server                  = "https://kubernetes.default.svc"
+    }
+  }
+}
+	`, name, strings.Join(repos, ", "), destinations[0], destinations[1])
+}
+
+// TestAccArgoCDProject_EmptyRoleGroups tests that empty groups list in roles
+// doesn't cause "Provider produced inconsistent result after apply" error.
+func TestAccArgoCDProject_EmptyRoleGroups(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-empty-groups")
+	config := testAccArgoCDProjectWithEmptyRoleGroups(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.empty_groups", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("argocd_project.empty_groups", "spec.0.role.0.groups.#", "0"),
+				),
+			},
+			{
+				// Apply the same configuration again to verify no drift
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectWithEmptyRoleGroups(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "empty_groups" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "project with role having empty groups"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "test-role"
+      groups   = []
+      policies = ["p, proj:%s:test-role, applications, get, %s/*, allow"]
+    }
+  }
+}
+	`, name, name, name)
+}
+
+// TestAccArgoCDProject_EmptyListsComprehensive tests multiple empty list fields
+// in a single project to ensure they all work correctly together (issue #788)
+func TestAccArgoCDProject_EmptyListsComprehensive(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-comprehensive")
+	config := testAccArgoCDProjectWithMultipleEmptyLists(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "metadata.0.name", name),
+					// Verify empty source_repos
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.source_repos.#", "0"),
+					// Verify empty signature_keys
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.signature_keys.#", "0"),
+					// Verify empty groups in role
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.role.0.groups.#", "0"),
+					// Verify sync window with mixed empty/non-empty lists
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.applications.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.clusters.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.comprehensive", "spec.0.sync_window.0.namespaces.#", "0"),
+				),
+			},
+			{
+				// Apply the same configuration again to verify no drift
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccArgoCDProjectWithMultipleEmptyLists(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "comprehensive" {
@@ -1777,56 +2449,361 @@ resource "argocd_project" "comprehensive" {
   }
 
   spec {
-    description     = "project testing multiple empty list fields"
-    source_repos    = []
-    signature_keys  = []
+    description     = "project testing multiple empty list fields"
+    source_repos    = []
+    signature_keys  = []
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "test-role"
+      groups   = []
+      policies = ["p, proj:%s:test-role, applications, get, %s/*, allow"]
+    }
+
+    sync_window {
+      kind         = "allow"
+      schedule     = "0 0 * * *"
+      duration     = "1h"
+      applications = ["test-app"]
+      clusters     = []
+      namespaces   = []
+    }
+  }
+}
+	`, name, name, name)
+}
+
+// TestAccArgoCDProject_MetadataComputedFieldsOnUpdate tests that computed metadata fields
+// (resource_version, generation, uid) don't cause "inconsistent state after apply" errors
+// when the spec is updated with lifecycle { ignore_changes = [metadata] }.
+// This is a regression test for issue #807.
+// See: https://github.com/argoproj-labs/terraform-provider-argocd/issues/807
+func TestAccArgoCDProject_MetadataComputedFieldsOnUpdate(t *testing.T) {
+	name := acctest.RandString(10)
+
+	// Initial configuration with lifecycle ignore_changes on metadata
+	// This replicates the exact scenario from issue #807
+	configInitial := fmt.Sprintf(`
+resource "argocd_project" "metadata_computed" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+    labels = {
+      "test" = "initial"
+    }
+  }
+
+  spec {
+    description  = "initial description"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [metadata]
+  }
+}
+`, name)
+
+	// Updated configuration - changes the spec but keeps metadata the same
+	// With ignore_changes = [metadata], this should trigger resource_version
+	// and generation changes without causing "inconsistent state after apply" errors
+	configUpdated := fmt.Sprintf(`
+resource "argocd_project" "metadata_computed" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+    labels = {
+      "test" = "initial"
+    }
+  }
+
+  spec {
+    description  = "updated description"
+    source_repos = ["*", "https://github.com/example/repo"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "test"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [metadata]
+  }
+}
+`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: configInitial,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.metadata_computed",
+						"metadata.0.name",
+						name,
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.metadata_computed",
+						"metadata.0.resource_version",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.metadata_computed",
+						"metadata.0.generation",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.metadata_computed",
+						"metadata.0.uid",
+					),
+				),
+			},
+			{
+				// Update the spec - this should not cause inconsistent state errors
+				// even though resource_version and generation will change
+				Config: configUpdated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.metadata_computed",
+						"spec.0.description",
+						"updated description",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.metadata_computed",
+						"metadata.0.resource_version",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.metadata_computed",
+						"metadata.0.generation",
+					),
+				),
+			},
+			{
+				// Apply the same configuration again to ensure no drift
+				Config: configUpdated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestAccArgoCDProject_MultipleSpecUpdates tests that multiple sequential spec updates
+// don't cause inconsistent state errors due to rapidly changing resource_version/generation.
+// This is a stress test for issue #807.
+func TestAccArgoCDProject_MultipleSpecUpdates(t *testing.T) {
+	name := acctest.RandString(10)
+
+	configs := []string{
+		// Config 1: Initial
+		fmt.Sprintf(`
+resource "argocd_project" "multi_update" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "version 1"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+`, name),
+		// Config 2: Update description
+		fmt.Sprintf(`
+resource "argocd_project" "multi_update" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "version 2"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+`, name),
+		// Config 3: Add destination
+		fmt.Sprintf(`
+resource "argocd_project" "multi_update" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "version 3"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "kube-system"
+    }
+  }
+}
+`, name),
+		// Config 4: Add role
+		fmt.Sprintf(`
+resource "argocd_project" "multi_update" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "version 4"
+    source_repos = ["*"]
 
     destination {
       server    = "https://kubernetes.default.svc"
       namespace = "default"
     }
 
-    role {
-      name     = "test-role"
-      groups   = []
-      policies = ["p, proj:%s:test-role, applications, get, %s/*, allow"]
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "kube-system"
     }
 
-    sync_window {
-      kind         = "allow"
-      schedule     = "0 0 * * *"
-      duration     = "1h"
-      applications = ["test-app"]
-      clusters     = []
-      namespaces   = []
+    role {
+      name     = "test-role"
+      policies = ["p, proj:%[1]s:test-role, applications, get, %[1]s/*, allow"]
     }
   }
 }
-	`, name, name, name)
+`, name),
+	}
+
+	steps := make([]resource.TestStep, len(configs))
+	for i, config := range configs {
+		steps[i] = resource.TestStep{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"argocd_project.multi_update",
+					"spec.0.description",
+					fmt.Sprintf("version %d", i+1),
+				),
+				resource.TestCheckResourceAttrSet(
+					"argocd_project.multi_update",
+					"metadata.0.resource_version",
+				),
+				resource.TestCheckResourceAttrSet(
+					"argocd_project.multi_update",
+					"metadata.0.generation",
+				),
+			),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
 }
 
-// TestAccArgoCDProject_MetadataComputedFieldsOnUpdate tests that computed metadata fields
-// (resource_version, generation, uid) don't cause "inconsistent state after apply" errors
-// when the spec is updated with lifecycle { ignore_changes = [metadata] }.
-// This is a regression test for issue #807.
-// See: https://github.com/argoproj-labs/terraform-provider-argocd/issues/807
-func TestAccArgoCDProject_MetadataComputedFieldsOnUpdate(t *testing.T) {
-	name := acctest.RandString(10)
+// TestAccArgoCDProject_UnknownAnnotationValues tests that argocd_project handles unknown
+// (computed) annotation and label values at plan time without crashing.
+// This is a regression test for issue #846.
+// See: https://github.com/argoproj-labs/terraform-provider-argocd/issues/846
+func TestAccArgoCDProject_UnknownAnnotationValues(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
 
-	// Initial configuration with lifecycle ignore_changes on metadata
-	// This replicates the exact scenario from issue #807
-	configInitial := fmt.Sprintf(`
-resource "argocd_project" "metadata_computed" {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// First step: terraform_data.test is new so its output is unknown at plan time.
+				// The argocd_project annotation references this unknown value.
+				// Before the fix, this would crash with "Value Conversion Error".
+				Config: testAccArgoCDProjectWithUnknownAnnotation(name, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.unknown_annotation",
+						"metadata.0.annotations.computed-key",
+						"initial",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.unknown_annotation",
+						"metadata.0.labels.computed-label",
+						"initial",
+					),
+				),
+			},
+			{
+				// Second step: changing the input makes the output unknown again at plan time.
+				Config: testAccArgoCDProjectWithUnknownAnnotation(name, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.unknown_annotation",
+						"metadata.0.annotations.computed-key",
+						"updated",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.unknown_annotation",
+						"metadata.0.labels.computed-label",
+						"updated",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectWithUnknownAnnotation(name, value string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  input = "%s"
+}
+
+resource "argocd_project" "unknown_annotation" {
   metadata {
     name      = "%s"
     namespace = "argocd"
+    annotations = {
+      "computed-key" = terraform_data.test.output
+    }
     labels = {
-      "test" = "initial"
+      "computed-label" = terraform_data.test.output
     }
   }
 
   spec {
-    description  = "initial description"
+    description  = "test unknown annotation values"
     source_repos = ["*"]
 
     destination {
@@ -1834,96 +2811,148 @@ resource "argocd_project" "metadata_computed" {
       namespace = "default"
     }
   }
+}
+	`, value, name)
+}
 
-  lifecycle {
-    ignore_changes = [metadata]
-  }
+func TestAccArgoCDProject_VerifySignatureKeys(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectVerifySignatureKeys(name, true),
+				ExpectError: regexp.MustCompile("GPG key 0123456789ABCDEF does not exist"),
+			},
+			{
+				// Without verification the key is accepted as before
+				Config: testAccArgoCDProjectVerifySignatureKeys(name, false),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_project.verify",
+					"spec.0.signature_keys.#",
+					"1",
+				),
+			},
+		},
+	})
 }
-`, name)
 
-	// Updated configuration - changes the spec but keeps metadata the same
-	// With ignore_changes = [metadata], this should trigger resource_version
-	// and generation changes without causing "inconsistent state after apply" errors
-	configUpdated := fmt.Sprintf(`
-resource "argocd_project" "metadata_computed" {
+func testAccArgoCDProjectVerifySignatureKeys(name string, verify bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "verify" {
   metadata {
     name      = "%s"
     namespace = "argocd"
-    labels = {
-      "test" = "initial"
-    }
   }
 
   spec {
-    description  = "updated description"
-    source_repos = ["*", "https://github.com/example/repo"]
+    source_repos   = ["*"]
+    signature_keys = ["0123456789ABCDEF"]
 
     destination {
       server    = "https://kubernetes.default.svc"
       namespace = "default"
     }
-
-    destination {
-      server    = "https://kubernetes.default.svc"
-      namespace = "test"
-    }
   }
 
-  lifecycle {
-    ignore_changes = [metadata]
-  }
+  verify_signature_keys = %t
 }
-`, name)
+	`, name, verify)
+}
+
+func TestAccArgoCDProject_SyncWindowTimezoneAlias(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: configInitial,
-				Check: resource.ComposeAggregateTestCheckFunc(
+				Config: testAccArgoCDProjectSyncWindowTimezone(name, "US/Eastern"),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_project.metadata_computed",
-						"metadata.0.name",
-						name,
-					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_project.metadata_computed",
-						"metadata.0.resource_version",
-					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_project.metadata_computed",
-						"metadata.0.generation",
+						"argocd_project.timezone",
+						"spec.0.sync_window.0.timezone",
+						"US/Eastern",
 					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_project.metadata_computed",
-						"metadata.0.uid",
+					resource.TestCheckResourceAttrWith(
+						"argocd_project.timezone",
+						"yaml",
+						func(value string) error {
+							if !strings.Contains(value, "timeZone: America/New_York") {
+								return fmt.Errorf("expected timezone alias to be saved as America/New_York, got:\n%s", value)
+							}
+
+							return nil
+						},
 					),
 				),
 			},
 			{
-				// Update the spec - this should not cause inconsistent state errors
-				// even though resource_version and generation will change
-				Config: configUpdated,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"argocd_project.metadata_computed",
-						"spec.0.description",
-						"updated description",
-					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_project.metadata_computed",
-						"metadata.0.resource_version",
-					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_project.metadata_computed",
-						"metadata.0.generation",
-					),
+				// Aliases are not reported as drift
+				Config: testAccArgoCDProjectSyncWindowTimezone(name, "US/Eastern"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectSyncWindowTimezone(name, timezone string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "timezone" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    sync_window {
+      kind         = "deny"
+      applications = ["*"]
+      duration     = "1h"
+      schedule     = "0 22 * * *"
+      timezone     = "%s"
+    }
+  }
+}
+	`, name, timezone)
+}
+
+func TestAccArgoCDProject_PolicyFormatting(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectPolicyFormatting(name),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(
+					"argocd_project.formatting",
+					"spec.0.role.*",
+					map[string]string{
+						"policies.0": fmt.Sprintf("p,proj:%[1]s:admin,applications,get,%[1]s/*,allow", name),
+						"policies.1": fmt.Sprintf("p,  proj:%[1]s:admin , applications, sync, %[1]s/*,  deny", name),
+					},
 				),
 			},
 			{
-				// Apply the same configuration again to ensure no drift
-				Config: configUpdated,
+				// ArgoCD saves the policies as "p, proj:...", which must not
+				// be reported as drift
+				Config: testAccArgoCDProjectPolicyFormatting(name),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectEmptyPlan(),
@@ -1934,211 +2963,333 @@ resource "argocd_project" "metadata_computed" {
 	})
 }
 
-// TestAccArgoCDProject_MultipleSpecUpdates tests that multiple sequential spec updates
-// don't cause inconsistent state errors due to rapidly changing resource_version/generation.
-// This is a stress test for issue #807.
-func TestAccArgoCDProject_MultipleSpecUpdates(t *testing.T) {
-	name := acctest.RandString(10)
-
-	configs := []string{
-		// Config 1: Initial
-		fmt.Sprintf(`
-resource "argocd_project" "multi_update" {
+func testAccArgoCDProjectPolicyFormatting(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "formatting" {
   metadata {
-    name      = "%s"
+    name      = "%[1]s"
     namespace = "argocd"
   }
 
   spec {
-    description  = "version 1"
     source_repos = ["*"]
 
     destination {
       server    = "https://kubernetes.default.svc"
       namespace = "default"
     }
+
+    role {
+      name = "admin"
+      policies = [
+        "p,proj:%[1]s:admin,applications,get,%[1]s/*,allow",
+        "p,  proj:%[1]s:admin , applications, sync, %[1]s/*,  deny",
+      ]
+    }
   }
 }
-`, name),
-		// Config 2: Update description
-		fmt.Sprintf(`
-resource "argocd_project" "multi_update" {
+	`, name)
+}
+
+func TestAccArgoCDProject_PermitOnlyProjectScopedClusters(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ProjectPermitOnlyScopedClusters)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectPermitOnlyProjectScopedClusters(name, true),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_project.scoped",
+					"spec.0.permit_only_project_scoped_clusters",
+					"true",
+				),
+			},
+			{
+				Config: testAccArgoCDProjectPermitOnlyProjectScopedClusters(name, false),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_project.scoped",
+					"spec.0.permit_only_project_scoped_clusters",
+					"false",
+				),
+			},
+			{
+				// ArgoCD omits false values, which must not be reported as drift
+				Config: testAccArgoCDProjectPermitOnlyProjectScopedClusters(name, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectPermitOnlyProjectScopedClusters(name string, permitOnlyProjectScopedClusters bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "scoped" {
   metadata {
     name      = "%s"
     namespace = "argocd"
   }
 
   spec {
-    description  = "version 2"
     source_repos = ["*"]
 
     destination {
-      server    = "https://kubernetes.default.svc"
-      namespace = "default"
+      server    = "*"
+      namespace = "*"
     }
+
+    permit_only_project_scoped_clusters = %t
   }
 }
-`, name),
-		// Config 3: Add destination
-		fmt.Sprintf(`
-resource "argocd_project" "multi_update" {
+	`, name, permitOnlyProjectScopedClusters)
+}
+
+func TestAccArgoCDProject_Finalizers(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectFinalizers(name, `["example.com/terraform"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.finalizers", "metadata.0.finalizers.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.finalizers", "metadata.0.finalizers.0", "example.com/terraform"),
+				),
+			},
+			{
+				// Finalizers added outside of Terraform are not reported as drift
+				PreConfig: func() {
+					testAccArgoCDProjectSetExternalFinalizer(t, name, true)
+				},
+				Config: testAccArgoCDProjectFinalizers(name, `["example.com/terraform"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Finalizers are not tracked on import, since it is unknown
+				// which of them are declared in the configuration
+				ResourceName:       "argocd_project.finalizers",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if n := s[0].Attributes["metadata.0.finalizers.#"]; n != "" && n != "0" {
+						return fmt.Errorf("expected no finalizers to be tracked after import, got %s", n)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: testAccArgoCDProjectFinalizers(name, `["example.com/terraform"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.finalizers", "metadata.0.finalizers.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.finalizers", "metadata.0.finalizers.0", "example.com/terraform"),
+				),
+			},
+			{
+				// Removing the declared finalizer preserves the external one
+				Config: testAccArgoCDProjectFinalizers(name, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.finalizers", "metadata.0.finalizers.#", "0"),
+					func(_ *terraform.State) error {
+						si, err := getServerInterface()
+						if err != nil {
+							return err
+						}
+
+						p, err := si.ProjectClient.Get(context.Background(), &project.ProjectQuery{Name: name})
+						if err != nil {
+							return fmt.Errorf("failed to get project %s: %w", name, err)
+						}
+
+						if !slices.Equal(p.Finalizers, []string{"example.com/external"}) {
+							return fmt.Errorf("expected only the external finalizer to remain on project %s, got %v", name, p.Finalizers)
+						}
+
+						// Remove the external finalizer so that the project can be destroyed
+						testAccArgoCDProjectSetExternalFinalizer(t, name, false)
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectSetExternalFinalizer(t *testing.T, name string, present bool) {
+	si, err := getServerInterface()
+	if err != nil {
+		t.Fatalf("failed to get server interface: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	p, err := si.ProjectClient.Get(ctx, &project.ProjectQuery{Name: name})
+	if err != nil {
+		t.Fatalf("failed to get project %s: %s", name, err)
+	}
+
+	p.Finalizers = slices.DeleteFunc(p.Finalizers, func(f string) bool { return f == "example.com/external" })
+	if present {
+		p.Finalizers = append(p.Finalizers, "example.com/external")
+	}
+
+	if _, err = si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{Project: p}); err != nil {
+		t.Fatalf("failed to update finalizers of project %s: %s", name, err)
+	}
+}
+
+func testAccArgoCDProjectFinalizers(name, finalizers string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "finalizers" {
   metadata {
-    name      = "%s"
-    namespace = "argocd"
+    name       = "%s"
+    namespace  = "argocd"
+    finalizers = %s
   }
 
   spec {
-    description  = "version 3"
     source_repos = ["*"]
 
     destination {
       server    = "https://kubernetes.default.svc"
       namespace = "default"
     }
-
-    destination {
-      server    = "https://kubernetes.default.svc"
-      namespace = "kube-system"
-    }
   }
 }
-`, name),
-		// Config 4: Add role
-		fmt.Sprintf(`
-resource "argocd_project" "multi_update" {
+	`, name, finalizers)
+}
+
+func TestAccArgoCDProject_ReportEvents(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectReportEvents(name, "initial"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_project.events",
+					"report_events",
+					"true",
+				),
+			},
+			{
+				Config: testAccArgoCDProjectReportEvents(name, "updated"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_project.events",
+					"spec.0.description",
+					"updated",
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectReportEvents(name, description string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "events" {
   metadata {
     name      = "%s"
     namespace = "argocd"
   }
 
   spec {
-    description  = "version 4"
+    description  = "%s"
     source_repos = ["*"]
 
     destination {
       server    = "https://kubernetes.default.svc"
       namespace = "default"
     }
-
-    destination {
-      server    = "https://kubernetes.default.svc"
-      namespace = "kube-system"
-    }
-
-    role {
-      name     = "test-role"
-      policies = ["p, proj:%[1]s:test-role, applications, get, %[1]s/*, allow"]
-    }
   }
-}
-`, name),
-	}
-
-	steps := make([]resource.TestStep, len(configs))
-	for i, config := range configs {
-		steps[i] = resource.TestStep{
-			Config: config,
-			Check: resource.ComposeAggregateTestCheckFunc(
-				resource.TestCheckResourceAttr(
-					"argocd_project.multi_update",
-					"spec.0.description",
-					fmt.Sprintf("version %d", i+1),
-				),
-				resource.TestCheckResourceAttrSet(
-					"argocd_project.multi_update",
-					"metadata.0.resource_version",
-				),
-				resource.TestCheckResourceAttrSet(
-					"argocd_project.multi_update",
-					"metadata.0.generation",
-				),
-			),
-		}
-	}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps:                    steps,
-	})
+  report_events = true
+}
+	`, name, description)
 }
 
-// TestAccArgoCDProject_UnknownAnnotationValues tests that argocd_project handles unknown
-// (computed) annotation and label values at plan time without crashing.
-// This is a regression test for issue #846.
-// See: https://github.com/argoproj-labs/terraform-provider-argocd/issues/846
-func TestAccArgoCDProject_UnknownAnnotationValues(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc")
-
-	resource.ParallelTest(t, resource.TestCase{
+func TestAccArgoCDProject_AdoptDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				// First step: terraform_data.test is new so its output is unknown at plan time.
-				// The argocd_project annotation references this unknown value.
-				// Before the fix, this would crash with "Value Conversion Error".
-				Config: testAccArgoCDProjectWithUnknownAnnotation(name, "initial"),
+				Config: testAccArgoCDProjectAdoptDefault("adopted"),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"argocd_project.unknown_annotation",
-						"metadata.0.annotations.computed-key",
-						"initial",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_project.unknown_annotation",
-						"metadata.0.labels.computed-label",
-						"initial",
-					),
+					resource.TestCheckResourceAttr("argocd_project.default", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("argocd_project.default", "spec.0.description", "adopted"),
 				),
 			},
 			{
-				// Second step: changing the input makes the output unknown again at plan time.
-				Config: testAccArgoCDProjectWithUnknownAnnotation(name, "updated"),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"argocd_project.unknown_annotation",
-						"metadata.0.annotations.computed-key",
-						"updated",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_project.unknown_annotation",
-						"metadata.0.labels.computed-label",
-						"updated",
-					),
-				),
+				Config: testAccArgoCDProjectAdoptDefault("adopted"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 			},
 		},
+		CheckDestroy: func(s *terraform.State) error {
+			si, err := getServerInterface()
+			if err != nil {
+				return err
+			}
+
+			p, err := si.ProjectClient.Get(context.Background(), &project.ProjectQuery{Name: "default"})
+			if err != nil {
+				return fmt.Errorf("default project should still exist after destroy: %w", err)
+			}
+
+			if p.Spec.Description != "adopted" {
+				return fmt.Errorf("default project should keep its configuration after destroy, got description %q", p.Spec.Description)
+			}
+
+			return nil
+		},
 	})
 }
 
-func testAccArgoCDProjectWithUnknownAnnotation(name, value string) string {
+func testAccArgoCDProjectAdoptDefault(description string) string {
 	return fmt.Sprintf(`
-resource "terraform_data" "test" {
-  input = "%s"
-}
-
-resource "argocd_project" "unknown_annotation" {
+resource "argocd_project" "default" {
   metadata {
-    name      = "%s"
+    name      = "default"
     namespace = "argocd"
-    annotations = {
-      "computed-key" = terraform_data.test.output
-    }
-    labels = {
-      "computed-label" = terraform_data.test.output
-    }
   }
 
   spec {
-    description  = "test unknown annotation values"
+    description  = "%s"
     source_repos = ["*"]
 
     destination {
-      server    = "https://kubernetes.default.svc"
-      namespace = "default"
+      server    = "*"
+      namespace = "*"
+    }
+
+    cluster_resource_whitelist {
+      group = "*"
+      kind  = "*"
     }
   }
+
+  adopt_existing = true
 }
-	`, value, name)
+	`, description)
 }
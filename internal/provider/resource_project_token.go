@@ -4,24 +4,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
 	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/tokensecret"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/cristalhq/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &projectTokenResource{}
 var _ resource.ResourceWithModifyPlan = &projectTokenResource{}
+var _ resource.ResourceWithConfigValidators = &projectTokenResource{}
 
 func NewProjectTokenResource() resource.Resource {
 	return &projectTokenResource{}
@@ -37,11 +44,17 @@ func (r *projectTokenResource) Metadata(ctx context.Context, req resource.Metada
 
 func (r *projectTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages ArgoCD project role JWT tokens. See [Project Roles](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-roles) for more info.\n\n~> **Security Notice** The JWT token generated by this resource is treated as sensitive and, thus, not displayed in console output. However, it will be stored *unencrypted* in your Terraform state file. Read more about sensitive data handling in the [Terraform documentation](https://www.terraform.io/docs/language/state/sensitive-data.html).\n",
+		MarkdownDescription: "Manages ArgoCD project role JWT tokens. See [Project Roles](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-roles) for more info.\n\n~> **Security Notice** The JWT token generated by this resource is treated as sensitive and, thus, not displayed in console output. However, it will be stored *unencrypted* in your Terraform state file, unless `store_jwt` is disabled and the token is handed over through `kubernetes_secret` instead. Read more about sensitive data handling in the [Terraform documentation](https://www.terraform.io/docs/language/state/sensitive-data.html).\n",
 		Attributes:          projectTokenSchemaAttributes(),
 	}
 }
 
+func (r *projectTokenResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ProjectTokenRenewal(),
+	}
+}
+
 func (r *projectTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -90,6 +103,25 @@ func (r *projectTokenResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
+	var configID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &configID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	renew := func() {
+		resp.Plan.SetAttribute(ctx, path.Root("issued_at"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())
+
+		// Renewed tokens retain their ID if it has been set explicitly
+		if configID.IsNull() {
+			resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
+		}
+	}
+
 	// Check renew_after
 	if planData != nil && !planData.RenewAfter.IsNull() && !planData.RenewAfter.IsUnknown() {
 		renewAfterDuration, err := time.ParseDuration(planData.RenewAfter.ValueString())
@@ -100,10 +132,7 @@ func (r *projectTokenResource) ModifyPlan(ctx context.Context, req resource.Modi
 
 		if time.Now().Unix()-issuedAt > int64(renewAfterDuration.Seconds()) {
 			// Token is older than renewAfterDuration - force recreation
-			resp.Plan.SetAttribute(ctx, path.Root("issued_at"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())
+			renew()
 
 			return
 		}
@@ -124,10 +153,7 @@ func (r *projectTokenResource) ModifyPlan(ctx context.Context, req resource.Modi
 
 		if expiresAt < time.Now().Unix() {
 			// Token has expired - force recreation
-			resp.Plan.SetAttribute(ctx, path.Root("issued_at"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())
-			resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())
+			renew()
 
 			return
 		}
@@ -141,10 +167,7 @@ func (r *projectTokenResource) ModifyPlan(ctx context.Context, req resource.Modi
 
 			if expiresAt-time.Now().Unix() < int64(renewBeforeDuration.Seconds()) {
 				// Token will expire within renewBeforeDuration - force recreation
-				resp.Plan.SetAttribute(ctx, path.Root("issued_at"), types.StringUnknown())
-				resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
-				resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())
-				resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())
+				renew()
 			}
 		}
 	}
@@ -172,6 +195,10 @@ func (r *projectTokenResource) Create(ctx context.Context, req resource.CreateRe
 		Role:    role,
 	}
 
+	if !data.ID.IsUnknown() && !data.ID.IsNull() {
+		opts.Id = data.ID.ValueString()
+	}
+
 	if !data.Description.IsNull() {
 		opts.Description = data.Description.ValueString()
 	}
@@ -227,41 +254,10 @@ func (r *projectTokenResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	token, err := jwt.ParseNoVerify([]byte(tokenResp.GetToken()))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid JWT Token",
-			fmt.Sprintf("token for project %s is not a valid jwt: %s", projectName, err.Error()),
-		)
-
-		return
-	}
-
-	var claims jwt.RegisteredClaims
-	if err = json.Unmarshal(token.Claims(), &claims); err != nil {
-		resp.Diagnostics.AddError(
-			"JWT Claims Parse Error",
-			fmt.Sprintf("token claims for project %s could not be parsed: %s", projectName, err.Error()),
-		)
-
-		return
-	}
-
-	if claims.IssuedAt == nil {
-		resp.Diagnostics.AddError(
-			"Missing JWT Issue Date",
-			fmt.Sprintf("token claims issue date for project %s is missing", projectName),
-		)
-
-		return
-	}
-
-	if claims.ID == "" {
-		resp.Diagnostics.AddError(
-			"Missing JWT ID",
-			fmt.Sprintf("token claims ID for project %s is missing", projectName),
-		)
+	token, claims, diags := parseToken("project "+projectName, tokenResp.GetToken())
+	resp.Diagnostics.Append(diags...)
 
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -285,6 +281,34 @@ func (r *projectTokenResource) Create(ctx context.Context, req resource.CreateRe
 		data.ExpiresAt = types.StringValue("0")
 	}
 
+	if err = recordProjectToken(ctx, r.si, projectName, role, claims.ID, true); err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "token for project", projectName, err)...)
+	}
+
+	if !resp.Diagnostics.HasError() && data.WaitForPropagation.ValueBool() {
+		resp.Diagnostics.Append(waitForTokenPropagation(ctx, r.si, "project "+projectName, token.String())...)
+	}
+
+	if !resp.Diagnostics.HasError() && data.KubernetesSecret != nil {
+		resp.Diagnostics.Append(r.writeSecret(ctx, data.KubernetesSecret, token.String())...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		// Revoke the token, as it could not be handed over
+		_, _ = r.si.ProjectClient.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{
+			Id:      claims.ID,
+			Project: projectName,
+			Role:    role,
+		})
+		_ = recordProjectToken(ctx, r.si, projectName, role, claims.ID, false)
+
+		return
+	}
+
+	if !data.StoreJWT.ValueBool() {
+		data.JWT = types.StringNull()
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("created project token %s for project %s", claims.ID, projectName))
 
 	// Save data into Terraform state
@@ -312,11 +336,10 @@ func (r *projectTokenResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Delete token from state if project has been deleted in an out-of-band fashion
 	projectMutex.RLock()
-	defer projectMutex.RUnlock()
-
 	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
 		Name: projectName,
 	})
+	projectMutex.RUnlock()
 
 	if err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
@@ -341,9 +364,42 @@ func (r *projectTokenResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// Tokens issued before the managed tokens were recorded on the project
+	// would otherwise be pruned by argocd_project
+	ids, err := projectManagedTokenIDs(p)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("managed tokens of project %s could not be read", projectName), err)...)
+		return
+	}
+
+	if !slices.Contains(ids[data.Role.ValueString()], data.ID.ValueString()) {
+		projectMutex.Lock()
+		err = recordProjectToken(ctx, r.si, projectName, data.Role.ValueString(), data.ID.ValueString(), true)
+		projectMutex.Unlock()
+
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "token for project", projectName, err)...)
+			return
+		}
+	}
+
 	data.IssuedAt = types.StringValue(strconv.FormatInt(token.IssuedAt, 10))
 	data.ExpiresAt = types.StringValue(strconv.FormatInt(token.ExpiresAt, 10))
 
+	if data.StoreJWT.IsNull() {
+		data.StoreJWT = types.BoolValue(true)
+	}
+
+	if !data.WarnBefore.IsNull() {
+		warnBefore, err := time.ParseDuration(data.WarnBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("token warning duration (%s) for project %s could not be parsed", data.WarnBefore.ValueString(), projectName), err)...)
+			return
+		}
+
+		resp.Diagnostics.Append(tokenExpiryWarning(fmt.Sprintf("Token %s of role %s in project %s", data.ID.ValueString(), data.Role.ValueString(), projectName), token.ExpiresAt, warnBefore)...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -367,8 +423,11 @@ func (r *projectTokenResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Check if this is a token renewal (issued_at is unknown in plan)
 	if data.IssuedAt.IsUnknown() {
-		// Delete the old token first
-		if stateData != nil && !stateData.ID.IsNull() {
+		// Tokens with an explicit ID can only be issued again once the old
+		// token has been revoked, as IDs must be unique within a role
+		explicitID := !data.ID.IsUnknown()
+
+		if explicitID && stateData != nil && !stateData.ID.IsNull() {
 			deleteReq := resource.DeleteRequest{State: req.State}
 			deleteResp := resource.DeleteResponse{Diagnostics: resp.Diagnostics}
 			r.Delete(ctx, deleteReq, &deleteResp)
@@ -379,13 +438,26 @@ func (r *projectTokenResource) Update(ctx context.Context, req resource.UpdateRe
 			}
 		}
 
-		// Create a new token
+		// Otherwise, create the new token first, so that the old token
+		// remains valid should the renewal fail
 		createReq := resource.CreateRequest{Plan: req.Plan}
 		createResp := resource.CreateResponse{State: resp.State, Diagnostics: resp.Diagnostics}
 		r.Create(ctx, createReq, &createResp)
 		resp.State = createResp.State
 		resp.Diagnostics = createResp.Diagnostics
 
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Then revoke the old token
+		if !explicitID && stateData != nil && !stateData.ID.IsNull() {
+			deleteReq := resource.DeleteRequest{State: req.State}
+			deleteResp := resource.DeleteResponse{Diagnostics: resp.Diagnostics}
+			r.Delete(ctx, deleteReq, &deleteResp)
+			resp.Diagnostics = deleteResp.Diagnostics
+		}
+
 		return
 	}
 
@@ -467,6 +539,16 @@ func (r *projectTokenResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	err = recordProjectToken(ctx, r.si, projectName, data.Role.ValueString(), data.ID.ValueString(), false)
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "revocation of token for project", projectName, err)...)
+		return
+	}
+
+	if data.KubernetesSecret != nil {
+		resp.Diagnostics.Append(r.deleteSecret(ctx, data.KubernetesSecret, data.ID.ValueString())...)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("deleted project token %s for project %s", data.ID.ValueString(), projectName))
 }
 
@@ -482,7 +564,248 @@ func (r *projectTokenResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record the imported token as managed, so that it is not pruned by
+	// argocd_project
+	projectMutex := argocdSync.GetProjectMutex(parts[0])
+	projectMutex.Lock()
+	defer projectMutex.Unlock()
+
+	if err := recordProjectToken(ctx, r.si, parts[0], parts[1], parts[2], true); err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("record", "token for project", parts[0], err)...)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_jwt"), true)...)
+}
+
+// writeSecret writes the token into the configured Kubernetes secret.
+func (r *projectTokenResource) writeSecret(ctx context.Context, s *tokenSecretModel, token string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	if !s.Namespace.IsNull() {
+		namespace = s.Namespace.ValueString()
+	}
+
+	if err = tokensecret.Write(ctx, kc, namespace, s.Name.ValueString(), s.Key.ValueString(), token); err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to write token to secret %s/%s", namespace, s.Name.ValueString()), err)...)
+	}
+
+	return diags
+}
+
+// deleteSecret removes the token with the given ID from the configured
+// Kubernetes secret.
+func (r *projectTokenResource) deleteSecret(ctx context.Context, s *tokenSecretModel, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	if !s.Namespace.IsNull() {
+		namespace = s.Namespace.ValueString()
+	}
+
+	if err = tokensecret.Delete(ctx, kc, namespace, s.Name.ValueString(), s.Key.ValueString(), id); err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to remove token from secret %s/%s", namespace, s.Name.ValueString()), err)...)
+	}
+
+	return diags
+}
+
+// tokenExpiryWarning returns a warning if the token described by name expires
+// within warnBefore, so that expiring tokens are noticed before they are no
+// longer accepted by ArgoCD. Tokens which do not expire are ignored.
+func tokenExpiryWarning(name string, expiresAt int64, warnBefore time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if expiresAt <= 0 {
+		return diags
+	}
+
+	expiry := time.Unix(expiresAt, 0)
+	remaining := time.Until(expiry)
+
+	switch {
+	case remaining <= 0:
+		diags.AddWarning("Token Expired", fmt.Sprintf("%s expired at %s.", name, expiry.UTC().Format(time.RFC3339)))
+	case remaining < warnBefore:
+		diags.AddWarning("Token Expiring Soon", fmt.Sprintf("%s expires at %s, i.e. in %s.", name, expiry.UTC().Format(time.RFC3339), remaining.Truncate(time.Second)))
+	}
+
+	return diags
+}
+
+// projectManagedTokensAnnotation is the annotation of a project in which the
+// IDs of the tokens issued by argocd_project_token are recorded, keyed by
+// role, so that argocd_project is able to tell them apart from tokens issued
+// by other means when pruning unmanaged tokens.
+const projectManagedTokensAnnotation = "terraform-provider-argocd.argoproj-labs.io/managed-tokens"
+
+// projectManagedTokenIDs returns the IDs of the tokens recorded as managed on
+// the given project, keyed by role.
+func projectManagedTokenIDs(p *v1alpha1.AppProject) (map[string][]string, error) {
+	ids := make(map[string][]string)
+
+	v, ok := p.Annotations[projectManagedTokensAnnotation]
+	if !ok {
+		return ids, nil
+	}
+
+	if err := json.Unmarshal([]byte(v), &ids); err != nil {
+		return nil, fmt.Errorf("annotation %s of project %s could not be parsed: %w", projectManagedTokensAnnotation, p.Name, err)
+	}
+
+	return ids, nil
+}
+
+// recordProjectToken adds the token with the given ID to, or removes it from,
+// the managed tokens recorded on the project. Callers must hold the project
+// mutex.
+func recordProjectToken(ctx context.Context, si *ServerInterface, projectName, role, id string, managed bool) error {
+	return retry.RetryContext(ctx, time.Minute, func() *retry.RetryError {
+		p, err := si.ProjectClient.Get(ctx, &project.ProjectQuery{
+			Name: projectName,
+		})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		ids, err := projectManagedTokenIDs(p)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		recorded := slices.Contains(ids[role], id)
+		if recorded == managed {
+			return nil
+		}
+
+		if managed {
+			ids[role] = append(ids[role], id)
+			slices.Sort(ids[role])
+		} else {
+			ids[role] = slices.DeleteFunc(ids[role], func(i string) bool { return i == id })
+			if len(ids[role]) == 0 {
+				delete(ids, role)
+			}
+		}
+
+		if p.Annotations == nil {
+			p.Annotations = make(map[string]string)
+		}
+
+		if len(ids) == 0 {
+			delete(p.Annotations, projectManagedTokensAnnotation)
+		} else {
+			v, err := json.Marshal(ids)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			p.Annotations[projectManagedTokensAnnotation] = string(v)
+		}
+
+		if _, err = si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{Project: p}); err != nil {
+			// The project may have been modified concurrently, e.g. by
+			// another workspace issuing a token for the same project
+			if strings.Contains(err.Error(), "the object has been modified") {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// waitForTokenPropagation waits until ArgoCD accepts the token issued for the
+// given owner, e.g. "project foo", as tokens may take a moment to be accepted
+// by all replicas of the API server.
+func waitForTokenPropagation(ctx context.Context, si *ServerInterface, owner, token string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout := 2 * time.Minute
+
+	retryErr := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := si.VerifyToken(ctx, token); err != nil {
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		diags.AddError(
+			"Token Propagation Failed",
+			fmt.Sprintf("token for %s was not accepted by ArgoCD within %s: %s", owner, timeout, retryErr),
+		)
+	}
+
+	return diags
+}
+
+// parseToken parses the claims of a raw JWT issued for the given owner, e.g.
+// "project foo" or "account bar", ensuring the claims required to track the
+// token are present.
+func parseToken(owner, rawToken string) (*jwt.Token, *jwt.RegisteredClaims, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	token, err := jwt.ParseNoVerify([]byte(rawToken))
+	if err != nil {
+		diags.AddError(
+			"Invalid JWT Token",
+			fmt.Sprintf("token for %s is not a valid jwt: %s", owner, err.Error()),
+		)
+
+		return nil, nil, diags
+	}
+
+	var claims jwt.RegisteredClaims
+	if err = json.Unmarshal(token.Claims(), &claims); err != nil {
+		diags.AddError(
+			"JWT Claims Parse Error",
+			fmt.Sprintf("token claims for %s could not be parsed: %s", owner, err.Error()),
+		)
+
+		return nil, nil, diags
+	}
+
+	if claims.IssuedAt == nil {
+		diags.AddError(
+			"Missing JWT Issue Date",
+			fmt.Sprintf("token claims issue date for %s is missing", owner),
+		)
+
+		return nil, nil, diags
+	}
+
+	if claims.ID == "" {
+		diags.AddError(
+			"Missing JWT ID",
+			fmt.Sprintf("token claims ID for %s is missing", owner),
+		)
+
+		return nil, nil, diags
+	}
+
+	return token, &claims, diags
 }
@@ -8,10 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccArgoCDProjectToken(t *testing.T) {
@@ -105,6 +109,10 @@ func TestAccArgoCDProjectToken_RenewBefore(t *testing.T) {
 				Config:      testAccArgoCDProjectTokenRenewBeforeFailure(expiresInDuration),
 				ExpectError: regexp.MustCompile("renew_before .* cannot be greater than expires_in .*"),
 			},
+			{
+				Config:      testAccArgoCDProjectTokenRenewBeforeWithoutExpiresIn(),
+				ExpectError: regexp.MustCompile("renew_before can only be used in combination with expires_in"),
+			},
 		},
 	})
 }
@@ -141,6 +149,157 @@ func TestAccArgoCDProjectToken_RenewAfter(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDProjectToken_ExplicitID(t *testing.T) {
+	resourceName := "argocd_project_token.explicit_id"
+	id := fmt.Sprintf("acc-%d", rand.Intn(1000000))
+	renewAfterSeconds := 10
+
+	// Note: not running in parallel as this is a time sensitive test case
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectTokenExplicitID("acc:explicit-id", renewAfterSeconds),
+				ExpectError: regexp.MustCompile("must not contain colons"),
+			},
+			{
+				Config: testAccArgoCDProjectTokenExplicitID(id, renewAfterSeconds),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					testCheckTokenIssuedAt(resourceName),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectTokenExplicitID(id, renewAfterSeconds),
+				Check: resource.ComposeTestCheckFunc(
+					testDelay(renewAfterSeconds + 1),
+				),
+				ExpectNonEmptyPlan: true, // token should be renewed when refreshed at end of step due to delay above
+			},
+			{
+				// The renewed token retains its ID
+				Config: testAccArgoCDProjectTokenExplicitID(id, renewAfterSeconds),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					testCheckTokenIssuedAt(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectToken_StoreJWT(t *testing.T) {
+	resourceName := "argocd_project_token.store_jwt"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectTokenStoreJWT(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_jwt", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "jwt"),
+					resource.TestCheckResourceAttrSet(resourceName, "issued_at"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectTokenStoreJWT(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_jwt", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "jwt"),
+				),
+			},
+			{
+				Config:      testAccArgoCDProjectTokenKubernetesSecret(),
+				ExpectError: regexp.MustCompile("configured with `core = true`"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectToken_WaitForPropagation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_project_token" "wait" {
+  project              = "myproject1"
+  role                 = "test-role1234"
+  wait_for_propagation = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("argocd_project_token.wait", "jwt"),
+					testCheckTokenIssuedAt("argocd_project_token.wait"),
+				),
+			},
+		},
+	})
+}
+
+func TestTokenExpiryWarning(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	// Tokens which do not expire, or not within warn_before, are ignored
+	assert.Empty(t, tokenExpiryWarning("Token foo", 0, time.Hour))
+	assert.Empty(t, tokenExpiryWarning("Token foo", now.Add(2*time.Hour).Unix(), time.Hour))
+
+	diags := tokenExpiryWarning("Token foo", now.Add(30*time.Minute).Unix(), time.Hour)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+		assert.Equal(t, "Token Expiring Soon", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "Token foo expires at")
+	}
+
+	diags = tokenExpiryWarning("Token foo", now.Add(-time.Minute).Unix(), time.Hour)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+		assert.Equal(t, "Token Expired", diags[0].Summary())
+	}
+}
+
+func TestUnmanagedProjectTokens(t *testing.T) {
+	t.Parallel()
+
+	p := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+			Annotations: map[string]string{
+				projectManagedTokensAnnotation: `{"ci":["managed"]}`,
+			},
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			Roles: []v1alpha1.ProjectRole{
+				{
+					Name: "ci",
+					JWTTokens: []v1alpha1.JWTToken{
+						{ID: "managed", IssuedAt: 1},
+						{ID: "terraform-cli", IssuedAt: 2},
+						{IssuedAt: 3},
+					},
+				},
+			},
+		},
+	}
+
+	// Tokens are only managed if their ID has been recorded, regardless of
+	// its form
+	ids, err := unmanagedProjectTokenIDs(p, []string{"ci"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/3", "ci/terraform-cli"}, ids)
+
+	p.Annotations[projectManagedTokensAnnotation] = "not json"
+
+	_, err = unmanagedProjectTokenIDs(p, []string{"ci"})
+	assert.ErrorContains(t, err, projectManagedTokensAnnotation)
+}
+
 func testAccArgoCDProjectTokenSimple() string {
 	return `
 resource "argocd_project_token" "simple" {
@@ -214,6 +373,16 @@ resource "argocd_project_token" "renew_before" {
 `, expiresIn, renewBefore)
 }
 
+func testAccArgoCDProjectTokenRenewBeforeWithoutExpiresIn() string {
+	return `
+resource "argocd_project_token" "renew_before" {
+  project = "myproject1"
+  role    = "test-role1234"
+  renew_before = "20s"
+}
+`
+}
+
 func testAccArgoCDProjectTokenRenewAfter(renewAfter int) string {
 	return fmt.Sprintf(`
 resource "argocd_project_token" "renew_after" {
@@ -225,6 +394,17 @@ resource "argocd_project_token" "renew_after" {
 `, renewAfter)
 }
 
+func testAccArgoCDProjectTokenExplicitID(id string, renewAfter int) string {
+	return fmt.Sprintf(`
+resource "argocd_project_token" "explicit_id" {
+  id          = "%s"
+  project     = "myproject1"
+  role        = "test-role1234"
+  renew_after = "%ds"
+}
+`, id, renewAfter)
+}
+
 func testCheckTokenIssuedAt(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -645,3 +825,27 @@ resource "argocd_project_token" "migration_with_expiry" {
 }
 `
 }
+
+func testAccArgoCDProjectTokenStoreJWT(storeJWT bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project_token" "store_jwt" {
+  project   = "myproject1"
+  role      = "test-role1234"
+  store_jwt = %t
+}
+`, storeJWT)
+}
+
+func testAccArgoCDProjectTokenKubernetesSecret() string {
+	return `
+resource "argocd_project_token" "store_jwt" {
+  project   = "myproject1"
+  role      = "test-role1234"
+  store_jwt = false
+
+  kubernetes_secret = {
+    name = "project-token"
+  }
+}
+`
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &rbacResource{}
+var _ resource.ResourceWithConfigValidators = &rbacResource{}
+var _ resource.ResourceWithImportState = &rbacResource{}
+
+func NewRBACResource() resource.Resource {
+	return &rbacResource{}
+}
+
+// rbacResource defines the resource implementation.
+type rbacResource struct {
+	si *ServerInterface
+}
+
+func (r *rbacResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbac"
+}
+
+func (r *rbacResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global [RBAC configuration](https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/) of ArgoCD, i.e. the `policy.csv`, `policy.default`, `scopes` and `policy.matchMode` keys of the `argocd-rbac-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the keys above are written, any other settings within the ConfigMap, e.g. additional policies under `policy.<name>.csv` keys, are left untouched. " +
+			"Keys are owned individually: keys which are not configured are left untouched, so that they may be managed by other tools, e.g. the Helm chart of ArgoCD. " +
+			"Removing an attribute from the configuration, or destroying the resource, removes its key so that ArgoCD falls back to its default.\n\n" +
+			"**Note**: a key should not be managed by more than one `argocd_rbac` resource or tool, as they would otherwise overwrite each other's value.",
+		Attributes: rbacSchemaAttributes(),
+	}
+}
+
+func (r *rbacResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(rbacAttributePaths...),
+	}
+}
+
+func (r *rbacResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *rbacResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data rbacModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.RBACMutex.Lock()
+	err = updateRBACSettings(ctx, kc, namespace, data.toRBACSettings(), nil)
+	sync.RBACMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to create RBAC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created RBAC configuration in namespace %s", namespace))
+
+	data.ID = types.StringValue(common.ArgoCDRBACConfigMapName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *rbacResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data rbacModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.RBACMutex.RLock()
+	s, err := readRBACSettings(ctx, kc, namespace)
+	sync.RBACMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// ConfigMap has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read RBAC configuration", err)...)
+		return
+	}
+
+	// At least one setting is configured, hence none of them are managed only
+	// when importing, in which case all settings present are taken over.
+	data.updateFromRBACSettings(s, data.isEmpty())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *rbacResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state rbacModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.RBACMutex.Lock()
+	err = updateRBACSettings(ctx, kc, namespace, data.toRBACSettings(), state.toRBACSettings())
+	sync.RBACMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to update RBAC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated RBAC configuration in namespace %s", namespace))
+
+	data.ID = types.StringValue(common.ArgoCDRBACConfigMapName)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *rbacResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data rbacModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.RBACMutex.Lock()
+	err = updateRBACSettings(ctx, kc, namespace, nil, data.toRBACSettings())
+	sync.RBACMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error("failed to delete RBAC configuration", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted RBAC configuration in namespace %s", namespace))
+}
+
+func (r *rbacResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDRBAC(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreProviderConfig + `
+resource "argocd_rbac" "test" {
+  policy_default = "role:readonly"
+  scopes         = ["groups", "email"]
+
+  policy_csv = <<-EOT
+    p, role:org-admin, applications, *, */*, allow
+    g, my-org:team-alpha, role:org-admin
+  EOT
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_rbac.test", "id", "argocd-rbac-cm"),
+					resource.TestCheckResourceAttr("argocd_rbac.test", "policy_default", "role:readonly"),
+					resource.TestCheckResourceAttr("argocd_rbac.test", "scopes.#", "2"),
+					resource.TestCheckNoResourceAttr("argocd_rbac.test", "match_mode"),
+				),
+			},
+			{
+				ResourceName:      "argocd_rbac.test",
+				ImportState:       true,
+				ImportStateId:     "argocd-rbac-cm",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCoreProviderConfig + `
+resource "argocd_rbac" "test" {
+  match_mode = "regex"
+
+  policy_csv = <<-EOT
+    p, role:org-admin, applications, get, .*, allow
+    g, my-org:team-alpha, role:org-admin
+  EOT
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("argocd_rbac.test", "policy_default"),
+					resource.TestCheckNoResourceAttr("argocd_rbac.test", "scopes"),
+					resource.TestCheckResourceAttr("argocd_rbac.test", "match_mode", "regex"),
+				),
+			},
+			{
+				Config: testAccCoreProviderConfig + `
+resource "argocd_rbac" "test" {
+  match_mode = "regex"
+
+  policy_csv = <<-EOT
+    p, role:org-admin, applications, get, .*, allow
+    g, my-org:team-alpha, role:org-admin
+  EOT
+}
+`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -10,18 +10,23 @@ import (
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &repositoryResource{}
+var _ resource.ResourceWithModifyPlan = &repositoryResource{}
 var _ resource.ResourceWithImportState = &repositoryResource{}
 
 func NewRepositoryResource() resource.Resource {
@@ -39,8 +44,12 @@ func (r *repositoryResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *repositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages [repositories](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) within ArgoCD.",
-		Attributes:          repositorySchemaAttributes(),
+		MarkdownDescription: "Manages [repositories](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) within ArgoCD.\n\n" +
+			"**Note**: ArgoCD does not store whether Helm should pass the repository credentials to other domains on the repository itself. " +
+			"When charts are served from a different domain than the repository (e.g. private chart mirrors redirecting to object storage), " +
+			"set `pass_credentials` on the Helm source of `argocd_application` instead. ArgoCD does not support verifying the provenance of Helm charts.",
+		Attributes: repositorySchemaAttributes(),
+		Blocks:     repositorySchemaBlocks(),
 	}
 }
 
@@ -63,12 +72,55 @@ func (r *repositoryResource) Configure(ctx context.Context, req resource.Configu
 	r.si = si
 }
 
+func (r *repositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		return
+	}
+
+	var repo, repoType, project types.String
+
+	var enableOCI types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("repo"), &repo)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &repoType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enable_oci"), &enableOCI)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &project)...)
+
+	if resp.Diagnostics.HasError() || repo.IsUnknown() || repoType.IsUnknown() || enableOCI.IsUnknown() || project.IsUnknown() {
+		return
+	}
+
+	// The name of the secret is derived from the URL the repository is
+	// registered under, so that it is known when planning
+	secretName := repositorySecretName(helmOCIRepoURL(repo.ValueString(), repoType.ValueString(), enableOCI.ValueBool()), project.ValueString())
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_name"), secretName)...)
+}
+
 func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data repositoryModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Convert to API model
+	repo, err := data.toAPIModel()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to convert repository model", err.Error())
+		return
+	}
+
+	setWriteOnlyAttributes(ctx, req.Config, &resp.Diagnostics, repositoryWriteOnlyFields(repo))
+
+	if data.DirectSecret.ValueBool() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.createSecret(ctx, &data, repo, &resp.State)...)
+		}
+
+		return
+	}
+
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
 
@@ -82,11 +134,18 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Convert to API model
-	repo, err := data.toAPIModel()
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to convert repository model", err.Error())
-		return
+	if repo.Project != "" {
+		resp.Diagnostics.Append(r.validateProject(ctx, repo.Repo, repo.Project)...)
+
+		// ArgoCD only verifies the connection to repositories which are not
+		// scoped to a project when creating them
+		if !resp.Diagnostics.HasError() && data.ValidateConnection.ValueBool() {
+			resp.Diagnostics.Append(r.verifyConnection(ctx, repo)...)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	timeout := 2 * time.Minute
@@ -138,6 +197,10 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data.updateFromAPI(createdRepo))...)
 
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.updateMetadata(ctx, createdRepo.Repo, createdRepo.Project, data.secretMetadata(), secretMetadata{})...)
+	}
+
 	// Perform a read to get the latest state with connection status
 	if !resp.Diagnostics.HasError() {
 		readResp := &resource.ReadResponse{State: resp.State, Diagnostics: resp.Diagnostics}
@@ -153,6 +216,14 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if data.DirectSecret.ValueBool() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.readSecret(ctx, &data, &resp.State)...)
+		}
+
+		return
+	}
+
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
 
@@ -162,7 +233,7 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Read repository from API
-	repo, diags := r.readRepository(ctx, data.Repo.ValueString(), data.Project.ValueString())
+	repo, diags := readRepository(ctx, r.si, data.Repo.ValueString(), data.Project.ValueString())
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -175,15 +246,39 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.readMetadata(ctx, &data, repo.Repo, repo.Project)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data.updateFromAPI(repo))...)
 }
 
 func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data repositoryModel
+	var data, state repositoryModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Convert to API model
+	repo, err := data.toAPIModel()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to convert repository model", err.Error())
+		return
+	}
+
+	setWriteOnlyAttributes(ctx, req.Config, &resp.Diagnostics, repositoryWriteOnlyFields(repo))
+
+	if data.DirectSecret.ValueBool() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.updateSecret(ctx, &data, repo, state.Project.ValueString(), state.secretMetadata(), &resp.State)...)
+		}
+
+		return
+	}
 
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
@@ -198,11 +293,24 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Convert to API model
-	repo, err := data.toAPIModel()
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to convert repository model", err.Error())
-		return
+	previousProject := state.Project.ValueString()
+	moved := repo.Project != previousProject
+
+	if moved && repo.Project != "" {
+		resp.Diagnostics.Append(r.validateProject(ctx, repo.Repo, repo.Project)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// ArgoCD does not verify the connection when updating repositories
+	if data.ValidateConnection.ValueBool() {
+		resp.Diagnostics.Append(r.verifyConnection(ctx, repo)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	var updatedRepo *v1alpha1.Repository
@@ -212,12 +320,24 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		sync.RepositoryMutex.Lock()
 		defer sync.RepositoryMutex.Unlock()
 
+		if moved {
+			updatedRepo, err = r.moveRepository(ctx, repo, previousProject)
+			return
+		}
+
 		updatedRepo, err = r.si.RepositoryClient.UpdateRepository(
 			ctx,
 			&repository.RepoUpdateRequest{Repo: repo},
 		)
 	}()
 
+	// A moved repository is saved into Terraform state as soon as it has been
+	// registered within its new project, even if it could not be removed from
+	// the previous one, so that it is not registered again by the next apply
+	if updatedRepo != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, data.updateFromAPI(updatedRepo))...)
+	}
+
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "repository", repo.Repo, err)...)
 		return
@@ -228,19 +348,17 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	if updatedRepo.ConnectionState.Status == v1alpha1.ConnectionStatusFailed {
-		resp.Diagnostics.AddError(
-			"Repository connection failed",
-			fmt.Sprintf("could not connect to repository %s: %s", repo.Repo, updatedRepo.ConnectionState.Message),
-		)
-
-		return
-	}
-
 	tflog.Trace(ctx, fmt.Sprintf("updated repository %s", updatedRepo.Repo))
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, data.updateFromAPI(updatedRepo))...)
+	if !resp.Diagnostics.HasError() {
+		// A moved repository is stored within a new secret
+		previousMetadata := state.secretMetadata()
+		if moved {
+			previousMetadata = secretMetadata{}
+		}
+
+		resp.Diagnostics.Append(r.updateMetadata(ctx, repo.Repo, repo.Project, data.secretMetadata(), previousMetadata)...)
+	}
 
 	// Perform a read to get the latest state
 	if !resp.Diagnostics.HasError() {
@@ -257,6 +375,14 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if data.DirectSecret.ValueBool() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.deleteSecret(ctx, &data)...)
+		}
+
+		return
+	}
+
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
 
@@ -272,7 +398,7 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 	_, err := r.si.RepositoryClient.DeleteRepository(
 		ctx,
 		&repository.RepoQuery{
-			Repo:       data.Repo.ValueString(),
+			Repo:       helmOCIRepoURL(data.Repo.ValueString(), data.Type.ValueString(), data.EnableOCI.ValueBool()),
 			AppProject: data.Project.ValueString(),
 		},
 	)
@@ -287,6 +413,299 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 	tflog.Trace(ctx, fmt.Sprintf("deleted repository %s", data.Repo.ValueString()))
 }
 
+// The methods below manage the repository through its declarative secret when
+// direct_secret is enabled, so that neither the ArgoCD API nor its connection
+// check to the repository are involved.
+
+func (r *repositoryResource) createSecret(ctx context.Context, data *repositoryModel, repo *v1alpha1.Repository, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.Lock()
+	created, err := createRepositorySecret(ctx, kc, namespace, repo)
+	sync.RepositoryMutex.Unlock()
+
+	if apierrors.IsAlreadyExists(err) {
+		diags.AddError(
+			fmt.Sprintf("repository %s already exists", repo.Repo),
+			fmt.Sprintf("a repository secret for %s already exists in namespace %s, import the repository to bring it under management.", repo.Repo, namespace),
+		)
+
+		return diags
+	} else if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to create secret of repository %s", repo.Repo), err)...)
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created secret of repository %s", created.Repo))
+
+	diags.Append(state.Set(ctx, data.updateFromSecret(created))...)
+
+	if !diags.HasError() {
+		diags.Append(r.updateMetadata(ctx, created.Repo, created.Project, data.secretMetadata(), secretMetadata{})...)
+	}
+
+	return diags
+}
+
+func (r *repositoryResource) readSecret(ctx context.Context, data *repositoryModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.RLock()
+	repo, err := readRepositorySecret(ctx, kc, namespace, helmOCIRepoURL(data.Repo.ValueString(), data.Type.ValueString(), data.EnableOCI.ValueBool()), data.Project.ValueString())
+	sync.RepositoryMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		state.RemoveResource(ctx)
+		return diags
+	} else if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to read secret of repository %s", data.Repo.ValueString()), err)...)
+		return diags
+	}
+
+	diags.Append(r.readMetadata(ctx, data, repo.Repo, repo.Project)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, data.updateFromSecret(repo))...)
+
+	return diags
+}
+
+func (r *repositoryResource) updateSecret(ctx context.Context, data *repositoryModel, repo *v1alpha1.Repository, previousProject string, previous secretMetadata, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.Lock()
+	updated, err := updateRepositorySecret(ctx, kc, namespace, previousProject, repo)
+	sync.RepositoryMutex.Unlock()
+
+	if apierrors.IsAlreadyExists(err) {
+		diags.AddAttributeError(
+			path.Root("project"),
+			fmt.Sprintf("repository %s already exists", repo.Repo),
+			fmt.Sprintf("a repository secret for %s already exists within project %s in namespace %s, hence the repository cannot be moved to it.", repo.Repo, repo.Project, namespace),
+		)
+
+		return diags
+	} else if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to update secret of repository %s", repo.Repo), err)...)
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated secret of repository %s", updated.Repo))
+
+	diags.Append(state.Set(ctx, data.updateFromSecret(updated))...)
+
+	if !diags.HasError() {
+		diags.Append(r.updateMetadata(ctx, updated.Repo, updated.Project, data.secretMetadata(), previous)...)
+	}
+
+	return diags
+}
+
+func (r *repositoryResource) deleteSecret(ctx context.Context, data *repositoryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.Lock()
+	err = deleteRepositorySecret(ctx, kc, namespace, helmOCIRepoURL(data.Repo.ValueString(), data.Type.ValueString(), data.EnableOCI.ValueBool()), data.Project.ValueString())
+	sync.RepositoryMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to delete secret of repository %s", data.Repo.ValueString()), err)...)
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted secret of repository %s", data.Repo.ValueString()))
+
+	return diags
+}
+
+// updateMetadata manages the labels and annotations of the repository secret
+// through the Kubernetes API, as they are not exposed by the ArgoCD API.
+func (r *repositoryResource) updateMetadata(ctx context.Context, repoURL, project string, metadata, previous secretMetadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if metadata.isEmpty() && previous.isEmpty() {
+		return diags
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.Lock()
+	err = updateRepositorySecretMetadata(ctx, kc, namespace, repoURL, project, metadata, previous)
+	sync.RepositoryMutex.Unlock()
+
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to update metadata of secret of repository %s", repoURL), err)...)
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated metadata of secret of repository %s", repoURL))
+
+	return diags
+}
+
+// readMetadata reads back the labels and annotations tracked in the model from
+// the repository secret.
+func (r *repositoryResource) readMetadata(ctx context.Context, data *repositoryModel, repoURL, project string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(data.Metadata) == 0 {
+		return diags
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryMutex.RLock()
+	metadata, err := readRepositorySecretMetadata(ctx, kc, namespace, repoURL, project, data.secretMetadata())
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to read metadata of secret of repository %s", repoURL), err)...)
+		return diags
+	}
+
+	data.updateMetadata(metadata)
+
+	return diags
+}
+
+// moveRepository registers the repository within its new project before
+// removing it from the previous one, as ArgoCD looks up repositories by their
+// URL and project and is hence unable to update the project in place. This way
+// the repository remains available to applications throughout. The moved
+// repository is returned along with the error if it could not be removed from
+// the previous project. Callers must hold the repository mutex.
+func (r *repositoryResource) moveRepository(ctx context.Context, repo *v1alpha1.Repository, previousProject string) (*v1alpha1.Repository, error) {
+	moved, err := r.si.RepositoryClient.CreateRepository(ctx, &repository.RepoCreateRequest{
+		Repo:   repo,
+		Upsert: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register repository within project %q: %w", repo.Project, err)
+	}
+
+	_, err = r.si.RepositoryClient.DeleteRepository(ctx, &repository.RepoQuery{
+		Repo:       repo.Repo,
+		AppProject: previousProject,
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		return moved, fmt.Errorf("registered repository within project %q, but failed to remove it from project %q, which has to be done manually: %w", repo.Project, previousProject, err)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("moved repository %s from project %q to project %q", repo.Repo, previousProject, repo.Project))
+
+	return moved, nil
+}
+
+// validateProject ensures that the project of a project scoped repository
+// exists, and warns if the project does not permit the repository as a source.
+// The checks are skipped if the project cannot be read.
+func (r *repositoryResource) validateProject(ctx context.Context, repoURL, projectName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{Name: projectName})
+	if err != nil {
+		// Users which are allowed to manage repositories of a project are not
+		// necessarily allowed to read the project itself
+		if strings.Contains(err.Error(), "PermissionDenied") {
+			return diags
+		}
+
+		if strings.Contains(err.Error(), "NotFound") {
+			diags.AddAttributeError(
+				path.Root("project"),
+				"Project Not Found",
+				fmt.Sprintf("project %s referenced by repository %s does not exist", projectName, repoURL),
+			)
+
+			return diags
+		}
+
+		return diagnostics.ArgoCDAPIError("get", "project", projectName, err)
+	}
+
+	if !p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: repoURL}) {
+		diags.AddAttributeWarning(
+			path.Root("project"),
+			"Repository Not Permitted By Project",
+			fmt.Sprintf("repository %s is not permitted by the source repositories of project %s, applications within the project will not be able to use it", repoURL, projectName),
+		)
+	}
+
+	return diags
+}
+
+// verifyConnection fails unless ArgoCD is able to connect to the repository
+// with the given configuration.
+func (r *repositoryResource) verifyConnection(ctx context.Context, repo *v1alpha1.Repository) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := r.si.RepositoryClient.ValidateAccess(ctx, &repository.RepoAccessQuery{
+		Repo:                       repo.Repo,
+		Type:                       repo.Type,
+		Name:                       repo.Name,
+		Project:                    repo.Project,
+		Username:                   repo.Username,
+		Password:                   repo.Password,
+		BearerToken:                repo.BearerToken,
+		SshPrivateKey:              repo.SSHPrivateKey,
+		Insecure:                   repo.IsInsecure(),
+		TlsClientCertData:          repo.TLSClientCertData,
+		TlsClientCertKey:           repo.TLSClientCertKey,
+		EnableOci:                  repo.EnableOCI,
+		GithubAppPrivateKey:        repo.GithubAppPrivateKey,
+		GithubAppID:                repo.GithubAppId,
+		GithubAppInstallationID:    repo.GithubAppInstallationId,
+		GithubAppEnterpriseBaseUrl: repo.GitHubAppEnterpriseBaseURL,
+		Proxy:                      repo.Proxy,
+		GcpServiceAccountKey:       repo.GCPServiceAccountKey,
+		ForceHttpBasicAuth:         repo.ForceHttpBasicAuth,
+		UseAzureWorkloadIdentity:   repo.UseAzureWorkloadIdentity,
+	})
+	if err != nil {
+		diags.AddError(
+			"Repository connection failed",
+			fmt.Sprintf("could not connect to repository %s: %s", repo.Repo, err.Error()),
+		)
+	}
+
+	return diags
+}
+
 func (r *repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import ID format can be:
 	// - "repo_url" for global repositories
@@ -304,13 +723,15 @@ func (r *repositoryResource) ImportState(ctx context.Context, req resource.Impor
 	}
 }
 
-func (r *repositoryResource) readRepository(ctx context.Context, repoURL, project string) (*v1alpha1.Repository, diag.Diagnostics) {
+// readRepository returns the repository registered under the given URL and
+// project, or nil if there is none.
+func readRepository(ctx context.Context, si *ServerInterface, repoURL, project string) (*v1alpha1.Repository, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	sync.RepositoryMutex.RLock()
 	defer sync.RepositoryMutex.RUnlock()
 
-	repos, err := r.si.RepositoryClient.List(ctx, &repository.RepoQuery{
+	repos, err := si.RepositoryClient.List(ctx, &repository.RepoQuery{
 		AppProject: project,
 	})
 
@@ -320,7 +741,7 @@ func (r *repositoryResource) readRepository(ctx context.Context, repoURL, projec
 		for _, repo := range repos.Items {
 			// Match both URL and project to handle cases where the same repo URL
 			// exists in multiple projects
-			if repo.Repo == repoURL && repo.Project == project {
+			if (repo.Repo == repoURL || repo.Repo == helmOCIRepoURL(repoURL, repo.Type, repo.EnableOCI)) && repo.Project == project {
 				finalRepo = repo
 				break
 			}
@@ -16,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &repositoryCredentialsResource{}
+var _ resource.ResourceWithModifyPlan = &repositoryCredentialsResource{}
 var _ resource.ResourceWithImportState = &repositoryCredentialsResource{}
 
 func NewRepositoryCredentialsResource() resource.Resource {
@@ -38,6 +40,8 @@ func (r *repositoryCredentialsResource) Metadata(ctx context.Context, req resour
 func (r *repositoryCredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages [repository credentials](https://argo-cd.readthedocs.io/en/stable/user-guide/private-repositories/#credentials) within ArgoCD.\n\n" +
+			"Repository credentials act as a template for all repositories whose URL starts with `url`, so that e.g. a single GitHub App or TLS client " +
+			"certificate can be used for every repository of an organization.\n\n" +
 			"**Note**: due to restrictions in the ArgoCD API the provider is unable to track drift in this resource to fields other than `username`. I.e. the " +
 			"provider is unable to detect changes to repository credentials that are made outside of Terraform (e.g. manual updates to the underlying Kubernetes " +
 			"Secrets).",
@@ -64,14 +68,35 @@ func (r *repositoryCredentialsResource) Configure(ctx context.Context, req resou
 	r.si = si
 }
 
+func (r *repositoryCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		return
+	}
+
+	var url types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("url"), &url)...)
+
+	if resp.Diagnostics.HasError() || url.IsUnknown() {
+		return
+	}
+
+	// The name of the secret is derived from the URL prefix, so that it is
+	// known when planning
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_name"), repositoryCredentialsSecretName(url.ValueString()))...)
+}
+
 func (r *repositoryCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data repositoryCredentialsModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	// Initialize API clients
-	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	// Initialize API clients, unless the credentials are managed through their secret
+	if !data.DirectSecret.ValueBool() {
+		resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	}
 
 	// Check for errors before proceeding
 	if resp.Diagnostics.HasError() {
@@ -85,19 +110,37 @@ func (r *repositoryCredentialsResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	// Create repository credentials
-	sync.RepositoryCredentialsMutex.Lock()
-	createdCreds, err := r.si.RepoCredsClient.CreateRepositoryCredentials(
-		ctx,
-		&repocreds.RepoCredsCreateRequest{
-			Creds:  creds,
-			Upsert: false,
-		},
-	)
-	sync.RepositoryCredentialsMutex.Unlock()
+	setWriteOnlyAttributes(ctx, req.Config, &resp.Diagnostics, repositoryCredentialsWriteOnlyFields(creds))
 
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "repository credentials", creds.URL, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createdCreds *v1alpha1.RepoCreds
+
+	if data.DirectSecret.ValueBool() {
+		var diags diag.Diagnostics
+
+		createdCreds, diags = r.createSecret(ctx, creds)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		// Create repository credentials
+		sync.RepositoryCredentialsMutex.Lock()
+		createdCreds, err = r.si.RepoCredsClient.CreateRepositoryCredentials(
+			ctx,
+			&repocreds.RepoCredsCreateRequest{
+				Creds:  creds,
+				Upsert: false,
+			},
+		)
+		sync.RepositoryCredentialsMutex.Unlock()
+
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "repository credentials", creds.URL, err)...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -107,6 +150,7 @@ func (r *repositoryCredentialsResource) Create(ctx context.Context, req resource
 	// Update the model with the created credentials data
 	result := data // Start with the original data to preserve all fields
 	result.ID = types.StringValue(createdCreds.URL)
+	result.SecretName = types.StringValue(repositoryCredentialsSecretName(createdCreds.URL))
 	result.URL = types.StringValue(createdCreds.URL)
 
 	// Handle Type - preserve planned value if API doesn't return it
@@ -164,16 +208,31 @@ func (r *repositoryCredentialsResource) Read(ctx context.Context, req resource.R
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	// Initialize API clients
-	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	// Initialize API clients, unless the credentials are managed through their secret
+	if !data.DirectSecret.ValueBool() {
+		resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	}
 
 	// Check for errors before proceeding
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Read repository credentials from API
-	creds, diags := r.readRepositoryCredentials(ctx, data.ID.ValueString())
+	var creds *v1alpha1.RepoCreds
+
+	var diags diag.Diagnostics
+
+	// In core mode, the declarative secret of the credentials is read instead,
+	// as the ArgoCD API only returns their URL and username
+	fromSecret := data.DirectSecret.ValueBool() || r.si.config.Core.ValueBool()
+
+	if fromSecret {
+		creds, diags = r.readSecret(ctx, data.ID.ValueString())
+	} else {
+		// Read repository credentials from API
+		creds, diags = r.readRepositoryCredentials(ctx, data.ID.ValueString())
+	}
+
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -189,6 +248,7 @@ func (r *repositoryCredentialsResource) Read(ctx context.Context, req resource.R
 	// Update the model with the read credentials data
 	result := data // Start with the original data to preserve all fields
 	result.ID = types.StringValue(creds.URL)
+	result.SecretName = types.StringValue(repositoryCredentialsSecretName(creds.URL))
 	result.URL = types.StringValue(creds.URL)
 
 	// Handle Type - preserve prior state value if API doesn't return it
@@ -230,6 +290,14 @@ func (r *repositoryCredentialsResource) Read(ctx context.Context, req resource.R
 		result.GitHubAppEnterpriseBaseURL = types.StringValue(creds.GitHubAppEnterpriseBaseURL)
 	}
 
+	if creds.Proxy != "" {
+		result.Proxy = types.StringValue(creds.Proxy)
+	}
+
+	if creds.NoProxy != "" {
+		result.NoProxy = types.StringValue(creds.NoProxy)
+	}
+
 	// GitHub App ID conversion
 	if creds.GithubAppId > 0 {
 		result.GitHubAppID = types.StringValue(strconv.FormatInt(creds.GithubAppId, 10))
@@ -240,6 +308,12 @@ func (r *repositoryCredentialsResource) Read(ctx context.Context, req resource.R
 		result.GitHubAppInstallationID = types.StringValue(strconv.FormatInt(creds.GithubAppInstallationId, 10))
 	}
 
+	// The secret holds all non-sensitive fields, which are hence read back as
+	// they are, e.g. when importing credentials
+	if fromSecret {
+		result.updateFromSecret(creds)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
@@ -250,8 +324,10 @@ func (r *repositoryCredentialsResource) Update(ctx context.Context, req resource
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	// Initialize API clients
-	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	// Initialize API clients, unless the credentials are managed through their secret
+	if !data.DirectSecret.ValueBool() {
+		resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+	}
 
 	// Check for errors before proceeding
 	if resp.Diagnostics.HasError() {
@@ -265,16 +341,34 @@ func (r *repositoryCredentialsResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	// Update repository credentials
-	sync.RepositoryCredentialsMutex.Lock()
-	updatedCreds, err := r.si.RepoCredsClient.UpdateRepositoryCredentials(
-		ctx,
-		&repocreds.RepoCredsUpdateRequest{Creds: creds},
-	)
-	sync.RepositoryCredentialsMutex.Unlock()
+	setWriteOnlyAttributes(ctx, req.Config, &resp.Diagnostics, repositoryCredentialsWriteOnlyFields(creds))
 
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "repository credentials", creds.URL, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updatedCreds *v1alpha1.RepoCreds
+
+	if data.DirectSecret.ValueBool() {
+		var diags diag.Diagnostics
+
+		updatedCreds, diags = r.updateSecret(ctx, creds)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		// Update repository credentials
+		sync.RepositoryCredentialsMutex.Lock()
+		updatedCreds, err = r.si.RepoCredsClient.UpdateRepositoryCredentials(
+			ctx,
+			&repocreds.RepoCredsUpdateRequest{Creds: creds},
+		)
+		sync.RepositoryCredentialsMutex.Unlock()
+
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "repository credentials", creds.URL, err)...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -284,6 +378,7 @@ func (r *repositoryCredentialsResource) Update(ctx context.Context, req resource
 	// Update the model with the updated credentials data
 	result := data // Start with the original data to preserve all fields
 	result.ID = types.StringValue(updatedCreds.URL)
+	result.SecretName = types.StringValue(repositoryCredentialsSecretName(updatedCreds.URL))
 	result.URL = types.StringValue(updatedCreds.URL)
 
 	// Handle Type - preserve planned value if API doesn't return it
@@ -341,6 +436,14 @@ func (r *repositoryCredentialsResource) Delete(ctx context.Context, req resource
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if data.DirectSecret.ValueBool() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.deleteSecret(ctx, data.ID.ValueString())...)
+		}
+
+		return
+	}
+
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
 
@@ -401,3 +504,102 @@ func (r *repositoryCredentialsResource) readRepositoryCredentials(ctx context.Co
 	// Credentials not found
 	return nil, diags
 }
+
+// The methods below manage the credentials through their declarative secret
+// when direct_secret is enabled, so that the ArgoCD API is not involved.
+
+func (r *repositoryCredentialsResource) createSecret(ctx context.Context, creds *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return nil, diags
+	}
+
+	sync.RepositoryCredentialsMutex.Lock()
+	created, err := createRepoCredsSecret(ctx, kc, namespace, creds)
+	sync.RepositoryCredentialsMutex.Unlock()
+
+	if apierrors.IsAlreadyExists(err) {
+		diags.AddError(
+			fmt.Sprintf("repository credentials %s already exist", creds.URL),
+			fmt.Sprintf("a repository credentials secret for %s already exists in namespace %s, import the credentials to bring them under management.", creds.URL, namespace),
+		)
+
+		return nil, diags
+	} else if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to create secret of repository credentials %s", creds.URL), err)...)
+		return nil, diags
+	}
+
+	return created, diags
+}
+
+func (r *repositoryCredentialsResource) readSecret(ctx context.Context, url string) (*v1alpha1.RepoCreds, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return nil, diags
+	}
+
+	sync.RepositoryCredentialsMutex.RLock()
+	creds, err := readRepoCredsSecret(ctx, kc, namespace, url)
+	sync.RepositoryCredentialsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Repository credentials have been deleted out-of-band
+		return nil, diags
+	} else if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to read secret of repository credentials %s", url), err)...)
+		return nil, diags
+	}
+
+	return creds, diags
+}
+
+func (r *repositoryCredentialsResource) updateSecret(ctx context.Context, creds *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return nil, diags
+	}
+
+	sync.RepositoryCredentialsMutex.Lock()
+	updated, err := updateRepoCredsSecret(ctx, kc, namespace, creds)
+	sync.RepositoryCredentialsMutex.Unlock()
+
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to update secret of repository credentials %s", creds.URL), err)...)
+		return nil, diags
+	}
+
+	return updated, diags
+}
+
+func (r *repositoryCredentialsResource) deleteSecret(ctx context.Context, url string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return diags
+	}
+
+	sync.RepositoryCredentialsMutex.Lock()
+	err = deleteRepoCredsSecret(ctx, kc, namespace, url)
+	sync.RepositoryCredentialsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to delete secret of repository credentials %s", url), err)...)
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted secret of repository credentials %s", url))
+
+	return diags
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceActionCustomizationResource{}
+var _ resource.ResourceWithImportState = &resourceActionCustomizationResource{}
+
+func NewResourceActionCustomizationResource() resource.Resource {
+	return &resourceActionCustomizationResource{}
+}
+
+// resourceActionCustomizationResource defines the resource implementation.
+type resourceActionCustomizationResource struct {
+	si *ServerInterface
+}
+
+func (r *resourceActionCustomizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_action_customization"
+}
+
+func (r *resourceActionCustomizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the [custom actions](https://argo-cd.readthedocs.io/en/stable/operator-manual/resource_actions/#custom-resource-actions) of ArgoCD for resources of a given group and kind, e.g. to restart or promote them, " +
+			"i.e. the `resource.customizations.actions.<group>_<kind>` key of the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the key above is written, any other settings within the ConfigMap are left untouched. " +
+			"Customizations of groups or kinds containing wildcards are stored within the legacy `resource.customizations` key instead, since Kubernetes does not permit `*` within the keys of a ConfigMap. " +
+			"Creating actions for a group and kind which already has custom actions fails, so that actions managed elsewhere are not overwritten.\n\n" +
+			"**Note**: the scripts may only use the standard libraries of Lua if `use_open_libs` is enabled on an `argocd_resource_health_customization` of the same group and kind. " +
+			"Actions of groups and kinds without wildcards configured through the legacy `resource.customizations` key are not taken into account.",
+		Attributes: resourceActionCustomizationSchemaAttributes(),
+	}
+}
+
+func (r *resourceActionCustomizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *resourceActionCustomizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceActionCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+	actions := data.toResourceActions()
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = createResourceActionCustomization(ctx, kc, namespace, group, kind, actions)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create actions for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created actions for %s in namespace %s", id, namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceActionCustomization(group, kind, actions))...)
+}
+
+func (r *resourceActionCustomizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceActionCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, kind, err := parseResourceCustomizationID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to parse resource action customization ID", err)...)
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.ResourceCustomizationsMutex.RLock()
+	actions, err := readResourceActionCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Actions have been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read actions for %s", data.ID.ValueString()), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceActionCustomization(group, kind, actions))...)
+}
+
+func (r *resourceActionCustomizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resourceActionCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+	actions := data.toResourceActions()
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = updateResourceActionCustomization(ctx, kc, namespace, group, kind, actions)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update actions for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated actions for %s in namespace %s", id, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceActionCustomization(group, kind, actions))...)
+}
+
+func (r *resourceActionCustomizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceActionCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = deleteResourceActionCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete actions for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted actions for %s in namespace %s", id, namespace))
+}
+
+func (r *resourceActionCustomizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDResourceActionCustomization(t *testing.T) {
+	group := acctest.RandomWithPrefix("action") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDResourceActionCustomization(group, "restart", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "id", group+"/Widget"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "actions.#", "1"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "actions.0.name", "restart"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "merge_builtin_actions", "false"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.wildcard", "id", "*."+group+"/Widget"),
+				),
+			},
+			{
+				ResourceName:      "argocd_resource_action_customization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "argocd_resource_action_customization.wildcard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDResourceActionCustomization(group, "refresh", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "actions.0.name", "refresh"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.test", "merge_builtin_actions", "true"),
+					resource.TestCheckResourceAttr("argocd_resource_action_customization.wildcard", "actions.0.name", "refresh"),
+				),
+			},
+			{
+				Config:   testAccArgoCDResourceActionCustomization(group, "refresh", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDResourceActionCustomization(group, action string, mergeBuiltinActions bool) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_resource_action_customization" "test" {
+  group                 = "%[1]s"
+  kind                  = "Widget"
+  merge_builtin_actions = %[3]t
+
+  discovery_lua = <<-EOT
+    actions = {}
+    actions["%[2]s"] = {}
+    return actions
+  EOT
+
+  actions = [
+    {
+      name = "%[2]s"
+      lua  = <<-EOT
+        obj.metadata.annotations = obj.metadata.annotations or {}
+        obj.metadata.annotations["example.com/action"] = "%[2]s"
+        return obj
+      EOT
+    },
+  ]
+}
+
+resource "argocd_resource_action_customization" "wildcard" {
+  group                 = "*.%[1]s"
+  kind                  = "Widget"
+  merge_builtin_actions = %[3]t
+
+  discovery_lua = <<-EOT
+    actions = {}
+    actions["%[2]s"] = {}
+    return actions
+  EOT
+
+  actions = [
+    {
+      name = "%[2]s"
+      lua  = <<-EOT
+        obj.metadata.annotations = obj.metadata.annotations or {}
+        obj.metadata.annotations["example.com/action"] = "%[2]s"
+        return obj
+      EOT
+    },
+  ]
+}
+`, group, action, mergeBuiltinActions)
+}
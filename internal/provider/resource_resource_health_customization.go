@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceHealthCustomizationResource{}
+var _ resource.ResourceWithImportState = &resourceHealthCustomizationResource{}
+
+func NewResourceHealthCustomizationResource() resource.Resource {
+	return &resourceHealthCustomizationResource{}
+}
+
+// resourceHealthCustomizationResource defines the resource implementation.
+type resourceHealthCustomizationResource struct {
+	si *ServerInterface
+}
+
+func (r *resourceHealthCustomizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_health_customization"
+}
+
+func (r *resourceHealthCustomizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [custom health check](https://argo-cd.readthedocs.io/en/stable/operator-manual/health/#custom-health-checks) of ArgoCD for resources of a given group and kind, " +
+			"i.e. the `resource.customizations.health.<group>_<kind>` and `resource.customizations.useOpenLibs.<group>_<kind>` keys of the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the keys above are written, any other settings within the ConfigMap are left untouched. " +
+			"Customizations of groups or kinds containing wildcards are stored within the legacy `resource.customizations` key instead, since Kubernetes does not permit `*` within the keys of a ConfigMap. " +
+			"Creating a health check for a group and kind which already has one fails, so that health checks managed elsewhere are not overwritten.\n\n" +
+			"**Note**: health checks of groups and kinds without wildcards configured through the legacy `resource.customizations` key are not taken into account.",
+		Attributes: resourceHealthCustomizationSchemaAttributes(),
+	}
+}
+
+func (r *resourceHealthCustomizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *resourceHealthCustomizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceHealthCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c := data.toResourceHealthCustomization()
+	id := resourceCustomizationID(c.Group, c.Kind)
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = createResourceHealthCustomization(ctx, kc, namespace, c)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create health check for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created health check for %s in namespace %s", id, namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceHealthCustomization(c))...)
+}
+
+func (r *resourceHealthCustomizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceHealthCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, kind, err := parseResourceCustomizationID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to parse resource health customization ID", err)...)
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.ResourceCustomizationsMutex.RLock()
+	c, err := readResourceHealthCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Health check has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read health check for %s", data.ID.ValueString()), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceHealthCustomization(c))...)
+}
+
+func (r *resourceHealthCustomizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resourceHealthCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	c := data.toResourceHealthCustomization()
+	id := resourceCustomizationID(c.Group, c.Kind)
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = updateResourceHealthCustomization(ctx, kc, namespace, c)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update health check for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated health check for %s in namespace %s", id, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceHealthCustomization(c))...)
+}
+
+func (r *resourceHealthCustomizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceHealthCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = deleteResourceHealthCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete health check for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted health check for %s in namespace %s", id, namespace))
+}
+
+func (r *resourceHealthCustomizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDResourceHealthCustomization(t *testing.T) {
+	group := acctest.RandomWithPrefix("health") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDResourceHealthCustomization(group, "Progressing", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_resource_health_customization.test", "id", group+"/Widget"),
+					resource.TestCheckResourceAttr("argocd_resource_health_customization.test", "use_open_libs", "false"),
+					resource.TestCheckResourceAttr("argocd_resource_health_customization.wildcard", "id", "*."+group+"/Widget"),
+				),
+			},
+			{
+				ResourceName:      "argocd_resource_health_customization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "argocd_resource_health_customization.wildcard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDResourceHealthCustomization(group, "Healthy", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_resource_health_customization.test", "use_open_libs", "true"),
+					resource.TestCheckResourceAttr("argocd_resource_health_customization.wildcard", "use_open_libs", "true"),
+				),
+			},
+			{
+				Config:   testAccArgoCDResourceHealthCustomization(group, "Healthy", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDResourceHealthCustomization(group, status string, useOpenLibs bool) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_resource_health_customization" "test" {
+  group         = "%[1]s"
+  kind          = "Widget"
+  use_open_libs = %[3]t
+
+  lua = <<-EOT
+    hs = {}
+    hs.status = "%[2]s"
+    return hs
+  EOT
+}
+
+resource "argocd_resource_health_customization" "wildcard" {
+  group         = "*.%[1]s"
+  kind          = "Widget"
+  use_open_libs = %[3]t
+
+  lua = <<-EOT
+    hs = {}
+    hs.status = "%[2]s"
+    return hs
+  EOT
+}
+`, group, status, useOpenLibs)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceIgnoreUpdatesCustomizationResource{}
+var _ resource.ResourceWithImportState = &resourceIgnoreUpdatesCustomizationResource{}
+
+func NewResourceIgnoreUpdatesCustomizationResource() resource.Resource {
+	return &resourceIgnoreUpdatesCustomizationResource{}
+}
+
+// resourceIgnoreUpdatesCustomizationResource defines the resource implementation.
+type resourceIgnoreUpdatesCustomizationResource struct {
+	si *ServerInterface
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_ignore_updates_customization"
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the fields ArgoCD [ignores on updates](https://argo-cd.readthedocs.io/en/stable/operator-manual/reconcile/) of resources of a given group and kind, i.e. updates which only modify these fields do not trigger a refresh of the application the resources belong to, " +
+			"through the `resource.customizations.ignoreResourceUpdates.<group>_<kind>` key of the `argocd-cm` ConfigMap. This reduces the load on the application controller caused by resources which are updated frequently, e.g. by controllers writing their status.\n\n" +
+			configMapResourceDescription +
+			"Only the key above is written, any other settings within the ConfigMap are left untouched. " +
+			"Customizations of groups or kinds containing wildcards are stored within the legacy `resource.customizations` key instead, since Kubernetes does not permit `*` within the keys of a ConfigMap. " +
+			"Creating ignored fields for a group and kind which already has some fails, so that fields managed elsewhere are not overwritten.\n\n" +
+			"**Note**: ignored fields only take effect if `ignore_resource_updates_enabled` of `argocd_compare_options` is enabled, which is the default. " +
+			"Fields ignored when diffing are ignored on updates as well, unless `ignore_differences_on_resource_updates` of `argocd_compare_options` is disabled.",
+		Attributes: resourceIgnoreUpdatesCustomizationSchemaAttributes(),
+	}
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceIgnoreUpdatesCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+	ignore := data.toOverrideIgnoreDiff()
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = createResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind, ignore)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create ignored fields for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created ignored fields for %s in namespace %s", id, namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceIgnoreUpdatesCustomization(group, kind, ignore))...)
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceIgnoreUpdatesCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, kind, err := parseResourceCustomizationID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to parse resource ignore updates customization ID", err)...)
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.ResourceCustomizationsMutex.RLock()
+	ignore, err := readResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Ignored fields have been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read ignored fields for %s", data.ID.ValueString()), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceIgnoreUpdatesCustomization(group, kind, ignore))...)
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resourceIgnoreUpdatesCustomizationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+	ignore := data.toOverrideIgnoreDiff()
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = updateResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind, ignore)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update ignored fields for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated ignored fields for %s in namespace %s", id, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newResourceIgnoreUpdatesCustomization(group, kind, ignore))...)
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceIgnoreUpdatesCustomizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	group, kind := data.Group.ValueString(), data.Kind.ValueString()
+	id := resourceCustomizationID(group, kind)
+
+	sync.ResourceCustomizationsMutex.Lock()
+	err = deleteResourceIgnoreUpdatesCustomization(ctx, kc, namespace, group, kind)
+	sync.ResourceCustomizationsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete ignored fields for %s", id), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted ignored fields for %s in namespace %s", id, namespace))
+}
+
+func (r *resourceIgnoreUpdatesCustomizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
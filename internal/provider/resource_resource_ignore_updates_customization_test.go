@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDResourceIgnoreUpdatesCustomization(t *testing.T) {
+	group := acctest.RandomWithPrefix("ignore") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDResourceIgnoreUpdatesCustomization(group, `json_pointers = ["/status"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_resource_ignore_updates_customization.test", "id", group+"/Widget"),
+					resource.TestCheckResourceAttr("argocd_resource_ignore_updates_customization.test", "json_pointers.0", "/status"),
+					resource.TestCheckNoResourceAttr("argocd_resource_ignore_updates_customization.test", "jq_path_expressions"),
+					resource.TestCheckResourceAttr("argocd_resource_ignore_updates_customization.wildcard", "id", "*."+group+"/Widget"),
+				),
+			},
+			{
+				ResourceName:      "argocd_resource_ignore_updates_customization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "argocd_resource_ignore_updates_customization.wildcard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDResourceIgnoreUpdatesCustomization(group, `jq_path_expressions = [".metadata.annotations.example"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("argocd_resource_ignore_updates_customization.test", "json_pointers"),
+					resource.TestCheckResourceAttr("argocd_resource_ignore_updates_customization.test", "jq_path_expressions.0", ".metadata.annotations.example"),
+					resource.TestCheckResourceAttr("argocd_resource_ignore_updates_customization.wildcard", "jq_path_expressions.0", ".metadata.annotations.example"),
+				),
+			},
+			{
+				Config:   testAccArgoCDResourceIgnoreUpdatesCustomization(group, `jq_path_expressions = [".metadata.annotations.example"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDResourceIgnoreUpdatesCustomization(group, fields string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_resource_ignore_updates_customization" "test" {
+  group = "%[1]s"
+  kind  = "Widget"
+
+  %[2]s
+}
+
+resource "argocd_resource_ignore_updates_customization" "wildcard" {
+  group = "*.%[1]s"
+  kind  = "Widget"
+
+  %[2]s
+}
+`, group, fields)
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &settingsResource{}
+var _ resource.ResourceWithConfigValidators = &settingsResource{}
+var _ resource.ResourceWithImportState = &settingsResource{}
+
+func NewSettingsResource() resource.Resource {
+	return &settingsResource{}
+}
+
+// settingsResource defines the resource implementation.
+type settingsResource struct {
+	si *ServerInterface
+}
+
+func (r *settingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings"
+}
+
+func (r *settingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages [general settings](https://argo-cd.readthedocs.io/en/stable/operator-manual/argocd-cm-yaml/) of ArgoCD within the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Settings are owned per key: only the keys of the configured attributes are written, the remaining ones are left untouched so that they may be managed by other tools, e.g. the Helm chart of ArgoCD. " +
+			"Removing an attribute from the configuration, or destroying the resource, removes its key so that ArgoCD falls back to its default.\n\n" +
+			"**Note**: a key should not be managed by more than one `argocd_settings` resource or tool, as they would otherwise overwrite each other's value.",
+		Attributes: settingsSchemaAttributes(),
+	}
+}
+
+func (r *settingsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(settingsAttributePaths...),
+	}
+}
+
+func (r *settingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *settingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data settingsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = updateGeneralSettings(ctx, kc, namespace, data.toGeneralSettings(), nil)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to create settings", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created settings in namespace %s", namespace))
+
+	data.ID = types.StringValue(common.ArgoCDConfigMapName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data settingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.RLock()
+	settings, err := readGeneralSettings(ctx, kc, namespace)
+	sync.SettingsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// ConfigMap has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read settings", err)...)
+		return
+	}
+
+	// At least one setting is configured, hence none of them are managed only
+	// when importing, in which case all settings present are taken over.
+	resp.Diagnostics.Append(data.updateFromGeneralSettings(settings, data.isEmpty())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state settingsModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = updateGeneralSettings(ctx, kc, namespace, data.toGeneralSettings(), state.toGeneralSettings())
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to update settings", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated settings in namespace %s", namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data settingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	sync.SettingsMutex.Lock()
+	err = updateGeneralSettings(ctx, kc, namespace, nil, data.toGeneralSettings())
+	sync.SettingsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error("failed to delete settings", err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted settings in namespace %s", namespace))
+}
+
+func (r *settingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDSettings("https://argocd.example.com", "5m", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_settings.test", "id", "argocd-cm"),
+					resource.TestCheckResourceAttr("argocd_settings.test", "url", "https://argocd.example.com"),
+					resource.TestCheckResourceAttr("argocd_settings.test", "reconciliation_timeout", "5m"),
+					resource.TestCheckResourceAttr("argocd_settings.test", "status_badge_enabled", "false"),
+				),
+			},
+			{
+				// All settings are configured, hence the imported state matches
+				ResourceName:      "argocd_settings.test",
+				ImportState:       true,
+				ImportStateId:     "argocd-cm",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDSettings("https://argocd.example.org", "3m", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_settings.test", "url", "https://argocd.example.org"),
+					resource.TestCheckResourceAttr("argocd_settings.test", "reconciliation_timeout", "3m"),
+					resource.TestCheckResourceAttr("argocd_settings.test", "status_badge_enabled", "true"),
+				),
+			},
+			{
+				Config:   testAccArgoCDSettings("https://argocd.example.org", "3m", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDSettings(url, reconciliationTimeout string, statusBadgeEnabled bool) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_settings" "test" {
+  url                     = "%s"
+  reconciliation_timeout  = "%s"
+  status_badge_enabled    = %t
+  anonymous_users_enabled = false
+  instance_label_key      = "app.kubernetes.io/instance"
+  exec_enabled            = false
+  admin_enabled           = true
+}
+`, url, reconciliationTimeout, statusBadgeEnabled)
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &sshKnownHostsResource{}
+
+func NewSSHKnownHostsResource() resource.Resource {
+	return &sshKnownHostsResource{}
+}
+
+// sshKnownHostsResource defines the resource implementation.
+type sshKnownHostsResource struct {
+	si *ServerInterface
+}
+
+func (r *sshKnownHostsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_known_hosts"
+}
+
+func (r *sshKnownHostsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of [SSH known host entries](https://argo-cd.readthedocs.io/en/stable/user-guide/private-repositories/#ssh-known-host-public-keys) used by ArgoCD for connecting Git repositories.\n\n" +
+			"Unlike `argocd_repository_certificate`, all entries are created in a single request, and updates first add or replace the changed keys " +
+			"before removing the ones which are no longer configured, so that hosts are never left without a key in between.\n\n" +
+			"**Note**: the ArgoCD API does not return the keys themselves, so the provider is only able to detect entries which have been removed outside of Terraform or whose key has been replaced, through their fingerprints.",
+		Attributes: sshKnownHostsSchemaAttributes(),
+	}
+}
+
+func (r *sshKnownHostsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *sshKnownHostsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data sshKnownHostsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.CertificateMutex.Lock()
+	resp.Diagnostics.Append(r.createEntries(ctx, data.Entries, false)...)
+	sync.CertificateMutex.Unlock()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("ssh_known_hosts")
+	data.Fingerprints, _ = types.MapValueFrom(ctx, types.StringType, data.fingerprints())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("created %d SSH known host entries", len(data.Entries)))
+}
+
+func (r *sshKnownHostsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data sshKnownHostsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.CertificateMutex.RLock()
+	certs, err := r.si.CertificateClient.ListCertificates(ctx, &certificate.RepositoryCertificateQuery{
+		CertType: sshCertType,
+	})
+	sync.CertificateMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read SSH known hosts", err)...)
+		return
+	}
+
+	// ArgoCD reports the SHA256 fingerprint of the key of each entry
+	existing := make(map[string]string, len(certs.Items))
+	for _, c := range certs.Items {
+		existing[c.CertSubType+"/"+c.ServerName] = c.CertInfo
+	}
+
+	// Drop the entries which have been removed or whose key has been replaced
+	// out-of-band, so that they are created again on the next apply
+	entries := make([]sshKnownHostsEntryModel, 0, len(data.Entries))
+	replaced := false
+
+	for _, e := range data.Entries {
+		fingerprint, ok := existing[e.key()]
+
+		switch {
+		case ok && fingerprint == e.fingerprint():
+			entries = append(entries, e)
+		case ok:
+			replaced = true
+		}
+	}
+
+	// Entries with a replaced key still exist, and hence need to be updated
+	// rather than created
+	if len(entries) == 0 && !replaced {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Entries = entries
+	data.Fingerprints, _ = types.MapValueFrom(ctx, types.StringType, data.fingerprints())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sshKnownHostsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state sshKnownHostsModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Entries are diffed by server name, sub type and fingerprint. Changed keys
+	// are replaced in place, as ArgoCD only stores one key per server name and
+	// sub type.
+	current := state.fingerprints()
+	planned := data.fingerprints()
+
+	var changed []sshKnownHostsEntryModel
+
+	for _, e := range data.Entries {
+		if fingerprint, ok := current[e.key()]; !ok || fingerprint != e.fingerprint() {
+			changed = append(changed, e)
+		}
+	}
+
+	var removed []sshKnownHostsEntryModel
+
+	for _, e := range state.Entries {
+		if _, ok := planned[e.key()]; !ok {
+			removed = append(removed, e)
+		}
+	}
+
+	sync.CertificateMutex.Lock()
+	defer sync.CertificateMutex.Unlock()
+
+	resp.Diagnostics.Append(r.createEntries(ctx, changed, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteEntries(ctx, removed)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.Fingerprints, _ = types.MapValueFrom(ctx, types.StringType, planned)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("updated %d and removed %d SSH known host entries", len(changed), len(removed)))
+}
+
+func (r *sshKnownHostsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data sshKnownHostsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.CertificateMutex.Lock()
+	resp.Diagnostics.Append(r.deleteEntries(ctx, data.Entries)...)
+	sync.CertificateMutex.Unlock()
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted %d SSH known host entries", len(data.Entries)))
+}
+
+// createEntries creates all entries in a single request, which ArgoCD either
+// applies as a whole or not at all. Callers must hold the certificate mutex.
+func (r *sshKnownHostsResource) createEntries(ctx context.Context, entries []sshKnownHostsEntryModel, upsert bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(entries) == 0 {
+		return diags
+	}
+
+	certs := v1alpha1.RepositoryCertificateList{}
+	for _, e := range entries {
+		certs.Items = append(certs.Items, e.toAPIModel())
+	}
+
+	_, err := r.si.CertificateClient.CreateCertificate(ctx, &certificate.RepositoryCertificateCreateRequest{
+		Certificates: &certs,
+		Upsert:       upsert,
+	})
+	if err != nil {
+		diags.Append(diagnostics.Error("failed to create SSH known hosts", err)...)
+	}
+
+	return diags
+}
+
+// deleteEntries deletes the entries one by one, as the ArgoCD API only allows
+// deleting entries matching a single server name and sub type at once.
+// Callers must hold the certificate mutex.
+func (r *sshKnownHostsResource) deleteEntries(ctx context.Context, entries []sshKnownHostsEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range entries {
+		_, err := r.si.CertificateClient.DeleteCertificate(ctx, &certificate.RepositoryCertificateQuery{
+			HostNamePattern: e.ServerName.ValueString(),
+			CertType:        sshCertType,
+			CertSubType:     e.CertSubType.ValueString(),
+		})
+		if err != nil && !strings.Contains(err.Error(), "NotFound") {
+			diags.Append(diagnostics.ArgoCDAPIError("delete", "SSH known host", e.key(), err)...)
+		}
+	}
+
+	return diags
+}
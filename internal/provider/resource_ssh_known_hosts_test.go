@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const (
+	testSSHKnownHostGitLab = "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY="
+	testSSHKnownHostGitHub = "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBEmKSENjQEezOmxkZMy7opKgwFB9nkt5YRrYMjNuG5N87uRgg6CLrbo5wAdT/y6v0mKV0U2w0WZ2YB/++Tpockg="
+)
+
+func TestAccArgoCDSSHKnownHosts(t *testing.T) {
+	first := acctest.RandomWithPrefix("first")
+	second := acctest.RandomWithPrefix("second")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDSSHKnownHosts(map[string]string{
+					first:  testSSHKnownHostGitLab,
+					second: testSSHKnownHostGitLab,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "fingerprints.%", "2"),
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "fingerprints.ecdsa-sha2-nistp256/"+first, "SHA256:HbW3g8zUjNSksFbqTiUWPWg2Bq1x8xdGUrliXFzSnUw"),
+				),
+			},
+			{
+				// Rotate the key of one host and remove the other
+				Config: testAccArgoCDSSHKnownHosts(map[string]string{
+					first: testSSHKnownHostGitHub,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "entries.#", "1"),
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "fingerprints.%", "1"),
+					resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "fingerprints.ecdsa-sha2-nistp256/"+first, "SHA256:p2QAMXNIC1TJYWeIOttrVc98/R1BUFWu3/LiyKgUfQM"),
+				),
+			},
+			{
+				Config: testAccArgoCDSSHKnownHosts(map[string]string{
+					first: testSSHKnownHostGitHub,
+				}),
+				PlanOnly: true,
+			},
+			{
+				// Keys replaced outside of Terraform are updated again
+				PreConfig: func() {
+					si, err := getServerInterface()
+					if err != nil {
+						t.Fatalf("failed to get server interface: %s", err)
+					}
+
+					ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+					defer cancel()
+
+					_, err = si.CertificateClient.CreateCertificate(ctx, &certificate.RepositoryCertificateCreateRequest{
+						Certificates: &v1alpha1.RepositoryCertificateList{
+							Items: []v1alpha1.RepositoryCertificate{
+								{
+									CertType:    "ssh",
+									ServerName:  first,
+									CertSubType: "ecdsa-sha2-nistp256",
+									CertData:    []byte(testSSHKnownHostGitLab),
+								},
+							},
+						},
+						Upsert: true,
+					})
+					if err != nil {
+						t.Fatalf("failed to replace SSH known host %s: %s", first, err)
+					}
+				},
+				Config: testAccArgoCDSSHKnownHosts(map[string]string{
+					first: testSSHKnownHostGitHub,
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("argocd_ssh_known_hosts.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("argocd_ssh_known_hosts.test", "fingerprints.ecdsa-sha2-nistp256/"+first, "SHA256:p2QAMXNIC1TJYWeIOttrVc98/R1BUFWu3/LiyKgUfQM"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "argocd_ssh_known_hosts" "test" {
+  entries = [
+    {
+      server_name  = "%[1]s"
+      cert_subtype = "ecdsa-sha2-nistp256"
+      cert_data    = "%[2]s"
+    },
+    {
+      server_name  = "%[1]s"
+      cert_subtype = "ecdsa-sha2-nistp256"
+      cert_data    = "%[3]s"
+    },
+  ]
+}
+`, first, testSSHKnownHostGitLab, testSSHKnownHostGitHub),
+				ExpectError: regexp.MustCompile("Duplicate SSH Known Host"),
+			},
+		},
+	})
+}
+
+func testAccArgoCDSSHKnownHosts(entries map[string]string) string {
+	config := `
+resource "argocd_ssh_known_hosts" "test" {
+  entries = [
+`
+
+	for serverName, certData := range entries {
+		config += fmt.Sprintf(`
+    {
+      server_name  = "%s"
+      cert_subtype = "ecdsa-sha2-nistp256"
+      cert_data    = "%s"
+    },
+`, serverName, certData)
+	}
+
+	return config + "  ]\n}\n"
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &uiExtensionResource{}
+var _ resource.ResourceWithImportState = &uiExtensionResource{}
+var _ resource.ResourceWithValidateConfig = &uiExtensionResource{}
+
+func NewUIExtensionResource() resource.Resource {
+	return &uiExtensionResource{}
+}
+
+// uiExtensionResource defines the resource implementation.
+type uiExtensionResource struct {
+	si *ServerInterface
+}
+
+func (r *uiExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ui_extension"
+}
+
+func (r *uiExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the backend of an ArgoCD [proxy extension](https://argo-cd.readthedocs.io/en/stable/developer-guide/extensions/proxy-extensions/), " +
+			"i.e. the services to which the requests of a UI extension are forwarded, through the `extension.config.<name>` key of the `argocd-cm` ConfigMap.\n\n" +
+			configMapResourceDescription +
+			"Only the key above is written, any other settings within the ConfigMap are left untouched. " +
+			"Creating an extension which is already configured, either under its own key or within the `extension.config` key, fails so that extensions managed elsewhere are not overwritten.\n\n" +
+			"**Note**: the JavaScript bundle of the UI extension itself is not managed by this resource, it must be installed into the `argocd-server` pods, e.g. with the [argocd-extension-installer](https://github.com/argoproj-labs/argocd-extension-installer). " +
+			"Proxy extensions must furthermore be enabled by setting `server.enable.proxy.extension` to `\"true\"` in the `argocd-cmd-params-cm` ConfigMap, " +
+			"and users must be granted the `invoke` action on the `extensions` resource, e.g. with `argocd_rbac`.",
+		Attributes: uiExtensionSchemaAttributes(),
+	}
+}
+
+func (r *uiExtensionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var services types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("services"), &services)...)
+
+	if resp.Diagnostics.HasError() || services.IsNull() || services.IsUnknown() || len(services.Elements()) < 2 {
+		return
+	}
+
+	// ArgoCD cannot tell which service to forward requests to unless each of
+	// them is bound to a cluster
+	for i, e := range services.Elements() {
+		service, ok := e.(types.Object)
+		if !ok || service.IsUnknown() {
+			continue
+		}
+
+		if cluster, ok := service.Attributes()["cluster"]; ok && cluster.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("services").AtListIndex(i).AtName("cluster"),
+				"Missing Attribute Configuration",
+				"cluster must be configured for each service if more than one service is configured",
+			)
+		}
+	}
+}
+
+func (r *uiExtensionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *uiExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data uiExtensionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+	b := data.toUIExtensionBackend()
+
+	sync.SettingsMutex.Lock()
+	err = createUIExtension(ctx, kc, namespace, name, b)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to create extension %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created extension %s in namespace %s", name, namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newUIExtension(name, b))...)
+}
+
+func (r *uiExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data uiExtensionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.ID.ValueString()
+
+	sync.SettingsMutex.RLock()
+	b, err := readUIExtension(ctx, kc, namespace, name)
+	sync.SettingsMutex.RUnlock()
+
+	if apierrors.IsNotFound(err) {
+		// Extension has been deleted out-of-band
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to read extension %s", name), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newUIExtension(name, b))...)
+}
+
+func (r *uiExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data uiExtensionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+	b := data.toUIExtensionBackend()
+
+	sync.SettingsMutex.Lock()
+	err = updateUIExtension(ctx, kc, namespace, name, b)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to update extension %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated extension %s in namespace %s", name, namespace))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newUIExtension(name, b))...)
+}
+
+func (r *uiExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data uiExtensionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc, namespace, err := r.si.KubernetesClient()
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to initialize Kubernetes client", err)...)
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	sync.SettingsMutex.Lock()
+	err = deleteUIExtension(ctx, kc, namespace, name)
+	sync.SettingsMutex.Unlock()
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to delete extension %s", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted extension %s in namespace %s", name, namespace))
+}
+
+func (r *uiExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDUIExtension(t *testing.T) {
+	name := acctest.RandomWithPrefix("metrics")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCore(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDUIExtension(name, "5s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_ui_extension.test", "id", name),
+					resource.TestCheckResourceAttr("argocd_ui_extension.test", "services.0.url", "http://argocd-metrics-server.argocd:9003"),
+					resource.TestCheckResourceAttr("argocd_ui_extension.test", "services.0.headers.0.value", "Bearer $extension.metrics.token"),
+					resource.TestCheckResourceAttr("argocd_ui_extension.test", "connection_timeout", "5s"),
+				),
+			},
+			{
+				ResourceName:      "argocd_ui_extension.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArgoCDUIExtension(name, "10s"),
+				Check:  resource.TestCheckResourceAttr("argocd_ui_extension.test", "connection_timeout", "10s"),
+			},
+			{
+				Config:   testAccArgoCDUIExtension(name, "10s"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccArgoCDUIExtension(name, connectionTimeout string) string {
+	return testAccCoreProviderConfig + fmt.Sprintf(`
+resource "argocd_ui_extension" "test" {
+  name = "%s"
+
+  services = [
+    {
+      url = "http://argocd-metrics-server.argocd:9003"
+
+      headers = [
+        {
+          name  = "Authorization"
+          value = "Bearer $extension.metrics.token"
+        },
+      ]
+    },
+  ]
+
+  connection_timeout = "%s"
+}
+`, name, connectionTimeout)
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 var runtimeErrorHandlers []runtime.ErrorHandler
@@ -48,9 +50,13 @@ type ServerInterface struct {
 	ServerVersion        *semver.Version
 	ServerVersionMessage *version.VersionMessage
 
-	config      ArgoCDProviderConfig
-	initialized bool
+	config        ArgoCDProviderConfig
+	clientOptions *apiclient.ClientOptions
+	initialized   bool
 	sync.RWMutex
+
+	kubernetesClient    kubernetes.Interface
+	kubernetesNamespace string
 }
 
 func NewServerInterface(c ArgoCDProviderConfig) *ServerInterface {
@@ -59,6 +65,50 @@ func NewServerInterface(c ArgoCDProviderConfig) *ServerInterface {
 	}
 }
 
+// configMapResourceDescription is part of the description of the resources
+// which manage settings within the ConfigMaps of ArgoCD through
+// KubernetesClient.
+const configMapResourceDescription = "The ArgoCD API does not allow managing its settings, hence the ConfigMap is managed through the Kubernetes API. " +
+	"This requires the provider to be configured with [`core = true`](https://registry.terraform.io/providers/argoproj-labs/argocd/latest/docs#core). "
+
+// KubernetesClient returns a client for the Kubernetes API ArgoCD is
+// running on, along with the namespace ArgoCD is installed in. As for the
+// local server started in core mode, both are taken from the current context
+// of the default kubeconfig.
+func (si *ServerInterface) KubernetesClient() (kubernetes.Interface, string, error) {
+	si.Lock()
+	defer si.Unlock()
+
+	if si.kubernetesClient != nil {
+		return si.kubernetesClient, si.kubernetesNamespace, nil
+	}
+
+	if !si.config.Core.ValueBool() {
+		return nil, "", fmt.Errorf("the Kubernetes API can only be accessed directly when the provider is configured with `core = true`")
+	}
+
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read namespace of the current kubeconfig context: %w", err)
+	}
+
+	kc, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	si.kubernetesClient, si.kubernetesNamespace = kc, namespace
+
+	return kc, namespace, nil
+}
+
 func (si *ServerInterface) InitClients(ctx context.Context) diag.Diagnostics {
 	si.Lock()
 	defer si.Unlock()
@@ -154,11 +204,51 @@ func (si *ServerInterface) InitClients(ctx context.Context) diag.Diagnostics {
 		si.ServerVersion = serverVersion
 	}
 
+	si.clientOptions = opts
 	si.initialized = !diags.HasError()
 
 	return diags
 }
 
+// VerifyToken checks whether ArgoCD accepts the given token by retrieving the
+// user info of the session it authenticates. Tokens are always accepted when
+// the provider is configured with `core = true`, as the local server does not
+// authenticate requests.
+func (si *ServerInterface) VerifyToken(ctx context.Context, token string) error {
+	if si.config.Core.ValueBool() {
+		return nil
+	}
+
+	si.RLock()
+	opts := *si.clientOptions
+	si.RUnlock()
+
+	opts.AuthToken = token
+
+	ac, err := apiclient.NewClient(&opts)
+	if err != nil {
+		return fmt.Errorf("failed to create new API client: %w", err)
+	}
+
+	closer, sessionClient, err := ac.NewSessionClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize session client: %w", err)
+	}
+
+	defer io.Close(closer)
+
+	userInfo, err := sessionClient.GetUserInfo(ctx, &session.GetUserInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	if !userInfo.LoggedIn {
+		return fmt.Errorf("token was not accepted")
+	}
+
+	return nil
+}
+
 // Checks that a specific feature is available for the current ArgoCD server version.
 // 'feature' argument must match one of the predefined feature* constants.
 func (si *ServerInterface) IsFeatureSupported(feature features.Feature) bool {
@@ -12,9 +12,35 @@ var RepositoryMutex = &sync.RWMutex{}
 // CertificateMutex is used to handle concurrent access to ArgoCD repository certificates
 var CertificateMutex = &sync.RWMutex{}
 
+// ClusterMutex is used to handle concurrent access to ArgoCD clusters
+var ClusterMutex = &sync.RWMutex{}
+
 // RepositoryCredentialsMutex is used to handle concurrent access to ArgoCD repository credentials
 var RepositoryCredentialsMutex = &sync.RWMutex{}
 
+// AccountMutex is used to handle concurrent access to ArgoCD local accounts
+// which are stored in the `argocd-cm` ConfigMap and `argocd-secret` Secret
+// resources
+var AccountMutex = &sync.RWMutex{}
+
+// RBACMutex is used to handle concurrent access to the ArgoCD RBAC
+// configuration which is stored in the `argocd-rbac-cm` ConfigMap resource
+var RBACMutex = &sync.RWMutex{}
+
+// SettingsMutex is used to handle concurrent access to the general settings of
+// ArgoCD which are stored in the `argocd-cm` ConfigMap resource
+var SettingsMutex = &sync.RWMutex{}
+
+// ResourceCustomizationsMutex is used to handle concurrent access to the
+// resource customizations of ArgoCD which are stored in the `argocd-cm`
+// ConfigMap resource
+var ResourceCustomizationsMutex = &sync.RWMutex{}
+
+// NotificationsMutex is used to handle concurrent access to the ArgoCD
+// notifications configuration which is stored in the `argocd-notifications-cm`
+// ConfigMap resource
+var NotificationsMutex = &sync.RWMutex{}
+
 // tokenMutexProjectMap is used to handle concurrent access to ArgoCD project tokens per project
 var tokenMutexProjectMap = make(map[string]*sync.RWMutex)
 
@@ -59,8 +59,8 @@ func runTestSuite(m *testing.M) int {
 			return
 		}
 
-		// Set environment variables for tests; currently only ARGOCD_SERVER is used (since we're port-forwarding the k8s
-		// service) but can be extended with more env vars if needed
+		// Set environment variables for tests; ARGOCD_SERVER points to the port-forwarded k8s service, KUBECONFIG to the
+		// K3s cluster for resources which are managed with `core = true`. Can be extended with more env vars if needed
 		envVars := GlobalTestEnv.GetEnvironmentVariables()
 		for key, value := range envVars {
 			os.Setenv(key, value)
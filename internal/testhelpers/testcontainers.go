@@ -28,6 +28,10 @@ type K3sTestEnvironment struct {
 	K3sContainer *k3s.K3sContainer
 	ArgoCDURL    string
 	RESTConfig   *rest.Config
+	// KubeconfigPath is the path of a kubeconfig of the K3s cluster whose
+	// current context defaults to the namespace ArgoCD is installed in, for
+	// tests of resources which require the provider to use `core = true`.
+	KubeconfigPath string
 }
 
 // SetupK3sWithArgoCD sets up a K3s cluster with ArgoCD using testcontainers
@@ -55,6 +59,11 @@ func SetupK3sWithArgoCD(ctx context.Context, argoCDVersion, k3sVersion string) (
 
 	env := &K3sTestEnvironment{K3sContainer: k3sContainer, RESTConfig: restConfig}
 
+	if err := env.writeKubeconfig(config); err != nil {
+		env.Cleanup(ctx)
+		return nil, fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+
 	// Pull and preload Argo CD image in k3s to reduce waiting time during the `waitForArgoCD` step.
 	argoCDImage := fmt.Sprintf("quay.io/argoproj/argocd:%s", argoCDVersion)
 
@@ -92,6 +101,32 @@ func SetupK3sWithArgoCD(ctx context.Context, argoCDVersion, k3sVersion string) (
 	return env, nil
 }
 
+// writeKubeconfig writes the kubeconfig of the K3s cluster to a temporary
+// file, with the namespace of its current context set to `argocd`
+func (env *K3sTestEnvironment) writeKubeconfig(kubeconfig []byte) error {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if c, ok := config.Contexts[config.CurrentContext]; ok {
+		c.Namespace = "argocd"
+	}
+
+	f, err := os.CreateTemp("", "kubeconfig-*.yaml")
+	if err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	env.KubeconfigPath = f.Name()
+
+	return clientcmd.WriteToFile(*config, env.KubeconfigPath)
+}
+
 // installArgoCD installs ArgoCD in the K3s cluster using kustomize
 func (env *K3sTestEnvironment) installArgoCD(ctx context.Context, version string) error {
 	rootDir, err := env.projectRoot()
@@ -228,7 +263,7 @@ func (env *K3sTestEnvironment) waitForArgoCD(ctx context.Context) error {
 
 // GetEnvironmentVariables returns the environment variables needed for tests
 func (env *K3sTestEnvironment) GetEnvironmentVariables() map[string]string {
-	return map[string]string{"ARGOCD_SERVER": env.ArgoCDURL}
+	return map[string]string{"ARGOCD_SERVER": env.ArgoCDURL, "KUBECONFIG": env.KubeconfigPath}
 }
 
 // Cleanup cleans up the test environment
@@ -239,4 +274,10 @@ func (env *K3sTestEnvironment) Cleanup(ctx context.Context) {
 			fmt.Printf("Warning: failed to terminate container: %v\n", err)
 		}
 	}
+
+	if env.KubeconfigPath != "" {
+		if err := os.Remove(env.KubeconfigPath); err != nil {
+			fmt.Printf("Warning: failed to remove kubeconfig: %v\n", err)
+		}
+	}
 }
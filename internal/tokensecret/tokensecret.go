@@ -0,0 +1,99 @@
+// Package tokensecret pushes ArgoCD tokens into Kubernetes secrets, so that
+// they can be consumed without being stored in the Terraform state.
+package tokensecret
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristalhq/jwt/v5"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "terraform-provider-argocd"
+)
+
+// Write stores the token under the given key of the secret. Secrets which do
+// not exist are created and labelled as such, so that they are deleted again
+// once they no longer hold any token. Existing secrets are only ever modified
+// under the given key.
+func Write(ctx context.Context, kc kubernetes.Interface, namespace, name, key, token string) error {
+	secret, err := kc.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = kc.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{managedByLabel: managedByValue},
+			},
+			Data: map[string][]byte{key: []byte(token)},
+		}, metav1.CreateOptions{})
+
+		return err
+	case err != nil:
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	secret.Data[key] = []byte(token)
+
+	_, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+
+	return err
+}
+
+// Delete removes the token with the given ID from the secret. The key is left
+// untouched if it holds another token, e.g. the one which replaced it when
+// tokens are renewed.
+func Delete(ctx context.Context, kc kubernetes.Interface, namespace, name, key, id string) error {
+	secret, err := kc.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if tokenID(secret.Data[key]) != id {
+		return nil
+	}
+
+	delete(secret.Data, key)
+
+	if len(secret.Data) == 0 && secret.Labels[managedByLabel] == managedByValue {
+		err = kc.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	} else {
+		_, err = kc.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	}
+
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// tokenID returns the ID of the token stored in a secret, or an empty
+// string if it does not hold a token.
+func tokenID(value []byte) string {
+	token, err := jwt.ParseNoVerify(value)
+	if err != nil {
+		return ""
+	}
+
+	var claims jwt.RegisteredClaims
+	if err = json.Unmarshal(token.Claims(), &claims); err != nil {
+		return ""
+	}
+
+	return claims.ID
+}
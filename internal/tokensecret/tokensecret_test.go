@@ -0,0 +1,93 @@
+package tokensecret
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testTokenWithID(id string) string {
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		enc.EncodeToString([]byte(`{"jti":"`+id+`","iat":1700000000}`)) + "." +
+		enc.EncodeToString([]byte("signature"))
+}
+
+func TestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset()
+
+	first, second := testTokenWithID("terraform-1"), testTokenWithID("terraform-2")
+
+	if err := Write(ctx, kc, "ci", "argocd-token", "token", first); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Renewed tokens replace the previous one, which is then revoked
+	if err := Write(ctx, kc, "ci", "argocd-token", "token", second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := Delete(ctx, kc, "ci", "argocd-token", "token", "terraform-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secret, err := kc.CoreV1().Secrets("ci").Get(ctx, "argocd-token", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, second, string(secret.Data["token"]))
+
+	// Secrets created by the provider are deleted along with their last token
+	if err = Delete(ctx, kc, "ci", "argocd-token", "token", "terraform-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err = kc.CoreV1().Secrets("ci").Get(ctx, "argocd-token", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if err = Delete(ctx, kc, "ci", "argocd-token", "token", "terraform-2"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestExistingSecret(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kc := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "image-updater", Namespace: "argocd"},
+			Data:       map[string][]byte{"registries": []byte("ghcr.io")},
+		},
+	)
+
+	token := testTokenWithID("terraform-1")
+
+	if err := Write(ctx, kc, "argocd", "image-updater", "argocd.token", token); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := Delete(ctx, kc, "argocd", "image-updater", "argocd.token", "terraform-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Secrets which existed beforehand are retained, along with their other keys
+	secret, err := kc.CoreV1().Secrets("argocd").Get(ctx, "image-updater", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string][]byte{"registries": []byte("ghcr.io")}, secret.Data)
+}
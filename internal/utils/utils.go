@@ -23,6 +23,14 @@ func OptionalString(value *string) basetypes.StringValue {
 	return types.StringValue(*value)
 }
 
+func OptionalNonEmptyString(value string) basetypes.StringValue {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
 func OptionalTimeString(value *metav1.Time) basetypes.StringValue {
 	if value == nil {
 		return types.StringNull()
@@ -3,11 +3,46 @@ package validators
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// ambiguousDurationRegexp matches durations where a fractional component is
+// followed by further components, e.g. `1.5h30m`.
+var ambiguousDurationRegexp = regexp.MustCompile(`\.\d*[a-zµμ]+[\d.]`)
+
+// ValidateDuration ensures that value is a duration ArgoCD is able to parse
+// (e.g. `30s`, `10m`, `1h30m`) and rejects values mixing fractional and
+// additional components, since their meaning is unclear to most readers.
+func ValidateDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s. Valid units are 'ns', 'us', 'ms', 's', 'm' and 'h'", err)
+	}
+
+	if ambiguousDurationRegexp.MatchString(value) {
+		return fmt.Errorf("fractional values can only be used in the last component of a duration, use '%s' instead", formatDuration(d))
+	}
+
+	return nil
+}
+
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
+
 // DurationValidator returns a validator which ensures that any configured
 // attribute value is a valid duration string.
 func DurationValidator() validator.String {
@@ -30,7 +65,7 @@ func (v durationValidator) ValidateString(ctx context.Context, req validator.Str
 	}
 
 	value := req.ConfigValue.ValueString()
-	if _, err := time.ParseDuration(value); err != nil {
+	if err := ValidateDuration(value); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Duration",
@@ -0,0 +1,58 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expectError string
+	}{
+		"seconds": {
+			value: "30s",
+		},
+		"hours and minutes": {
+			value: "1h30m",
+		},
+		"trailing fractional component": {
+			value: "1h1.5m",
+		},
+		"fractional hours": {
+			value: "1.5h",
+		},
+		"missing unit": {
+			value:       "30",
+			expectError: "Valid units are",
+		},
+		"days": {
+			value:       "1d",
+			expectError: "Valid units are",
+		},
+		"fractional component followed by minutes": {
+			value:       "1.5h30m",
+			expectError: "use '2h' instead",
+		},
+		"fractional component followed by seconds": {
+			value:       "1.5m10s",
+			expectError: "use '1m40s' instead",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateDuration(test.value)
+			if test.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
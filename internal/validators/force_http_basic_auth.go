@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Bool = forceHTTPBasicAuthValidator{}
+
+type forceHTTPBasicAuthValidator struct{}
+
+func (v forceHTTPBasicAuthValidator) Description(_ context.Context) string {
+	return "force_http_basic_auth can only be set to true when authenticating with a password, not with an SSH private key or a bearer token"
+}
+
+func (v forceHTTPBasicAuthValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v forceHTTPBasicAuthValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || !req.ConfigValue.ValueBool() {
+		return
+	}
+
+	for _, attribute := range []string{"ssh_private_key", "ssh_private_key_wo", "bearer_token"} {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Configuration",
+				"force_http_basic_auth can only be set to true when authenticating with a password, but "+attribute+" is set",
+			)
+		}
+	}
+
+	var password, passwordWO types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+
+	if password.IsNull() && passwordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Configuration",
+			"force_http_basic_auth can only be set to true when password or password_wo is set",
+		)
+	}
+}
+
+// ForceHTTPBasicAuth returns a validator that ensures force_http_basic_auth is
+// only enabled for repositories authenticating with a username and password.
+func ForceHTTPBasicAuth() validator.Bool {
+	return forceHTTPBasicAuthValidator{}
+}
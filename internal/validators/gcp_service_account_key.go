@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = gcpServiceAccountKeyValidator{}
+
+type gcpServiceAccountKeyValidator struct{}
+
+func (v gcpServiceAccountKeyValidator) Description(_ context.Context) string {
+	return "value must be a Google Cloud service account key in JSON format"
+}
+
+func (v gcpServiceAccountKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gcpServiceAccountKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var key struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &key); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Service Account Key",
+			"The provided value is not a valid JSON service account key: "+err.Error(),
+		)
+
+		return
+	}
+
+	if key.Type == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Service Account Key",
+			"The provided service account key does not specify its credentials `type`, e.g. `service_account`",
+		)
+	}
+}
+
+// GCPServiceAccountKey validates that a string is a Google Cloud service
+// account key (or any other credentials file understood by Google client
+// libraries) in JSON format.
+func GCPServiceAccountKey() validator.String {
+	return gcpServiceAccountKeyValidator{}
+}
@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCPServiceAccountKeyValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         types.String
+		expectError bool
+	}{
+		"null value": {
+			val: types.StringNull(),
+		},
+		"unknown value": {
+			val: types.StringUnknown(),
+		},
+		"service account key": {
+			val: types.StringValue(`{"type": "service_account", "project_id": "my-project", "private_key_id": "abc", "client_email": "argocd@my-project.iam.gserviceaccount.com"}`),
+		},
+		"external account credentials": {
+			val: types.StringValue(`{"type": "external_account", "audience": "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider"}`),
+		},
+		"invalid JSON": {
+			val:         types.StringValue("not json"),
+			expectError: true,
+		},
+		"missing type": {
+			val:         types.StringValue(`{"project_id": "my-project"}`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("gcp_service_account_key"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.StringResponse{}
+			GCPServiceAccountKey().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ValidateNotificationsExpression ensures that value is an expression which
+// can be compiled by the ArgoCD notifications controller, e.g. the `when`
+// condition of a trigger.
+func ValidateNotificationsExpression(value string) error {
+	if _, err := expr.Compile(value); err != nil {
+		return fmt.Errorf("'%s' is not a valid expression: %s", value, err.Error())
+	}
+
+	return nil
+}
+
+// NotificationsExpression returns a validator which ensures that any
+// configured attribute value is a valid notifications expression.
+func NotificationsExpression() validator.String {
+	return notificationsExpressionValidator{}
+}
+
+type notificationsExpressionValidator struct{}
+
+func (v notificationsExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid expression, see https://expr-lang.org/docs/language-definition"
+}
+
+func (v notificationsExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationsExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateNotificationsExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Expression",
+			err.Error(),
+		)
+	}
+}
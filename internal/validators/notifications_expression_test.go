@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNotificationsExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expectError string
+	}{
+		"condition": {
+			value: "app.status.operationState.phase in ['Succeeded'] and app.status.health.status == 'Healthy'",
+		},
+		"function call": {
+			value: "time.Now().Sub(time.Parse(app.status.operationState.startedAt)).Minutes() >= 5",
+		},
+		"once per": {
+			value: "app.status.sync.revision",
+		},
+		"unbalanced brackets": {
+			value:       "app.status.operationState.phase in ['Succeeded'",
+			expectError: "is not a valid expression",
+		},
+		"dangling operator": {
+			value:       "app.status.health.status ==",
+			expectError: "is not a valid expression",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateNotificationsExpression(test.value)
+			if test.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
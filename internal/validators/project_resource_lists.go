@@ -0,0 +1,157 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = projectResourceListsValidator{}
+
+// coreKinds are the kinds of the core API group, i.e. the kinds matched by an
+// empty group.
+var coreKinds = []string{
+	"Binding",
+	"ComponentStatus",
+	"ConfigMap",
+	"Endpoints",
+	"Event",
+	"LimitRange",
+	"Namespace",
+	"Node",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"Pod",
+	"PodTemplate",
+	"ReplicationController",
+	"ResourceQuota",
+	"Secret",
+	"Service",
+	"ServiceAccount",
+}
+
+type projectResourceListsValidator struct{}
+
+func (v projectResourceListsValidator) Description(_ context.Context) string {
+	return "resource allow and deny lists must use valid patterns and should not contain common mistakes"
+}
+
+func (v projectResourceListsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectResourceListsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	specPath := path.Root("spec").AtListIndex(0)
+
+	for _, scope := range []string{"cluster", "namespace"} {
+		whitelistPath := specPath.AtName(scope + "_resource_whitelist")
+		blacklistPath := specPath.AtName(scope + "_resource_blacklist")
+
+		whitelist := v.validateList(ctx, req, resp, whitelistPath)
+		blacklist := v.validateList(ctx, req, resp, blacklistPath)
+
+		for _, gk := range whitelist {
+			if slices.Contains(blacklist, gk) {
+				resp.Diagnostics.AddAttributeWarning(
+					whitelistPath,
+					"Resource Both Whitelisted And Blacklisted",
+					fmt.Sprintf("group '%s' and kind '%s' are both whitelisted and blacklisted. The blacklist takes precedence, hence such resources cannot be synced.", gk[0], gk[1]),
+				)
+			}
+		}
+	}
+}
+
+// validateList validates the entries of the allow or deny list at p and
+// returns their group and kind.
+func (v projectResourceListsValidator) validateList(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse, p path.Path) [][2]string {
+	var list types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &list)...)
+
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	entries := make([][2]string, 0, len(list.Elements()))
+
+	for _, e := range list.Elements() {
+		entry, ok := e.(types.Object)
+		if !ok || entry.IsNull() || entry.IsUnknown() {
+			continue
+		}
+
+		group, ok := entry.Attributes()["group"].(types.String)
+		if !ok || group.IsUnknown() {
+			continue
+		}
+
+		kind, ok := entry.Attributes()["kind"].(types.String)
+		if !ok || kind.IsUnknown() {
+			continue
+		}
+
+		entryPath := p.AtSetValue(entry)
+
+		if err := ValidateGroupKindPattern(group.ValueString(), kind.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(entryPath, "Invalid Resource Pattern", err.Error())
+			continue
+		}
+
+		if warning := groupKindWarning(group.ValueString(), kind.ValueString()); warning != "" {
+			resp.Diagnostics.AddAttributeWarning(entryPath, "Suspicious Resource Pattern", warning)
+		}
+
+		entries = append(entries, [2]string{group.ValueString(), kind.ValueString()})
+	}
+
+	return entries
+}
+
+// ValidateGroupKindPattern ensures that group and kind are patterns ArgoCD is
+// able to match resources against. Invalid patterns never match any resource.
+func ValidateGroupKindPattern(group, kind string) error {
+	if _, err := filepath.Match(group, ""); err != nil {
+		return fmt.Errorf("group '%s' is not a valid pattern: %s", group, err.Error())
+	}
+
+	if _, err := filepath.Match(kind, ""); err != nil {
+		return fmt.Errorf("kind '%s' is not a valid pattern: %s", kind, err.Error())
+	}
+
+	return nil
+}
+
+// groupKindWarning returns a description of a likely mistake in the given
+// group and kind patterns, if any.
+func groupKindWarning(group, kind string) string {
+	if kind == "" {
+		return fmt.Sprintf("an empty kind does not match any resource of group '%s', use '*' to match all kinds", group)
+	}
+
+	isLiteralKind := !strings.ContainsAny(kind, `*?[\`)
+
+	if isLiteralKind && unicode.IsLower([]rune(kind)[0]) {
+		return fmt.Sprintf("kinds are matched case sensitively against the CamelCase, singular kind of resources (e.g. 'Deployment'), hence '%s' is unlikely to match any resource", kind)
+	}
+
+	if group == "" && isLiteralKind && !slices.Contains(coreKinds, kind) {
+		return fmt.Sprintf("an empty group only matches the core API group, which does not contain kind '%s'. Use the group of the kind (e.g. 'apps' for 'Deployment') or '*' to match any group", kind)
+	}
+
+	return ""
+}
+
+// ProjectResourceLists returns a validator which ensures that the cluster and
+// namespace resource allow and deny lists of a project contain valid patterns,
+// and warns on common mistakes which would silently block syncs.
+func ProjectResourceLists() resource.ConfigValidator {
+	return projectResourceListsValidator{}
+}
@@ -0,0 +1,66 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGroupKindPattern(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateGroupKindPattern("*", "*"))
+	assert.NoError(t, ValidateGroupKindPattern("apps", "Deploy*"))
+	assert.Error(t, ValidateGroupKindPattern("[apps", "Deployment"))
+	assert.Error(t, ValidateGroupKindPattern("apps", "Deployment["))
+}
+
+func TestGroupKindWarning(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		group         string
+		kind          string
+		expectWarning bool
+	}{
+		"wildcards": {
+			group: "*",
+			kind:  "*",
+		},
+		"core kind": {
+			group: "",
+			kind:  "Namespace",
+		},
+		"grouped kind": {
+			group: "apps",
+			kind:  "Deployment",
+		},
+		"wildcard kind in core group": {
+			group: "",
+			kind:  "*",
+		},
+		"empty kind": {
+			group:         "apps",
+			kind:          "",
+			expectWarning: true,
+		},
+		"lowercase kind": {
+			group:         "apps",
+			kind:          "deployment",
+			expectWarning: true,
+		},
+		"non-core kind in core group": {
+			group:         "",
+			kind:          "Deployment",
+			expectWarning: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectWarning, groupKindWarning(tt.group, tt.kind) != "")
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = projectRolePoliciesValidator{}
+
+type projectRolePoliciesValidator struct{}
+
+func (v projectRolePoliciesValidator) Description(_ context.Context) string {
+	return "role policies must be valid casbin policy rules scoped to the project and role"
+}
+
+func (v projectRolePoliciesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectRolePoliciesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		projectName types.String
+		roles       types.Set
+	)
+
+	rolesPath := path.Root("spec").AtListIndex(0).AtName("role")
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata").AtListIndex(0).AtName("name"), &projectName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, rolesPath, &roles)...)
+
+	if resp.Diagnostics.HasError() || projectName.IsNull() || projectName.IsUnknown() || roles.IsNull() || roles.IsUnknown() {
+		return
+	}
+
+	for _, r := range roles.Elements() {
+		role, ok := r.(types.Object)
+		if !ok || role.IsNull() || role.IsUnknown() {
+			continue
+		}
+
+		roleName, ok := role.Attributes()["name"].(types.String)
+		if !ok || roleName.IsNull() || roleName.IsUnknown() {
+			continue
+		}
+
+		policies, ok := role.Attributes()["policies"].(types.List)
+		if !ok || policies.IsNull() || policies.IsUnknown() {
+			continue
+		}
+
+		for i, p := range policies.Elements() {
+			policy, ok := p.(types.String)
+			if !ok || policy.IsNull() || policy.IsUnknown() {
+				continue
+			}
+
+			if err := ValidateProjectRolePolicy(projectName.ValueString(), roleName.ValueString(), policy.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					rolesPath.AtSetValue(role).AtName("policies").AtListIndex(i),
+					"Invalid Role Policy",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// ProjectRolePolicies returns a validator which ensures that the policies of
+// every project role follow the casbin grammar enforced by ArgoCD for project
+// scoped policies.
+func ProjectRolePolicies() resource.ConfigValidator {
+	return projectRolePoliciesValidator{}
+}
+
+var validPolicyActionPatterns = []*regexp.Regexp{
+	regexp.MustCompile("action/.*"),
+	regexp.MustCompile("update/.*"),
+	regexp.MustCompile("delete/.*"),
+}
+
+func isValidPolicyAction(action string) bool {
+	switch action {
+	case rbac.ActionGet, rbac.ActionCreate, rbac.ActionUpdate, rbac.ActionDelete, rbac.ActionSync, rbac.ActionOverride, "*":
+		return true
+	}
+
+	for _, p := range validPolicyActionPatterns {
+		if p.MatchString(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateProjectRolePolicy mirrors the validation ArgoCD performs on project
+// role policies so that invalid rules are reported at plan time.
+func ValidateProjectRolePolicy(project, role, policy string) error {
+	policyComponents := strings.Split(policy, ",")
+	if len(policyComponents) != 6 || strings.Trim(policyComponents[0], " ") != "p" {
+		return fmt.Errorf("invalid policy rule '%s': must be of the form: 'p, sub, res, act, obj, eft'", policy)
+	}
+
+	// subject
+	subject := strings.Trim(policyComponents[1], " ")
+	expectedSubject := fmt.Sprintf("proj:%s:%s", project, role)
+
+	if subject != expectedSubject {
+		return fmt.Errorf("invalid policy rule '%s': policy subject must be: '%s', not '%s'", policy, expectedSubject, subject)
+	}
+
+	// resource
+	res := strings.Trim(policyComponents[2], " ")
+	if !rbac.ProjectScoped[res] {
+		return fmt.Errorf("invalid policy rule '%s': project resource must be: 'applications', 'applicationsets', 'repositories', 'exec', 'logs' or 'clusters', not '%s'", policy, res)
+	}
+
+	// action
+	action := strings.Trim(policyComponents[3], " ")
+	if !isValidPolicyAction(action) {
+		return fmt.Errorf("invalid policy rule '%s': invalid action '%s'", policy, action)
+	}
+
+	// object, of the form <PROJECT>[/<NAMESPACE>]/<APPLICATION>
+	object := strings.Trim(policyComponents[4], " ")
+	objectRegexp := regexp.MustCompile(fmt.Sprintf(`^%s(/[*\w-.]+)?/[*\w-.]+$`, regexp.QuoteMeta(project)))
+
+	if !objectRegexp.MatchString(object) {
+		return fmt.Errorf("invalid policy rule '%s': object must be of form '%s/*', '%s[/<NAMESPACE>]/<APPNAME>' or '%s/<APPNAME>', not '%s'", policy, project, project, project, object)
+	}
+
+	// effect
+	effect := strings.Trim(policyComponents[5], " ")
+	if effect != "allow" && effect != "deny" {
+		return fmt.Errorf("invalid policy rule '%s': effect must be: 'allow' or 'deny'", policy)
+	}
+
+	return nil
+}
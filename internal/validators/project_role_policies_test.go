@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProjectRolePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		policy      string
+		expectError bool
+	}{
+		"valid policy": {
+			policy: "p, proj:myproject:admin, applications, get, myproject/*, allow",
+		},
+		"valid applicationsets policy": {
+			policy: "p, proj:myproject:admin, applicationsets, get, myproject/*, allow",
+		},
+		"valid action pattern": {
+			policy: "p, proj:myproject:admin, applications, action/apps/Deployment/restart, myproject/*, allow",
+		},
+		"valid namespaced object": {
+			policy: "p, proj:myproject:admin, applications, get, myproject/default/app-1.2, deny",
+		},
+		"not enough components": {
+			policy:      "p, proj:myproject:admin, applications, get",
+			expectError: true,
+		},
+		"not a policy line": {
+			policy:      "g, proj:myproject:admin, applications, get, myproject/*, allow",
+			expectError: true,
+		},
+		"subject of another project": {
+			policy:      "p, proj:otherproject:admin, applications, get, myproject/*, allow",
+			expectError: true,
+		},
+		"subject of another role": {
+			policy:      "p, proj:myproject:reader, applications, get, myproject/*, allow",
+			expectError: true,
+		},
+		"resource not project scoped": {
+			policy:      "p, proj:myproject:admin, accounts, get, myproject/*, allow",
+			expectError: true,
+		},
+		"invalid action": {
+			policy:      "p, proj:myproject:admin, applications, invalid, myproject/*, allow",
+			expectError: true,
+		},
+		"object of another project": {
+			policy:      "p, proj:myproject:admin, applications, get, otherproject/*, allow",
+			expectError: true,
+		},
+		"object with too many segments": {
+			policy:      "p, proj:myproject:admin, applications, get, myproject/a/b/c, allow",
+			expectError: true,
+		},
+		"invalid effect": {
+			policy:      "p, proj:myproject:admin, applications, get, myproject/*, maybe",
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateProjectRolePolicy("myproject", "admin", tt.policy)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = projectSourceNamespacesValidator{}
+
+type projectSourceNamespacesValidator struct{}
+
+func (v projectSourceNamespacesValidator) Description(_ context.Context) string {
+	return "source namespaces should not target the namespace the project lives in"
+}
+
+func (v projectSourceNamespacesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectSourceNamespacesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		namespace        types.String
+		sourceNamespaces types.Set
+	)
+
+	sourceNamespacesPath := path.Root("spec").AtListIndex(0).AtName("source_namespaces")
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata").AtListIndex(0).AtName("namespace"), &namespace)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, sourceNamespacesPath, &sourceNamespaces)...)
+
+	if resp.Diagnostics.HasError() || namespace.IsNull() || namespace.IsUnknown() || sourceNamespaces.IsNull() || sourceNamespaces.IsUnknown() {
+		return
+	}
+
+	for _, e := range sourceNamespaces.Elements() {
+		pattern, ok := e.(types.String)
+		if !ok || pattern.IsNull() || pattern.IsUnknown() {
+			continue
+		}
+
+		// A lone wildcard explicitly opts into every namespace, including
+		// the control plane one.
+		if pattern.ValueString() == "*" {
+			continue
+		}
+
+		if glob.MatchStringInList([]string{pattern.ValueString()}, namespace.ValueString(), glob.REGEXP) {
+			resp.Diagnostics.AddAttributeWarning(
+				sourceNamespacesPath.AtSetValue(pattern),
+				"Source Namespace Matches Control Plane Namespace",
+				fmt.Sprintf("source namespace '%s' matches '%s', the namespace the project lives in. Applications in the control plane namespace are always permitted for the project, so this entry has no effect there. Remove it or narrow the pattern unless this is intended.", pattern.ValueString(), namespace.ValueString()),
+			)
+		}
+	}
+}
+
+// ProjectSourceNamespaces returns a validator which warns when a source
+// namespace pattern overlaps with the control plane namespace of the project.
+func ProjectSourceNamespaces() resource.ConfigValidator {
+	return projectSourceNamespacesValidator{}
+}
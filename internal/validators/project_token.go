@@ -0,0 +1,77 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = projectTokenRenewalValidator{}
+
+type projectTokenRenewalValidator struct{}
+
+func (v projectTokenRenewalValidator) Description(_ context.Context) string {
+	return "`renew_before` cannot be greater than `expires_in`"
+}
+
+func (v projectTokenRenewalValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectTokenRenewalValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiresIn, renewAfter, renewBefore types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_in"), &expiresIn)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("renew_after"), &renewAfter)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("renew_before"), &renewBefore)...)
+
+	if resp.Diagnostics.HasError() || expiresIn.IsUnknown() || renewAfter.IsUnknown() || renewBefore.IsUnknown() {
+		return
+	}
+
+	if expiresIn.IsNull() {
+		if !renewBefore.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renew_before"),
+				"Invalid Token Configuration",
+				"renew_before can only be used in combination with expires_in",
+			)
+		}
+
+		return
+	}
+
+	// Malformed durations are reported by the attribute validators
+	expiresInDuration, err := time.ParseDuration(expiresIn.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !renewBefore.IsNull() {
+		if renewBeforeDuration, err := time.ParseDuration(renewBefore.ValueString()); err == nil && renewBeforeDuration > expiresInDuration {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renew_before"),
+				"Invalid Token Configuration",
+				fmt.Sprintf("renew_before (%s) cannot be greater than expires_in (%s)", renewBefore.ValueString(), expiresIn.ValueString()),
+			)
+		}
+	}
+
+	if !renewAfter.IsNull() {
+		if renewAfterDuration, err := time.ParseDuration(renewAfter.ValueString()); err == nil && renewAfterDuration >= expiresInDuration {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("renew_after"),
+				"Token May Expire Before Renewal",
+				fmt.Sprintf("renew_after (%s) is not shorter than expires_in (%s), so the token will expire before it is renewed. Consider using renew_before instead.", renewAfter.ValueString(), expiresIn.ValueString()),
+			)
+		}
+	}
+}
+
+func ProjectTokenRenewal() resource.ConfigValidator {
+	return projectTokenRenewalValidator{}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
+	"strings"
 
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	argocdtime "github.com/argoproj/pkg/time"
+	"github.com/dlclark/regexp2"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/robfig/cron/v3"
 )
@@ -130,13 +132,21 @@ func (v syncWindowScheduleValidator) ValidateString(ctx context.Context, req val
 	}
 
 	value := req.ConfigValue.ValueString()
+
+	// ArgoCD evaluates sync window schedules with a standard five field
+	// parser, hence descriptors such as `@daily` are rejected as well.
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 
 	if _, err := specParser.Parse(value); err != nil {
+		detail := fmt.Sprintf("cannot parse schedule '%s': %s", value, err.Error())
+		if strings.HasPrefix(strings.TrimSpace(value), "@") {
+			detail += ". Descriptors are not supported by ArgoCD, use a five field cron expression (minute, hour, day of month, month, day of week) instead"
+		}
+
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Cron Schedule",
-			fmt.Sprintf("cannot parse schedule '%s': %s", value, err.Error()),
+			detail,
 		)
 	}
 }
@@ -181,11 +191,11 @@ func SyncWindowTimezoneValidator() validator.String {
 type syncWindowTimezoneValidator struct{}
 
 func (v syncWindowTimezoneValidator) Description(ctx context.Context) string {
-	return "value must be a valid timezone"
+	return "value must be a timezone of the IANA timezone database"
 }
 
 func (v syncWindowTimezoneValidator) MarkdownDescription(ctx context.Context) string {
-	return "value must be a valid timezone"
+	return "value must be a timezone of the IANA timezone database"
 }
 
 func (v syncWindowTimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
@@ -194,11 +204,106 @@ func (v syncWindowTimezoneValidator) ValidateString(ctx context.Context, req val
 	}
 
 	value := req.ConfigValue.ValueString()
-	if _, err := time.LoadLocation(value); err != nil {
+	if err := ValidateTimezone(value); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Timezone",
 			fmt.Sprintf("cannot parse timezone '%s': %s", value, err.Error()),
 		)
+
+		return
+	}
+
+	if canonical := CanonicalTimezone(value); canonical != value {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Deprecated Timezone Alias",
+			fmt.Sprintf("'%s' is a deprecated alias of '%s'. The sync window is saved with '%s'; consider updating the configuration accordingly.", value, canonical, canonical),
+		)
+	}
+}
+
+// GlobPatternValidator returns a validator which ensures that any configured
+// attribute value is a glob pattern ArgoCD is able to compile.
+func GlobPatternValidator() validator.String {
+	return globPatternValidator{}
+}
+
+type globPatternValidator struct{}
+
+func (v globPatternValidator) Description(ctx context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := glob.MatchWithError(value, ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Glob Pattern",
+			fmt.Sprintf("cannot compile pattern '%s': %s", value, err.Error()),
+		)
+	}
+}
+
+// SourceNamespaceValidator returns a validator which ensures that any
+// configured attribute value is either a glob pattern or a regular expression
+// wrapped in "/" that ArgoCD is able to compile.
+func SourceNamespaceValidator() validator.String {
+	return sourceNamespaceValidator{}
+}
+
+type sourceNamespaceValidator struct{}
+
+func (v sourceNamespaceValidator) Description(ctx context.Context) string {
+	return "value must be a valid glob pattern or a regular expression wrapped in '/'"
+}
+
+func (v sourceNamespaceValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid glob pattern or a regular expression wrapped in `/`"
+}
+
+func (v sourceNamespaceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Source Namespace",
+			"source namespace must not be empty",
+		)
+
+		return
+	}
+
+	if len(value) > 1 && strings.HasPrefix(value, "/") && strings.HasSuffix(value, "/") {
+		if _, err := regexp2.Compile(value[1:len(value)-1], 0); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Source Namespace",
+				fmt.Sprintf("cannot compile regular expression '%s': %s", value, err.Error()),
+			)
+		}
+
+		return
+	}
+
+	if _, err := glob.MatchWithError(value, ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Source Namespace",
+			fmt.Sprintf("cannot compile pattern '%s': %s", value, err.Error()),
+		)
 	}
 }
@@ -0,0 +1,220 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncWindowScheduleValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         types.String
+		expectError bool
+	}{
+		"null schedule": {
+			val: types.StringNull(),
+		},
+		"unknown schedule": {
+			val: types.StringUnknown(),
+		},
+		"every day": {
+			val: types.StringValue("10 1 * * *"),
+		},
+		"ranges and steps": {
+			val: types.StringValue("*/15 8-18 * * 1-5"),
+		},
+		"month and weekday names": {
+			val: types.StringValue("0 22 1 JAN,JUL MON-FRI"),
+		},
+		"empty schedule": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"descriptor": {
+			val:         types.StringValue("@daily"),
+			expectError: true,
+		},
+		"seconds field": {
+			val:         types.StringValue("0 10 1 * * *"),
+			expectError: true,
+		},
+		"out of range hour": {
+			val:         types.StringValue("0 25 * * *"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("schedule"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.StringResponse{}
+			SyncWindowScheduleValidator().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestGlobPatternValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         types.String
+		expectError bool
+	}{
+		"null pattern": {
+			val: types.StringNull(),
+		},
+		"literal": {
+			val: types.StringValue("ConfigMap"),
+		},
+		"wildcard": {
+			val: types.StringValue("prefix-*"),
+		},
+		"character class": {
+			val: types.StringValue("app-[0-9]"),
+		},
+		"alternatives": {
+			val: types.StringValue("{Secret,ConfigMap}"),
+		},
+		"unterminated character class": {
+			val:         types.StringValue("[invalid"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.StringResponse{}
+			GlobPatternValidator().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestSourceNamespaceValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         types.String
+		expectError bool
+	}{
+		"null namespace": {
+			val: types.StringNull(),
+		},
+		"literal": {
+			val: types.StringValue("team-a"),
+		},
+		"wildcard": {
+			val: types.StringValue("team-*"),
+		},
+		"regular expression": {
+			val: types.StringValue("/^team-(a|b)$/"),
+		},
+		"empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"unterminated character class": {
+			val:         types.StringValue("team-[a"),
+			expectError: true,
+		},
+		"invalid regular expression": {
+			val:         types.StringValue("/team-(a/"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("source_namespaces"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.StringResponse{}
+			SourceNamespaceValidator().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestSyncWindowTimezoneValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val           types.String
+		expectError   bool
+		expectWarning bool
+	}{
+		"null timezone": {
+			val: types.StringNull(),
+		},
+		"utc": {
+			val: types.StringValue("UTC"),
+		},
+		"canonical zone": {
+			val: types.StringValue("America/New_York"),
+		},
+		"deprecated alias": {
+			val:           types.StringValue("US/Eastern"),
+			expectWarning: true,
+		},
+		"local": {
+			val:         types.StringValue("Local"),
+			expectError: true,
+		},
+		"typo": {
+			val:         types.StringValue("Europe/Londno"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("timezone"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.StringResponse{}
+			SyncWindowTimezoneValidator().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+			assert.Equal(t, test.expectWarning, resp.Diagnostics.WarningsCount() > 0)
+		})
+	}
+}
+
+func TestCanonicalTimezone(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "America/New_York", CanonicalTimezone("US/Eastern"))
+	assert.Equal(t, "Europe/London", CanonicalTimezone("Europe/London"))
+
+	// All aliases must resolve to zones of the embedded timezone database
+	for alias, canonical := range timezoneAliases {
+		assert.NoError(t, ValidateTimezone(canonical), alias)
+	}
+}
@@ -0,0 +1,80 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ValidateRBACPolicy ensures that policy is a valid ArgoCD RBAC policy in CSV
+// format. Each line is validated on its own, so that the error points to the
+// offending line rather than the policy as a whole.
+func ValidateRBACPolicy(policy string) error {
+	for i, line := range strings.Split(policy, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		for j := range fields {
+			fields[j] = strings.TrimSpace(fields[j])
+		}
+
+		switch fields[0] {
+		case "p":
+			if len(fields) != 6 {
+				return fmt.Errorf("line %d: policies must be of the form 'p, <subject>, <resource>, <action>, <object>, <effect>', got '%s'", i+1, line)
+			}
+
+			if fields[5] != "allow" && fields[5] != "deny" {
+				return fmt.Errorf("line %d: effect must be either 'allow' or 'deny', got '%s'", i+1, fields[5])
+			}
+		case "g":
+			if len(fields) != 3 {
+				return fmt.Errorf("line %d: role assignments must be of the form 'g, <subject>, <role>', got '%s'", i+1, line)
+			}
+		default:
+			return fmt.Errorf("line %d: lines must either start with 'p' or 'g', got '%s'", i+1, line)
+		}
+
+		if err := rbac.ValidatePolicy(line); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// RBACPolicy returns a validator which ensures that any configured attribute
+// value is a valid ArgoCD RBAC policy in CSV format.
+func RBACPolicy() validator.String {
+	return rbacPolicyValidator{}
+}
+
+type rbacPolicyValidator struct{}
+
+func (v rbacPolicyValidator) Description(_ context.Context) string {
+	return "value must be a valid RBAC policy in CSV format"
+}
+
+func (v rbacPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rbacPolicyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateRBACPolicy(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RBAC Policy",
+			err.Error(),
+		)
+	}
+}
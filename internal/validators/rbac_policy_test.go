@@ -0,0 +1,58 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRBACPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expectError string
+	}{
+		"empty": {
+			value: "",
+		},
+		"policies and role assignments": {
+			value: "p, role:org-admin, applications, *, */*, allow\np, role:org-admin, clusters, get, *, allow\ng, my-org:team-alpha, role:org-admin\n",
+		},
+		"comments and blank lines": {
+			value: "# Read-only access for everyone\n\n  g, my-org:everyone, role:readonly\n",
+		},
+		"deny": {
+			value: "p, role:restricted, applications, delete, */*, deny",
+		},
+		"missing fields": {
+			value:       "p, role:org-admin, applications, get, allow",
+			expectError: "line 1: policies must be of the form",
+		},
+		"invalid effect": {
+			value:       "g, my-org:team-alpha, role:org-admin\np, role:org-admin, applications, get, */*, maybe",
+			expectError: "line 2: effect must be either 'allow' or 'deny'",
+		},
+		"incomplete role assignment": {
+			value:       "g, my-org:team-alpha",
+			expectError: "line 1: role assignments must be of the form",
+		},
+		"unknown policy type": {
+			value:       "\nx, role:org-admin, applications",
+			expectError: "line 2: lines must either start with 'p' or 'g'",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRBACPolicy(test.value)
+			if test.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
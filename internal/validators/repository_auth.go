@@ -0,0 +1,107 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ validator.Bool   = azureWorkloadIdentityValidator{}
+	_ validator.String = bearerTokenValidator{}
+)
+
+type azureWorkloadIdentityValidator struct {
+	urlAttribute         string
+	credentialAttributes []string
+}
+
+func (v azureWorkloadIdentityValidator) Description(_ context.Context) string {
+	return "use_azure_workload_identity can only be set to true for repositories accessed over HTTPS, without any other credentials"
+}
+
+func (v azureWorkloadIdentityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v azureWorkloadIdentityValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || !req.ConfigValue.ValueBool() {
+		return
+	}
+
+	// ArgoCD prefers any other credentials over Azure Workload Identity for Git
+	// repositories, and ignores them for Helm repositories
+	for _, attribute := range v.credentialAttributes {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Configuration",
+				"use_azure_workload_identity cannot be set to true when "+attribute+" is set, as only one of them is used to authenticate",
+			)
+		}
+	}
+
+	var url types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.urlAttribute), &url)...)
+
+	if isSSH, _ := git.IsSSHURL(url.ValueString()); isSSH {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Configuration",
+			"use_azure_workload_identity can only be set to true for repositories accessed over HTTPS, but "+v.urlAttribute+" is an SSH URL",
+		)
+	}
+}
+
+// AzureWorkloadIdentity returns a validator that ensures Azure Workload
+// Identity is only enabled for repositories accessed over HTTPS (e.g. Azure
+// DevOps or Azure Container Registry), and not together with any of the given
+// credential attributes.
+func AzureWorkloadIdentity(urlAttribute string, credentialAttributes ...string) validator.Bool {
+	return azureWorkloadIdentityValidator{
+		urlAttribute:         urlAttribute,
+		credentialAttributes: credentialAttributes,
+	}
+}
+
+type bearerTokenValidator struct{}
+
+func (v bearerTokenValidator) Description(_ context.Context) string {
+	return "bearer_token can only be used for repositories accessed over HTTP(S)"
+}
+
+func (v bearerTokenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v bearerTokenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	var repo types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repo"), &repo)...)
+
+	if isSSH, _ := git.IsSSHURL(repo.ValueString()); isSSH {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Configuration",
+			"bearer_token is sent as an HTTP header and can only be used for repositories accessed over HTTP(S), but repo is an SSH URL",
+		)
+	}
+}
+
+// BearerToken returns a validator that ensures bearer tokens, as used by
+// Bitbucket Data Center, are only set for repositories accessed over HTTP(S).
+func BearerToken() validator.String {
+	return bearerTokenValidator{}
+}
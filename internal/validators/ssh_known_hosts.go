@@ -0,0 +1,71 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	certutil "github.com/argoproj/argo-cd/v3/util/cert"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = sshKnownHostsValidator{}
+
+type sshKnownHostsValidator struct{}
+
+func (v sshKnownHostsValidator) Description(_ context.Context) string {
+	return "entries must contain valid SSH public keys, with at most one key per server name and sub type"
+}
+
+func (v sshKnownHostsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshKnownHostsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]bool)
+
+	for _, element := range req.ConfigValue.Elements() {
+		entry, ok := element.(types.Object)
+		if !ok || entry.IsUnknown() {
+			continue
+		}
+
+		serverName, _ := entry.Attributes()["server_name"].(types.String)
+		certSubType, _ := entry.Attributes()["cert_subtype"].(types.String)
+		certData, _ := entry.Attributes()["cert_data"].(types.String)
+
+		if serverName.IsUnknown() || certSubType.IsUnknown() || certData.IsUnknown() {
+			continue
+		}
+
+		key := certSubType.ValueString() + "/" + serverName.ValueString()
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Duplicate SSH Known Host",
+				fmt.Sprintf("more than one %s key is specified for %s, ArgoCD only supports one key per server name and sub type", certSubType.ValueString(), serverName.ValueString()),
+			)
+
+			continue
+		}
+
+		seen[key] = true
+
+		if _, _, err := certutil.TokenizedDataToPublicKey(serverName.ValueString(), certSubType.ValueString(), strings.TrimSpace(certData.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid SSH Known Host",
+				fmt.Sprintf("the %s key of %s could not be parsed: %s", certSubType.ValueString(), serverName.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+func SSHKnownHosts() validator.Set {
+	return sshKnownHostsValidator{}
+}
@@ -0,0 +1,79 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHKnownHostsValidator(t *testing.T) {
+	t.Parallel()
+
+	entryType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"server_name":  types.StringType,
+		"cert_subtype": types.StringType,
+		"cert_data":    types.StringType,
+	}}
+
+	entry := func(serverName, certSubType, certData string) attr.Value {
+		return types.ObjectValueMust(entryType.AttrTypes, map[string]attr.Value{
+			"server_name":  types.StringValue(serverName),
+			"cert_subtype": types.StringValue(certSubType),
+			"cert_data":    types.StringValue(certData),
+		})
+	}
+
+	gitlab := "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY="
+	github := "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBEmKSENjQEezOmxkZMy7opKgwFB9nkt5YRrYMjNuG5N87uRgg6CLrbo5wAdT/y6v0mKV0U2w0WZ2YB/++Tpockg="
+
+	tests := map[string]struct {
+		val         types.Set
+		expectError bool
+	}{
+		"null value": {
+			val: types.SetNull(entryType),
+		},
+		"unknown value": {
+			val: types.SetUnknown(entryType),
+		},
+		"valid entries": {
+			val: types.SetValueMust(entryType, []attr.Value{
+				entry("gitlab.com", "ecdsa-sha2-nistp256", gitlab),
+				entry("github.com", "ecdsa-sha2-nistp256", github+"\n"),
+			}),
+		},
+		"duplicate server name and sub type": {
+			val: types.SetValueMust(entryType, []attr.Value{
+				entry("github.com", "ecdsa-sha2-nistp256", gitlab),
+				entry("github.com", "ecdsa-sha2-nistp256", github),
+			}),
+			expectError: true,
+		},
+		"invalid key": {
+			val: types.SetValueMust(entryType, []attr.Value{
+				entry("github.com", "ssh-rsa", "not-a-key"),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.SetRequest{
+				Path:        path.Root("entries"),
+				ConfigValue: test.val,
+			}
+
+			resp := validator.SetResponse{}
+			SSHKnownHosts().ValidateSet(context.Background(), req, &resp)
+			assert.Equal(t, test.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the IANA timezone database so that timezones are validated
+	// consistently, regardless of the timezone data available on the host.
+	_ "time/tzdata"
+)
+
+// timezoneAliases maps commonly used backward compatible links of the IANA
+// timezone database to their canonical zone.
+var timezoneAliases = map[string]string{
+	"Asia/Calcutta":        "Asia/Kolkata",
+	"Asia/Katmandu":        "Asia/Kathmandu",
+	"Asia/Rangoon":         "Asia/Yangon",
+	"Asia/Saigon":          "Asia/Ho_Chi_Minh",
+	"Australia/ACT":        "Australia/Sydney",
+	"Australia/NSW":        "Australia/Sydney",
+	"Australia/Victoria":   "Australia/Melbourne",
+	"Brazil/East":          "America/Sao_Paulo",
+	"Canada/Central":       "America/Winnipeg",
+	"Canada/Eastern":       "America/Toronto",
+	"Canada/Mountain":      "America/Edmonton",
+	"Canada/Pacific":       "America/Vancouver",
+	"Europe/Kiev":          "Europe/Kyiv",
+	"GB":                   "Europe/London",
+	"Hongkong":             "Asia/Hong_Kong",
+	"Japan":                "Asia/Tokyo",
+	"NZ":                   "Pacific/Auckland",
+	"PRC":                  "Asia/Shanghai",
+	"ROK":                  "Asia/Seoul",
+	"Singapore":            "Asia/Singapore",
+	"US/Alaska":            "America/Anchorage",
+	"US/Arizona":           "America/Phoenix",
+	"US/Central":           "America/Chicago",
+	"US/Eastern":           "America/New_York",
+	"US/Hawaii":            "Pacific/Honolulu",
+	"US/Mountain":          "America/Denver",
+	"US/Pacific":           "America/Los_Angeles",
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+}
+
+// CanonicalTimezone returns the canonical IANA zone for known aliases, and
+// the given name otherwise.
+func CanonicalTimezone(name string) string {
+	if canonical, ok := timezoneAliases[name]; ok {
+		return canonical
+	}
+
+	return name
+}
+
+// ValidateTimezone ensures that value is a zone of the IANA timezone
+// database. ArgoCD falls back to UTC for timezones it cannot load, hence a
+// typo silently shifts sync windows.
+func ValidateTimezone(value string) error {
+	if value == "Local" {
+		return fmt.Errorf("'Local' depends on the timezone of the ArgoCD application controller, use an IANA timezone such as 'Europe/London' instead")
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		return fmt.Errorf("'%s' is not a timezone of the IANA timezone database, e.g. 'UTC' or 'America/New_York': %s", value, err.Error())
+	}
+
+	return nil
+}